@@ -29,6 +29,8 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_Authenticators_FullMethodName       = "/cosmos.auth.v1beta1.Query/Authenticators"
+	Query_RotatedPubKeys_FullMethodName       = "/cosmos.auth.v1beta1.Query/RotatedPubKeys"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +74,16 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// Authenticators returns the names of the authenticators registered to
+	// authenticate abstract accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error)
+	// RotatedPubKeys returns the accounts which replaced the public key with the
+	// given address with MsgRotatePubKey.
+	//
+	// Since: cosmos-sdk 0.50
+	RotatedPubKeys(ctx context.Context, in *QueryRotatedPubKeysRequest, opts ...grpc.CallOption) (*QueryRotatedPubKeysResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +184,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error) {
+	out := new(QueryAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, Query_Authenticators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RotatedPubKeys(ctx context.Context, in *QueryRotatedPubKeysRequest, opts ...grpc.CallOption) (*QueryRotatedPubKeysResponse, error) {
+	out := new(QueryRotatedPubKeysResponse)
+	err := c.cc.Invoke(ctx, Query_RotatedPubKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +243,16 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// Authenticators returns the names of the authenticators registered to
+	// authenticate abstract accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error)
+	// RotatedPubKeys returns the accounts which replaced the public key with the
+	// given address with MsgRotatePubKey.
+	//
+	// Since: cosmos-sdk 0.50
+	RotatedPubKeys(context.Context, *QueryRotatedPubKeysRequest) (*QueryRotatedPubKeysResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +290,12 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticators not implemented")
+}
+func (UnimplementedQueryServer) RotatedPubKeys(context.Context, *QueryRotatedPubKeysRequest) (*QueryRotatedPubKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatedPubKeys not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +489,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Authenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Authenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticators(ctx, req.(*QueryAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RotatedPubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRotatedPubKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RotatedPubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RotatedPubKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RotatedPubKeys(ctx, req.(*QueryRotatedPubKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +572,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "Authenticators",
+			Handler:    _Query_Authenticators_Handler,
+		},
+		{
+			MethodName: "RotatedPubKeys",
+			Handler:    _Query_RotatedPubKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -22,6 +22,8 @@ const (
 	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
 	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
 	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_MsgFilters_FullMethodName   = "/cosmos.circuit.v1.Query/MsgFilters"
+	Query_RateLimits_FullMethodName   = "/cosmos.circuit.v1.Query/RateLimits"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,10 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// MsgFilters returns the active Msg filters.
+	MsgFilters(ctx context.Context, in *QueryMsgFiltersRequest, opts ...grpc.CallOption) (*MsgFiltersResponse, error)
+	// RateLimits returns the Msg rate limits.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +77,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) MsgFilters(ctx context.Context, in *QueryMsgFiltersRequest, opts ...grpc.CallOption) (*MsgFiltersResponse, error) {
+	out := new(MsgFiltersResponse)
+	err := c.cc.Invoke(ctx, Query_MsgFilters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +105,10 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// MsgFilters returns the active Msg filters.
+	MsgFilters(context.Context, *QueryMsgFiltersRequest) (*MsgFiltersResponse, error)
+	// RateLimits returns the Msg rate limits.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*RateLimitsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +125,12 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) MsgFilters(context.Context, *QueryMsgFiltersRequest) (*MsgFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFilters not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +198,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFilters(ctx, req.(*QueryMsgFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +253,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "MsgFilters",
+			Handler:    _Query_MsgFilters_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -22,6 +22,9 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_AddMsgFilter_FullMethodName            = "/cosmos.circuit.v1.Msg/AddMsgFilter"
+	Msg_RemoveMsgFilter_FullMethodName         = "/cosmos.circuit.v1.Msg/RemoveMsgFilter"
+	Msg_SetRateLimit_FullMethodName            = "/cosmos.circuit.v1.Msg/SetRateLimit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +39,13 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// AddMsgFilter pauses processing of the Msg's of a type URL whose fields
+	// match the given conditions.
+	AddMsgFilter(ctx context.Context, in *MsgAddMsgFilter, opts ...grpc.CallOption) (*MsgAddMsgFilterResponse, error)
+	// RemoveMsgFilter removes a filter added using AddMsgFilter.
+	RemoveMsgFilter(ctx context.Context, in *MsgRemoveMsgFilter, opts ...grpc.CallOption) (*MsgRemoveMsgFilterResponse, error)
+	// SetRateLimit sets or removes the rate limit of a Msg type URL.
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +83,33 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) AddMsgFilter(ctx context.Context, in *MsgAddMsgFilter, opts ...grpc.CallOption) (*MsgAddMsgFilterResponse, error) {
+	out := new(MsgAddMsgFilterResponse)
+	err := c.cc.Invoke(ctx, Msg_AddMsgFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMsgFilter(ctx context.Context, in *MsgRemoveMsgFilter, opts ...grpc.CallOption) (*MsgRemoveMsgFilterResponse, error) {
+	out := new(MsgRemoveMsgFilterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMsgFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +122,13 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// AddMsgFilter pauses processing of the Msg's of a type URL whose fields
+	// match the given conditions.
+	AddMsgFilter(context.Context, *MsgAddMsgFilter) (*MsgAddMsgFilterResponse, error)
+	// RemoveMsgFilter removes a filter added using AddMsgFilter.
+	RemoveMsgFilter(context.Context, *MsgRemoveMsgFilter) (*MsgRemoveMsgFilterResponse, error)
+	// SetRateLimit sets or removes the rate limit of a Msg type URL.
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +145,15 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) AddMsgFilter(context.Context, *MsgAddMsgFilter) (*MsgAddMsgFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMsgFilter not implemented")
+}
+func (UnimplementedMsgServer) RemoveMsgFilter(context.Context, *MsgRemoveMsgFilter) (*MsgRemoveMsgFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMsgFilter not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +221,60 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddMsgFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMsgFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMsgFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddMsgFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMsgFilter(ctx, req.(*MsgAddMsgFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMsgFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMsgFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMsgFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMsgFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMsgFilter(ctx, req.(*MsgRemoveMsgFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +294,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "AddMsgFilter",
+			Handler:    _Msg_AddMsgFilter_Handler,
+		},
+		{
+			MethodName: "RemoveMsgFilter",
+			Handler:    _Msg_RemoveMsgFilter_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
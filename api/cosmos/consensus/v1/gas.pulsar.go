@@ -0,0 +1,2212 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package consensusv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_GasConfig                     protoreflect.MessageDescriptor
+	fd_GasConfig_has_cost            protoreflect.FieldDescriptor
+	fd_GasConfig_delete_cost         protoreflect.FieldDescriptor
+	fd_GasConfig_read_cost_flat      protoreflect.FieldDescriptor
+	fd_GasConfig_read_cost_per_byte  protoreflect.FieldDescriptor
+	fd_GasConfig_write_cost_flat     protoreflect.FieldDescriptor
+	fd_GasConfig_write_cost_per_byte protoreflect.FieldDescriptor
+	fd_GasConfig_iter_next_cost_flat protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_consensus_v1_gas_proto_init()
+	md_GasConfig = File_cosmos_consensus_v1_gas_proto.Messages().ByName("GasConfig")
+	fd_GasConfig_has_cost = md_GasConfig.Fields().ByName("has_cost")
+	fd_GasConfig_delete_cost = md_GasConfig.Fields().ByName("delete_cost")
+	fd_GasConfig_read_cost_flat = md_GasConfig.Fields().ByName("read_cost_flat")
+	fd_GasConfig_read_cost_per_byte = md_GasConfig.Fields().ByName("read_cost_per_byte")
+	fd_GasConfig_write_cost_flat = md_GasConfig.Fields().ByName("write_cost_flat")
+	fd_GasConfig_write_cost_per_byte = md_GasConfig.Fields().ByName("write_cost_per_byte")
+	fd_GasConfig_iter_next_cost_flat = md_GasConfig.Fields().ByName("iter_next_cost_flat")
+}
+
+var _ protoreflect.Message = (*fastReflection_GasConfig)(nil)
+
+type fastReflection_GasConfig GasConfig
+
+func (x *GasConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasConfig)(x)
+}
+
+func (x *GasConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GasConfig_messageType fastReflection_GasConfig_messageType
+var _ protoreflect.MessageType = fastReflection_GasConfig_messageType{}
+
+type fastReflection_GasConfig_messageType struct{}
+
+func (x fastReflection_GasConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasConfig)(nil)
+}
+func (x fastReflection_GasConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasConfig)
+}
+func (x fastReflection_GasConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GasConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GasConfig) Type() protoreflect.MessageType {
+	return _fastReflection_GasConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GasConfig) New() protoreflect.Message {
+	return new(fastReflection_GasConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GasConfig) Interface() protoreflect.ProtoMessage {
+	return (*GasConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GasConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HasCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HasCost)
+		if !f(fd_GasConfig_has_cost, value) {
+			return
+		}
+	}
+	if x.DeleteCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeleteCost)
+		if !f(fd_GasConfig_delete_cost, value) {
+			return
+		}
+	}
+	if x.ReadCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReadCostFlat)
+		if !f(fd_GasConfig_read_cost_flat, value) {
+			return
+		}
+	}
+	if x.ReadCostPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReadCostPerByte)
+		if !f(fd_GasConfig_read_cost_per_byte, value) {
+			return
+		}
+	}
+	if x.WriteCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WriteCostFlat)
+		if !f(fd_GasConfig_write_cost_flat, value) {
+			return
+		}
+	}
+	if x.WriteCostPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WriteCostPerByte)
+		if !f(fd_GasConfig_write_cost_per_byte, value) {
+			return
+		}
+	}
+	if x.IterNextCostFlat != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IterNextCostFlat)
+		if !f(fd_GasConfig_iter_next_cost_flat, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GasConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		return x.HasCost != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		return x.DeleteCost != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		return x.ReadCostFlat != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		return x.ReadCostPerByte != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		return x.WriteCostFlat != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		return x.WriteCostPerByte != uint64(0)
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		return x.IterNextCostFlat != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		x.HasCost = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		x.DeleteCost = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		x.ReadCostFlat = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		x.ReadCostPerByte = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		x.WriteCostFlat = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		x.WriteCostPerByte = uint64(0)
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		x.IterNextCostFlat = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GasConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		value := x.HasCost
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		value := x.DeleteCost
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		value := x.ReadCostFlat
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		value := x.ReadCostPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		value := x.WriteCostFlat
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		value := x.WriteCostPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		value := x.IterNextCostFlat
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		x.HasCost = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		x.DeleteCost = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		x.ReadCostFlat = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		x.ReadCostPerByte = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		x.WriteCostFlat = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		x.WriteCostPerByte = value.Uint()
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		x.IterNextCostFlat = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		panic(fmt.Errorf("field has_cost of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		panic(fmt.Errorf("field delete_cost of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		panic(fmt.Errorf("field read_cost_flat of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		panic(fmt.Errorf("field read_cost_per_byte of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		panic(fmt.Errorf("field write_cost_flat of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		panic(fmt.Errorf("field write_cost_per_byte of message cosmos.consensus.v1.GasConfig is not mutable"))
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		panic(fmt.Errorf("field iter_next_cost_flat of message cosmos.consensus.v1.GasConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GasConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasConfig.has_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.delete_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.read_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.read_cost_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.write_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.write_cost_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.consensus.v1.GasConfig.iter_next_cost_flat":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GasConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.consensus.v1.GasConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GasConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GasConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GasConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GasConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.HasCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.HasCost))
+		}
+		if x.DeleteCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeleteCost))
+		}
+		if x.ReadCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReadCostFlat))
+		}
+		if x.ReadCostPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReadCostPerByte))
+		}
+		if x.WriteCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.WriteCostFlat))
+		}
+		if x.WriteCostPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.WriteCostPerByte))
+		}
+		if x.IterNextCostFlat != 0 {
+			n += 1 + runtime.Sov(uint64(x.IterNextCostFlat))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GasConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IterNextCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IterNextCostFlat))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.WriteCostPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WriteCostPerByte))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.WriteCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WriteCostFlat))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ReadCostPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReadCostPerByte))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReadCostFlat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReadCostFlat))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DeleteCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeleteCost))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.HasCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HasCost))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GasConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+				}
+				x.HasCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HasCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+				}
+				x.DeleteCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeleteCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+				}
+				x.ReadCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReadCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+				}
+				x.ReadCostPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReadCostPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+				}
+				x.WriteCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WriteCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+				}
+				x.WriteCostPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WriteCostPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+				}
+				x.IterNextCostFlat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IterNextCostFlat |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StoreGasConfig            protoreflect.MessageDescriptor
+	fd_StoreGasConfig_store_key  protoreflect.FieldDescriptor
+	fd_StoreGasConfig_gas_config protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_consensus_v1_gas_proto_init()
+	md_StoreGasConfig = File_cosmos_consensus_v1_gas_proto.Messages().ByName("StoreGasConfig")
+	fd_StoreGasConfig_store_key = md_StoreGasConfig.Fields().ByName("store_key")
+	fd_StoreGasConfig_gas_config = md_StoreGasConfig.Fields().ByName("gas_config")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreGasConfig)(nil)
+
+type fastReflection_StoreGasConfig StoreGasConfig
+
+func (x *StoreGasConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreGasConfig)(x)
+}
+
+func (x *StoreGasConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreGasConfig_messageType fastReflection_StoreGasConfig_messageType
+var _ protoreflect.MessageType = fastReflection_StoreGasConfig_messageType{}
+
+type fastReflection_StoreGasConfig_messageType struct{}
+
+func (x fastReflection_StoreGasConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreGasConfig)(nil)
+}
+func (x fastReflection_StoreGasConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreGasConfig)
+}
+func (x fastReflection_StoreGasConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreGasConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreGasConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreGasConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreGasConfig) Type() protoreflect.MessageType {
+	return _fastReflection_StoreGasConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreGasConfig) New() protoreflect.Message {
+	return new(fastReflection_StoreGasConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreGasConfig) Interface() protoreflect.ProtoMessage {
+	return (*StoreGasConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreGasConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StoreKey != "" {
+		value := protoreflect.ValueOfString(x.StoreKey)
+		if !f(fd_StoreGasConfig_store_key, value) {
+			return
+		}
+	}
+	if x.GasConfig != nil {
+		value := protoreflect.ValueOfMessage(x.GasConfig.ProtoReflect())
+		if !f(fd_StoreGasConfig_gas_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreGasConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		return x.StoreKey != ""
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		return x.GasConfig != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreGasConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		x.StoreKey = ""
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		x.GasConfig = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreGasConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		value := x.StoreKey
+		return protoreflect.ValueOfString(value)
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		value := x.GasConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreGasConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		x.StoreKey = value.Interface().(string)
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		x.GasConfig = value.Message().Interface().(*GasConfig)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreGasConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		if x.GasConfig == nil {
+			x.GasConfig = new(GasConfig)
+		}
+		return protoreflect.ValueOfMessage(x.GasConfig.ProtoReflect())
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		panic(fmt.Errorf("field store_key of message cosmos.consensus.v1.StoreGasConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreGasConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.StoreGasConfig.store_key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.consensus.v1.StoreGasConfig.gas_config":
+		m := new(GasConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.StoreGasConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.StoreGasConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreGasConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.consensus.v1.StoreGasConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreGasConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreGasConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreGasConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreGasConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreGasConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StoreKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasConfig != nil {
+			l = options.Size(x.GasConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreGasConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasConfig != nil {
+			encoded, err := options.Marshal(x.GasConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.StoreKey) > 0 {
+			i -= len(x.StoreKey)
+			copy(dAtA[i:], x.StoreKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StoreKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreGasConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreGasConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasConfig == nil {
+					x.GasConfig = &GasConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GasSchedule_3_list)(nil)
+
+type _GasSchedule_3_list struct {
+	list *[]*StoreGasConfig
+}
+
+func (x *_GasSchedule_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GasSchedule_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GasSchedule_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreGasConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GasSchedule_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreGasConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GasSchedule_3_list) AppendMutable() protoreflect.Value {
+	v := new(StoreGasConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GasSchedule_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GasSchedule_3_list) NewElement() protoreflect.Value {
+	v := new(StoreGasConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GasSchedule_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GasSchedule           protoreflect.MessageDescriptor
+	fd_GasSchedule_kv        protoreflect.FieldDescriptor
+	fd_GasSchedule_transient protoreflect.FieldDescriptor
+	fd_GasSchedule_stores    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_consensus_v1_gas_proto_init()
+	md_GasSchedule = File_cosmos_consensus_v1_gas_proto.Messages().ByName("GasSchedule")
+	fd_GasSchedule_kv = md_GasSchedule.Fields().ByName("kv")
+	fd_GasSchedule_transient = md_GasSchedule.Fields().ByName("transient")
+	fd_GasSchedule_stores = md_GasSchedule.Fields().ByName("stores")
+}
+
+var _ protoreflect.Message = (*fastReflection_GasSchedule)(nil)
+
+type fastReflection_GasSchedule GasSchedule
+
+func (x *GasSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasSchedule)(x)
+}
+
+func (x *GasSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GasSchedule_messageType fastReflection_GasSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_GasSchedule_messageType{}
+
+type fastReflection_GasSchedule_messageType struct{}
+
+func (x fastReflection_GasSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasSchedule)(nil)
+}
+func (x fastReflection_GasSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasSchedule)
+}
+func (x fastReflection_GasSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GasSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GasSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_GasSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GasSchedule) New() protoreflect.Message {
+	return new(fastReflection_GasSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GasSchedule) Interface() protoreflect.ProtoMessage {
+	return (*GasSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GasSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kv != nil {
+		value := protoreflect.ValueOfMessage(x.Kv.ProtoReflect())
+		if !f(fd_GasSchedule_kv, value) {
+			return
+		}
+	}
+	if x.Transient != nil {
+		value := protoreflect.ValueOfMessage(x.Transient.ProtoReflect())
+		if !f(fd_GasSchedule_transient, value) {
+			return
+		}
+	}
+	if len(x.Stores) != 0 {
+		value := protoreflect.ValueOfList(&_GasSchedule_3_list{list: &x.Stores})
+		if !f(fd_GasSchedule_stores, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GasSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		return x.Kv != nil
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		return x.Transient != nil
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		return len(x.Stores) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		x.Kv = nil
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		x.Transient = nil
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		x.Stores = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GasSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		value := x.Kv
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		value := x.Transient
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		if len(x.Stores) == 0 {
+			return protoreflect.ValueOfList(&_GasSchedule_3_list{})
+		}
+		listValue := &_GasSchedule_3_list{list: &x.Stores}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		x.Kv = value.Message().Interface().(*GasConfig)
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		x.Transient = value.Message().Interface().(*GasConfig)
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		lv := value.List()
+		clv := lv.(*_GasSchedule_3_list)
+		x.Stores = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		if x.Kv == nil {
+			x.Kv = new(GasConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Kv.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		if x.Transient == nil {
+			x.Transient = new(GasConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Transient.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		if x.Stores == nil {
+			x.Stores = []*StoreGasConfig{}
+		}
+		value := &_GasSchedule_3_list{list: &x.Stores}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GasSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.consensus.v1.GasSchedule.kv":
+		m := new(GasConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.transient":
+		m := new(GasConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.consensus.v1.GasSchedule.stores":
+		list := []*StoreGasConfig{}
+		return protoreflect.ValueOfList(&_GasSchedule_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.consensus.v1.GasSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.consensus.v1.GasSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GasSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.consensus.v1.GasSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GasSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GasSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GasSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GasSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GasSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Kv != nil {
+			l = options.Size(x.Kv)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Transient != nil {
+			l = options.Size(x.Transient)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Stores) > 0 {
+			for _, e := range x.Stores {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GasSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Stores) > 0 {
+			for iNdEx := len(x.Stores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Transient != nil {
+			encoded, err := options.Marshal(x.Transient)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Kv != nil {
+			encoded, err := options.Marshal(x.Kv)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GasSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Kv == nil {
+					x.Kv = &GasConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Kv); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transient", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Transient == nil {
+					x.Transient = &GasConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Transient); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stores = append(x.Stores, &StoreGasConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stores[len(x.Stores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/consensus/v1/gas.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GasConfig defines the gas costs of the operations on a KVStore.
+type GasConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (x *GasConfig) Reset() {
+	*x = GasConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasConfig) ProtoMessage() {}
+
+// Deprecated: Use GasConfig.ProtoReflect.Descriptor instead.
+func (*GasConfig) Descriptor() ([]byte, []int) {
+	return file_cosmos_consensus_v1_gas_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GasConfig) GetHasCost() uint64 {
+	if x != nil {
+		return x.HasCost
+	}
+	return 0
+}
+
+func (x *GasConfig) GetDeleteCost() uint64 {
+	if x != nil {
+		return x.DeleteCost
+	}
+	return 0
+}
+
+func (x *GasConfig) GetReadCostFlat() uint64 {
+	if x != nil {
+		return x.ReadCostFlat
+	}
+	return 0
+}
+
+func (x *GasConfig) GetReadCostPerByte() uint64 {
+	if x != nil {
+		return x.ReadCostPerByte
+	}
+	return 0
+}
+
+func (x *GasConfig) GetWriteCostFlat() uint64 {
+	if x != nil {
+		return x.WriteCostFlat
+	}
+	return 0
+}
+
+func (x *GasConfig) GetWriteCostPerByte() uint64 {
+	if x != nil {
+		return x.WriteCostPerByte
+	}
+	return 0
+}
+
+func (x *GasConfig) GetIterNextCostFlat() uint64 {
+	if x != nil {
+		return x.IterNextCostFlat
+	}
+	return 0
+}
+
+// StoreGasConfig defines the gas costs of the operations on the store of a
+// given store key.
+type StoreGasConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// store_key is the name of the store key.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// gas_config is the gas config of the store.
+	GasConfig *GasConfig `protobuf:"bytes,2,opt,name=gas_config,json=gasConfig,proto3" json:"gas_config,omitempty"`
+}
+
+func (x *StoreGasConfig) Reset() {
+	*x = StoreGasConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreGasConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreGasConfig) ProtoMessage() {}
+
+// Deprecated: Use StoreGasConfig.ProtoReflect.Descriptor instead.
+func (*StoreGasConfig) Descriptor() ([]byte, []int) {
+	return file_cosmos_consensus_v1_gas_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoreGasConfig) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreGasConfig) GetGasConfig() *GasConfig {
+	if x != nil {
+		return x.GasConfig
+	}
+	return nil
+}
+
+// GasSchedule defines the gas costs of the store operations. The stores
+// without their own gas config are charged with the kv config, or with the
+// transient config for transient stores.
+type GasSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kv is the default gas config of the KVStores.
+	Kv *GasConfig `protobuf:"bytes,1,opt,name=kv,proto3" json:"kv,omitempty"`
+	// transient is the default gas config of the transient stores.
+	Transient *GasConfig `protobuf:"bytes,2,opt,name=transient,proto3" json:"transient,omitempty"`
+	// stores are the gas configs of the stores overriding the defaults.
+	Stores []*StoreGasConfig `protobuf:"bytes,3,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *GasSchedule) Reset() {
+	*x = GasSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_consensus_v1_gas_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasSchedule) ProtoMessage() {}
+
+// Deprecated: Use GasSchedule.ProtoReflect.Descriptor instead.
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_consensus_v1_gas_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GasSchedule) GetKv() *GasConfig {
+	if x != nil {
+		return x.Kv
+	}
+	return nil
+}
+
+func (x *GasSchedule) GetTransient() *GasConfig {
+	if x != nil {
+		return x.Transient
+	}
+	return nil
+}
+
+func (x *GasSchedule) GetStores() []*StoreGasConfig {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+var File_cosmos_consensus_v1_gas_proto protoreflect.FileDescriptor
+
+var file_cosmos_consensus_v1_gas_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x02, 0x0a, 0x09, 0x47,
+	0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x61, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x73, 0x74,
+	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x74, 0x12,
+	0x2d, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x13, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x74, 0x65,
+	0x72, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x74, 0x22, 0x72, 0x0a,
+	0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x0a,
+	0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0xca, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x34, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x42, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x42, 0xc3,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_consensus_v1_gas_proto_rawDescOnce sync.Once
+	file_cosmos_consensus_v1_gas_proto_rawDescData = file_cosmos_consensus_v1_gas_proto_rawDesc
+)
+
+func file_cosmos_consensus_v1_gas_proto_rawDescGZIP() []byte {
+	file_cosmos_consensus_v1_gas_proto_rawDescOnce.Do(func() {
+		file_cosmos_consensus_v1_gas_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_consensus_v1_gas_proto_rawDescData)
+	})
+	return file_cosmos_consensus_v1_gas_proto_rawDescData
+}
+
+var file_cosmos_consensus_v1_gas_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_consensus_v1_gas_proto_goTypes = []interface{}{
+	(*GasConfig)(nil),      // 0: cosmos.consensus.v1.GasConfig
+	(*StoreGasConfig)(nil), // 1: cosmos.consensus.v1.StoreGasConfig
+	(*GasSchedule)(nil),    // 2: cosmos.consensus.v1.GasSchedule
+}
+var file_cosmos_consensus_v1_gas_proto_depIdxs = []int32{
+	0, // 0: cosmos.consensus.v1.StoreGasConfig.gas_config:type_name -> cosmos.consensus.v1.GasConfig
+	0, // 1: cosmos.consensus.v1.GasSchedule.kv:type_name -> cosmos.consensus.v1.GasConfig
+	0, // 2: cosmos.consensus.v1.GasSchedule.transient:type_name -> cosmos.consensus.v1.GasConfig
+	1, // 3: cosmos.consensus.v1.GasSchedule.stores:type_name -> cosmos.consensus.v1.StoreGasConfig
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_consensus_v1_gas_proto_init() }
+func file_cosmos_consensus_v1_gas_proto_init() {
+	if File_cosmos_consensus_v1_gas_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_consensus_v1_gas_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_consensus_v1_gas_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreGasConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_consensus_v1_gas_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_consensus_v1_gas_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cosmos_consensus_v1_gas_proto_goTypes,
+		DependencyIndexes: file_cosmos_consensus_v1_gas_proto_depIdxs,
+		MessageInfos:      file_cosmos_consensus_v1_gas_proto_msgTypes,
+	}.Build()
+	File_cosmos_consensus_v1_gas_proto = out.File
+	file_cosmos_consensus_v1_gas_proto_rawDesc = nil
+	file_cosmos_consensus_v1_gas_proto_goTypes = nil
+	file_cosmos_consensus_v1_gas_proto_depIdxs = nil
+}
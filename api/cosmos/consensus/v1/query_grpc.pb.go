@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/cosmos.consensus.v1.Query/Params"
+	Query_Params_FullMethodName      = "/cosmos.consensus.v1.Query/Params"
+	Query_GasSchedule_FullMethodName = "/cosmos.consensus.v1.Query/GasSchedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +31,8 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasSchedule queries the active gas schedule of the store operations.
+	GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -49,12 +52,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error) {
+	out := new(QueryGasScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_GasSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasSchedule queries the active gas schedule of the store operations.
+	GasSchedule(context.Context, *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -65,6 +79,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) GasSchedule(context.Context, *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +113,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSchedule(ctx, req.(*QueryGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +142,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasSchedule",
+			Handler:    _Query_GasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
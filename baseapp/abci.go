@@ -227,6 +227,11 @@ func (app *BaseApp) ListSnapshots(req *abci.RequestListSnapshots) (*abci.Respons
 	}
 
 	for _, snapshot := range snapshots {
+		// differential snapshots can't be restored through state sync
+		if snapshot.IsDifferential() {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to convert ABCI snapshots", "err", err)
@@ -316,9 +321,17 @@ func (app *BaseApp) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*ab
 		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
 	}
 
-	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	done, err := app.snapshotManager.RestoreChunk(req.Chunk)
 	switch {
 	case err == nil:
+		// the state storage is rebuilt from the restored state, as it misses the
+		// changesets of the versions up to the snapshot height
+		if done && app.stateStorage != nil {
+			if err := app.stateStorage.Sync(app.cms.(*rootmulti.Store)); err != nil {
+				app.logger.Error("failed to sync the state storage with the restored snapshot", "err", err)
+				return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}, nil
+			}
+		}
 		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}, nil
 
 	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
@@ -492,6 +505,8 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
 	if req.Height > app.initialHeight {
+		// abort any running OE, which writes to the FinalizeBlock state
+		app.optimisticExec.Abort()
 		app.setState(execModeFinalize, header)
 	}
 
@@ -532,6 +547,15 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 	}
 
+	// Only start the optimistic execution of an accepted proposal, and not for
+	// the first block, which carries the state written during InitChain that
+	// could not be discarded on abort.
+	if resp.Status == abci.ResponseProcessProposal_ACCEPT &&
+		app.optimisticExec.Enabled() &&
+		req.Height > app.initialHeight {
+		app.optimisticExec.Execute(req)
+	}
+
 	return resp, nil
 }
 
@@ -656,6 +680,40 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (r
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	if app.optimisticExec.Initialized() {
+		// check if the hash we got is the same as the one we are executing
+		aborted := app.optimisticExec.AbortIfNeeded(req.Hash)
+		// Wait for the OE to finish, regardless of whether it was aborted or not
+		res, err := app.optimisticExec.WaitResult()
+
+		// only return if we are not aborting
+		if !aborted {
+			if res != nil {
+				res.AppHash = app.workingHash()
+			}
+
+			return res, err
+		}
+
+		// if it was aborted, we need to discard the state written by the OE and
+		// execute the block again from scratch
+		app.optimisticExec.Reset()
+		app.finalizeBlockState = nil
+	}
+
+	res, err := app.internalFinalizeBlock(context.Background(), req)
+	if res != nil {
+		res.AppHash = app.workingHash()
+	}
+
+	return res, err
+}
+
+// internalFinalizeBlock executes the block, called by FinalizeBlock or, when
+// optimistic execution is enabled, in the background after ProcessProposal. The
+// execution stops between transactions once ctx is canceled. The returned
+// response has no AppHash, which is set by FinalizeBlock.
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
 	var events []abci.Event
 
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
@@ -733,12 +791,50 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
+	skipFirstTx := app.injectVoteExtensions && voteExtensionsInjected(app.finalizeBlockState.ctx.ConsensusParams(), req.Height)
+
+	var parallelExec *parallelExecution
+	if app.parallelTxWorkers > 0 {
+		txs := make([][]byte, len(req.Txs))
+		for i, rawTx := range req.Txs {
+			if i == 0 && skipFirstTx {
+				continue
+			}
+			if _, err := app.txDecoder(rawTx); err == nil {
+				txs[i] = rawTx
+			}
+		}
+
+		var err error
+		if parallelExec, err = app.executeTxsSpeculatively(ctx, txs, app.parallelTxWorkers); err != nil {
+			return nil, err
+		}
+	}
+
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
+	for i, rawTx := range req.Txs {
+		// check before each transaction whether the execution was aborted
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
 		var response *abci.ExecTxResult
 
+		// The extended commit info injected by VoteExtensionProposalHandler is not
+		// a transaction and is stripped before decoding.
+		if i == 0 && skipFirstTx {
+			txResults = append(txResults, &abci.ExecTxResult{})
+			continue
+		}
+
 		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
+			if parallelExec != nil {
+				response = parallelExec.commit(i, rawTx)
+			} else {
+				response = app.deliverTx(rawTx)
+			}
 		} else {
 			// In the case where a transaction included in a block proposal is malformed,
 			// we still want to return a default response to comet. This is because comet
@@ -772,7 +868,6 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 		TxResults:             txResults,
 		ValidatorUpdates:      endBlock.ValidatorUpdates,
 		ConsensusParamUpdates: &cp,
-		AppHash:               app.workingHash(),
 	}, nil
 }
 
@@ -814,17 +909,30 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		rms.SetCommitHeader(header)
 	}
 
+	abciListeners := app.streamingManager.ABCIListeners
+	var changeSet []*storetypes.StoreKVPair
+	if len(abciListeners) > 0 || app.stateStorage != nil {
+		changeSet = app.cms.PopStateCache()
+	}
+
+	// The state storage is written before the commit of the block, so that the block
+	// replayed after a crash writes it again.
+	if app.stateStorage != nil {
+		if err := app.stateStorage.Commit(header.Height, changeSet); err != nil {
+			return nil, fmt.Errorf("failed to write the state storage: %w", err)
+		}
+		changeSet = app.streamedChangeSet(changeSet)
+	}
+
 	app.cms.Commit()
 
 	resp := &abci.ResponseCommit{
 		RetainHeight: retainHeight,
 	}
 
-	abciListeners := app.streamingManager.ABCIListeners
 	if len(abciListeners) > 0 {
 		ctx := app.finalizeBlockState.ctx
 		blockHeight := ctx.BlockHeight()
-		changeSet := app.cms.PopStateCache()
 
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
@@ -841,6 +949,10 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 
 	app.finalizeBlockState = nil
 
+	if app.optimisticExec.Initialized() {
+		app.optimisticExec.Reset()
+	}
+
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.ctx)
 	}
@@ -851,6 +963,22 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	return resp, nil
 }
 
+// streamedChangeSet returns the changes of the changeset streamed to the ABCI listeners,
+// leaving out the ones of the stores only listened to by the state storage.
+func (app *BaseApp) streamedChangeSet(changeSet []*storetypes.StoreKVPair) []*storetypes.StoreKVPair {
+	if len(app.stateStorageKeys) == 0 {
+		return changeSet
+	}
+
+	streamed := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if !app.stateStorageKeys[pair.StoreKey] {
+			streamed = append(streamed, pair)
+		}
+	}
+	return streamed
+}
+
 // workingHash gets the apphash that will be finalized in commit.
 // These writes will be persisted to the root multi-store (app.cms) and flushed to
 // disk in the Commit phase. This means when the ABCI client requests Commit(), the application
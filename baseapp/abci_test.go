@@ -40,6 +40,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -799,6 +800,105 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateWithOptions(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			// the simulations run in simulate mode, whatever the height of their state
+			if simulate {
+				require.Equal(t, sdk.ExecModeSimulate, ctx.ExecMode())
+			}
+			return anteHandler(ctx, tx, simulate)
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// commit two blocks, the counters being 1 at height 1 and 2 at height 2
+	for counter := int64(0); counter < 2; counter++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, counter, counter))
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: counter + 1, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	encodeCounter := func(i int64) []byte {
+		bz := make([]byte, binary.MaxVarintLen64)
+		return bz[:binary.PutVarint(bz, i)]
+	}
+
+	// simulate at a past height
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+	_, result, trace, err := suite.baseApp.SimulateWithOptions(txBytes, txtypes.SimulateOptions{Height: 1})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Nil(t, trace)
+
+	_, _, _, err = suite.baseApp.SimulateWithOptions(txBytes, txtypes.SimulateOptions{Height: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	// simulate with the counters overridden
+	txBytes, err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	_, result, _, err = suite.baseApp.SimulateWithOptions(txBytes, txtypes.SimulateOptions{
+		StateOverrides: []txtypes.StateOverride{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: encodeCounter(0)},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Delete: true},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the overrides are discarded
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(2), getIntFromStore(t, store, deliverKey))
+
+	_, _, _, err = suite.baseApp.SimulateWithOptions(txBytes, txtypes.SimulateOptions{
+		StateOverrides: []txtypes.StateOverride{{StoreKey: "unknown", Key: anteKey}},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// simulate with the trace of a tx with two msgs
+	txBytes, err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 2, 2, 3))
+	require.NoError(t, err)
+	_, result, trace, err = suite.baseApp.SimulateWithOptions(txBytes, txtypes.SimulateOptions{Trace: true})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotNil(t, trace)
+
+	storeOp := func(op string, key []byte, i int64) txtypes.StoreOperation {
+		return txtypes.StoreOperation{Operation: op, StoreKey: capKey1.Name(), Key: key, Value: encodeCounter(i)}
+	}
+	require.Equal(t, []txtypes.StoreOperation{storeOp("read", anteKey, 2), storeOp("write", anteKey, 3)}, trace.Ante.Operations)
+	require.Len(t, trace.Ante.Events, 1)
+	require.Equal(t, "ante_handler", trace.Ante.Events[0].Type)
+
+	require.Len(t, trace.Msgs, 2)
+	for i, counter := range []int64{2, 3} {
+		require.Equal(t, []txtypes.StoreOperation{storeOp("read", deliverKey, counter), storeOp("write", deliverKey, counter+1)}, trace.Msgs[i].Operations)
+		require.NotEmpty(t, trace.Msgs[i].Events)
+		for _, event := range trace.Msgs[i].Events {
+			require.Contains(t, event.Attributes, abci.EventAttribute{Key: "msg_index", Value: strconv.Itoa(i)})
+		}
+	}
+	require.Empty(t, trace.Post.Operations)
+	require.Empty(t, trace.Post.Events)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1777,6 +1877,159 @@ func TestABCI_PrepareProposal_PanicRecovery(t *testing.T) {
 	})
 }
 
+func TestABCI_Proposal_Lanes(t *testing.T) {
+	// MsgKeyValue txs may use at most half of the block gas
+	pool := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "keyvalue",
+			Match:         mempool.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			Mempool:       mempool.NewSenderNonceMempool(),
+		},
+		mempool.Lane{
+			Name:    "default",
+			Mempool: mempool.NewSenderNonceMempool(),
+		},
+	)
+	proposalOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewLaneProposalHandler(pool, bapp)
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+		bapp.SetProcessProposal(handler.ProcessProposalHandler())
+	}
+
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), proposalOpt)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	// set max block gas limit to 100, this will allow 10 txs of 10 gas each.
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// insert 10 txs in each lane, each with a gas limit of 10, the default lane
+	// txs first
+	_, _, addr := testdata.KeyTestPubAddr()
+	for i := int64(0); i < 20; i++ {
+		var msg sdk.Msg = &baseapptestutil.MsgCounter{Counter: i, Signer: addr.String()}
+		if i >= 10 {
+			msg = &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: addr.String()}
+		}
+
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetMemo("counter=" + strconv.FormatInt(i, 10) + "&failOnAnte=false")
+		builder.SetGasLimit(10)
+		setTxSignature(t, builder, uint64(i))
+
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+	}
+
+	res, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000, // large enough to ignore restriction
+		Height:     1,
+	})
+	require.NoError(t, err)
+
+	// the keyvalue lane comes first and is limited to 5 txs, the default lane
+	// fills the rest of the block
+	require.Len(t, res.Txs, 10)
+	for i, bz := range res.Txs {
+		tx, err := suite.txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+
+		_, isKeyValue := tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue)
+		require.Equal(t, i < 5, isKeyValue)
+	}
+
+	resProcess, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Txs: res.Txs, Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcess.Status)
+
+	// a default lane tx before a keyvalue lane tx is rejected
+	outOfOrder := append([][]byte{res.Txs[9]}, res.Txs[:9]...)
+	resProcess, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Txs: outOfOrder, Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcess.Status)
+
+	// more than half of the block gas used by the keyvalue lane is rejected
+	overLane := append([][]byte{res.Txs[0]}, res.Txs[:5]...)
+	resProcess, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Txs: overLane, Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcess.Status)
+}
+
+func TestABCI_Proposal_LanesMaxBytes(t *testing.T) {
+	// MsgKeyValue txs may use at most half of the block bytes
+	pool := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "keyvalue",
+			Match:         mempool.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			Mempool:       mempool.NewSenderNonceMempool(),
+		},
+		mempool.Lane{
+			Name:    "default",
+			Mempool: mempool.NewSenderNonceMempool(),
+		},
+	)
+	proposalOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewLaneProposalHandler(pool, bapp)
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+		bapp.SetProcessProposal(handler.ProcessProposalHandler())
+	}
+
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), proposalOpt)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	// insert 10 keyvalue txs of the same size
+	_, _, addr := testdata.KeyTestPubAddr()
+	var txSize int64
+	for i := int64(10); i < 20; i++ {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: addr.String()}))
+		builder.SetMemo("counter=" + strconv.FormatInt(i, 10) + "&failOnAnte=false")
+		setTxSignature(t, builder, uint64(i))
+
+		bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txSize = int64(len(bz))
+
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+	}
+
+	// the block may contain 10 txs, but MaxTxBytes, which excludes the block
+	// overhead, only 8
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 10 * txSize},
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: 8*txSize + 1,
+		Height:     1,
+	})
+	require.NoError(t, err)
+
+	// the lane byte limit is computed from the block max bytes, as in
+	// ProcessProposal, so the proposal is accepted
+	require.Len(t, res.Txs, 5)
+
+	resProcess, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Txs: res.Txs, Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcess.Status)
+
+	// more than half of the block bytes used by the keyvalue lane is rejected
+	overLane := append([][]byte{res.Txs[0]}, res.Txs...)
+	resProcess, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Txs: overLane, Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcess.Status)
+}
+
 func TestABCI_PrepareProposal_VoteExtensions(t *testing.T) {
 	// set up mocks
 	ctrl := gomock.NewController(t)
@@ -1890,6 +2143,180 @@ func TestABCI_PrepareProposal_VoteExtensions(t *testing.T) {
 	require.Equal(t, 0, len(resPrepareProposal.Txs))
 }
 
+func TestABCI_Proposal_InjectedVoteExtensions(t *testing.T) {
+	// set up mocks
+	ctrl := gomock.NewController(t)
+	valStore := mock.NewMockValidatorStore(ctrl)
+	privkey := secp256k1.GenPrivKey()
+	pubkey := privkey.PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	tmPk := cmtprotocrypto.PublicKey{
+		Sum: &cmtprotocrypto.PublicKey_Secp256K1{
+			Secp256K1: pubkey.Bytes(),
+		},
+	}
+
+	val1 := mock.NewMockValidator(ctrl)
+	val1.EXPECT().BondedTokens().Return(math.NewInt(667)).AnyTimes()
+	val1.EXPECT().CmtConsPublicKey().Return(tmPk, nil).AnyTimes()
+
+	consAddr := sdk.ConsAddress(addr.String())
+	valStore.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr.Bytes()).Return(val1, nil).AnyTimes()
+	valStore.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(1000)).AnyTimes()
+
+	// set up baseapp, aggregating the vote extensions before FinalizeBlock
+	var median math.LegacyDec
+	proposalOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewVoteExtensionProposalHandler(valStore, baseapp.NoOpPrepareProposal(), baseapp.NoOpProcessProposal())
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+		bapp.SetProcessProposal(handler.ProcessProposalHandler())
+		bapp.SetInjectVoteExtensions(true)
+		bapp.SetPreFinalizeBlockHook(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+			extCommit, err := baseapp.ExtractInjectedVoteExtensions(ctx, req.Txs)
+			if err != nil || extCommit == nil {
+				return err
+			}
+
+			median, err = baseapp.StakeWeightedMedian(*extCommit, func(ext []byte) (math.LegacyDec, error) {
+				return math.LegacyNewDecFromStr(string(ext))
+			})
+			return err
+		})
+	}
+
+	suite := NewBaseAppSuite(t, proposalOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		InitialHeight: 1,
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{
+				VoteExtensionsEnableHeight: 1,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// no vote extensions are injected at the vote extensions enable height
+	resPrepareProposal, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{MaxTxBytes: 1000, Height: 1})
+	require.NoError(t, err)
+	require.Empty(t, resPrepareProposal.Txs)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the vote extensions of height 1 are injected at height 2
+	marshalDelimitedFn := func(msg proto.Message) ([]byte, error) {
+		var buf bytes.Buffer
+		if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	ext := []byte("1.5")
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: ext,
+		Height:    1,
+		Round:     int64(0),
+		ChainId:   suite.baseApp.ChainID(),
+	}
+
+	bz, err := marshalDelimitedFn(&cve)
+	require.NoError(t, err)
+
+	extSig, err := privkey.Sign(bz)
+	require.NoError(t, err)
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{
+				Validator: abci.Validator{
+					Address: consAddr.Bytes(),
+					Power:   10,
+				},
+				VoteExtension:      ext,
+				ExtensionSignature: extSig,
+				BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+			},
+		},
+	}
+
+	tx := []byte("some-tx")
+	resPrepareProposal, err = suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes:      1000,
+		Height:          2,
+		Txs:             [][]byte{tx},
+		LocalLastCommit: extCommit,
+	})
+	require.NoError(t, err)
+	require.Len(t, resPrepareProposal.Txs, 2)
+	require.Equal(t, tx, resPrepareProposal.Txs[1])
+
+	extCommitBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, extCommitBz, resPrepareProposal.Txs[0])
+
+	resProcessProposal, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Txs: resPrepareProposal.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
+
+	// proposals without valid injected vote extensions are rejected
+	tampered := extCommit
+	tampered.Votes = []abci.ExtendedVoteInfo{extCommit.Votes[0]}
+	tampered.Votes[0].VoteExtension = []byte("1000")
+	tamperedBz, err := tampered.Marshal()
+	require.NoError(t, err)
+
+	for _, txs := range [][][]byte{nil, {tx}, {tamperedBz, tx}} {
+		resProcessProposal, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Txs: txs})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcessProposal.Status)
+	}
+
+	// the injected vote extensions are aggregated and not executed
+	resFinalizeBlock, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Txs: resPrepareProposal.Txs})
+	require.NoError(t, err)
+	require.Len(t, resFinalizeBlock.TxResults, 2)
+	require.True(t, resFinalizeBlock.TxResults[0].IsOK())
+	require.EqualValues(t, sdkerrors.ErrTxDecode.ABCICode(), resFinalizeBlock.TxResults[1].Code)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), median)
+}
+
+func TestWeightedMedian(t *testing.T) {
+	dec := math.LegacyNewDec
+
+	testCases := []struct {
+		name   string
+		values []baseapp.WeightedValue
+		exp    math.LegacyDec
+		expErr bool
+	}{
+		{"no values", nil, math.LegacyDec{}, true},
+		{"no positive weights", []baseapp.WeightedValue{{dec(1), 0}, {dec(2), -1}}, math.LegacyDec{}, true},
+		{"single value", []baseapp.WeightedValue{{dec(7), 1}}, dec(7), false},
+		{"heaviest value", []baseapp.WeightedValue{{dec(1), 1}, {dec(3), 5}, {dec(2), 1}}, dec(3), false},
+		{"half of the weight", []baseapp.WeightedValue{{dec(3), 1}, {dec(1), 2}, {dec(2), 1}}, dec(1), false},
+		{"ignored weights", []baseapp.WeightedValue{{dec(1), 1}, {dec(2), 2}, {dec(3), 0}, {dec(0), -5}}, dec(2), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			median, err := baseapp.WeightedMedian(tc.values)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, median)
+		})
+	}
+}
+
 func TestABCI_ProcessProposal_PanicRecovery(t *testing.T) {
 	processOpt := func(app *baseapp.BaseApp) {
 		app.SetProcessProposal(func(ctx sdk.Context, rpp *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
@@ -1910,6 +2337,151 @@ func TestABCI_ProcessProposal_PanicRecovery(t *testing.T) {
 	})
 }
 
+// TestABCI_Proposal_OptimisticExecution ensures that the block optimistically
+// executed in ProcessProposal is reused by FinalizeBlock when its hash matches,
+// and executed again otherwise.
+func TestABCI_Proposal_OptimisticExecution(t *testing.T) {
+	var executions int
+	beginBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			executions++
+			ctx.KVStore(capKey1).Set([]byte("height"), []byte(strconv.FormatInt(ctx.BlockHeight(), 10)))
+			return sdk.BeginBlock{}, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution(), beginBlockerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the first block is never executed optimistically
+	_, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 1, Hash: []byte("hash1")})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Hash: []byte("hash1")})
+	require.NoError(t, err)
+	require.Equal(t, 1, executions)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the result of the optimistic execution is reused when the hash matches
+	res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 2, Hash: []byte("hash2")})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+	resFinalize, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Hash: []byte("hash2")})
+	require.NoError(t, err)
+	require.NotEmpty(t, resFinalize.AppHash)
+	require.Equal(t, 2, executions)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the optimistic execution is aborted and the block executed again when the
+	// hash does not match
+	_, err = suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{Height: 3, Hash: []byte("hash3")})
+	require.NoError(t, err)
+	resFinalize, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 3, Hash: []byte("other-hash3")})
+	require.NoError(t, err)
+	require.Equal(t, 4, executions)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	store := suite.baseApp.NewContext(true).KVStore(capKey1)
+	require.Equal(t, []byte("3"), store.Get([]byte("height")))
+}
+
+// TestABCI_FinalizeBlock_ParallelTxExecution ensures that executing the txs of
+// a block in parallel, with conflicting and failing txs, yields the same tx
+// results and app hashes as executing them sequentially.
+func TestABCI_FinalizeBlock_ParallelTxExecution(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100_000))
+			counter, _ := parseTxMemo(t, tx)
+			store := ctx.KVStore(capKey1)
+
+			// every fifth tx iterates over all the sequences
+			if counter%5 == 0 {
+				iter := storetypes.KVStorePrefixIterator(store, []byte("seq/"))
+				for ; iter.Valid(); iter.Next() {
+					_ = iter.Value()
+				}
+				iter.Close()
+			}
+
+			// the txs sharing a sequence conflict, and fail depending on it
+			key := []byte(fmt.Sprintf("seq/%d", counter%3))
+			seq := getIntFromStore(t, store, key)
+			if seq%3 == 2 && counter%2 == 1 {
+				return ctx, errors.New("unlucky sequence")
+			}
+			setIntOnStore(store, key, seq+1)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent("seq", sdk.NewAttribute("value", strconv.FormatInt(seq, 10))))
+			return ctx, nil
+		})
+	}
+
+	// executes the same blocks on the given app and returns their results
+	_, _, addr := testdata.KeyTestPubAddr()
+	executeBlocks := func(suite *BaseAppSuite, maxGas int64) []*abci.ResponseFinalizeBlock {
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxGas: maxGas},
+			},
+		})
+		require.NoError(t, err)
+
+		var responses []*abci.ResponseFinalizeBlock
+		for height := int64(1); height <= 3; height++ {
+			var txs [][]byte
+			for i := int64(0); i < 20; i++ {
+				counter := height*100 + i
+				builder := suite.txConfig.NewTxBuilder()
+				require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+					Key:    []byte(fmt.Sprintf("key/%d", counter%7)),
+					Value:  []byte(strconv.FormatInt(counter, 10)),
+					Signer: addr.String(),
+				}))
+				builder.SetMemo("counter=" + strconv.FormatInt(counter, 10) + "&failOnAnte=false")
+				setTxSignature(t, builder, uint64(counter))
+
+				bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+				require.NoError(t, err)
+				txs = append(txs, bz)
+			}
+			txs = append(txs, []byte("invalid tx"))
+
+			res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: txs})
+			require.NoError(t, err)
+			_, err = suite.baseApp.Commit()
+			require.NoError(t, err)
+
+			responses = append(responses, res)
+		}
+
+		return responses
+	}
+
+	testCases := map[string]int64{
+		"no block gas limit": -1,
+		"block gas limit":    50_000,
+	}
+
+	for name, maxGas := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sequential := executeBlocks(NewBaseAppSuite(t, anteOpt), maxGas)
+			parallel := executeBlocks(NewBaseAppSuite(t, anteOpt, baseapp.SetParallelTxExecution(4)), maxGas)
+
+			// both the tx results and the app hashes are identical
+			require.Equal(t, sequential, parallel)
+		})
+	}
+}
+
 // TestABCI_Proposal_Reset_State ensures that state is reset between runs of
 // PrepareProposal and ProcessProposal in case they are called multiple times.
 // This is only valid for heights > 1, given that on height 1 we always set the
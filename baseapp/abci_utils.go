@@ -3,6 +3,7 @@ package baseapp
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -139,6 +140,235 @@ func ValidateVoteExtensions(
 	return nil
 }
 
+// voteExtensionsInjected returns whether the extended commit info is injected
+// in the proposal of the given height by VoteExtensionProposalHandler, i.e.
+// whether the votes of the previous height carry vote extensions.
+func voteExtensionsInjected(cp cmtproto.ConsensusParams, height int64) bool {
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// VoteExtensionProposalHandler wraps the PrepareProposal and ProcessProposal
+// handlers of an application to inject the extended commit info of the previous
+// height, which holds the vote extensions, as the first transaction of the
+// proposals, so that it can be read by the application in FinalizeBlock with
+// ExtractInjectedVoteExtensions.
+//
+// The application must also call BaseApp.SetInjectVoteExtensions so that the
+// injected extended commit info is not executed as a transaction.
+type VoteExtensionProposalHandler struct {
+	valStore        ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+func NewVoteExtensionProposalHandler(
+	valStore ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) VoteExtensionProposalHandler {
+	return VoteExtensionProposalHandler{
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler which, when vote
+// extensions are available, verifies the local last commit with
+// ValidateVoteExtensions and injects it as the first transaction of the
+// proposal. The wrapped handler selects the other transactions within the
+// remaining RequestPrepareProposal.MaxTxBytes.
+func (h VoteExtensionProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsInjected(ctx.ConsensusParams(), req.Height) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		extCommitBz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode extended commit info: %w", err)
+		}
+
+		innerReq := *req
+		innerReq.MaxTxBytes -= int64(len(extCommitBz))
+
+		resp, err := h.prepareProposal(ctx, &innerReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: append([][]byte{extCommitBz}, resp.Txs...)}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler which, when vote
+// extensions are available, rejects the proposal if its first transaction is not
+// an extended commit info passing ValidateVoteExtensions. The wrapped handler
+// processes the other transactions.
+func (h VoteExtensionProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsInjected(ctx.ConsensusParams(), req.Height) {
+			return h.processProposal(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		innerReq := *req
+		innerReq.Txs = req.Txs[1:]
+
+		return h.processProposal(ctx, &innerReq)
+	}
+}
+
+// ExtractInjectedVoteExtensions returns the extended commit info injected by
+// VoteExtensionProposalHandler in the transactions of the proposal of the block
+// of the context, or nil if vote extensions are not available at that height. It
+// is meant to be called from a PreFinalizeBlockHook.
+func ExtractInjectedVoteExtensions(ctx sdk.Context, txs [][]byte) (*abci.ExtendedCommitInfo, error) {
+	if !voteExtensionsInjected(ctx.ConsensusParams(), ctx.BlockHeight()) {
+		return nil, nil
+	}
+
+	if len(txs) == 0 {
+		return nil, errors.New("missing injected extended commit info")
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(txs[0]); err != nil {
+		return nil, fmt.Errorf("failed to decode injected extended commit info: %w", err)
+	}
+
+	return &extCommit, nil
+}
+
+// WeightedValue is a numeric value with the weight it has in an aggregate.
+type WeightedValue struct {
+	Value  math.LegacyDec
+	Weight int64
+}
+
+// WeightedMedian returns the weighted median of the values, i.e. the smallest
+// value such that the values lower or equal to it hold at least half of the
+// total weight. Values with a non-positive weight are ignored. It returns an
+// error if there is no value to aggregate.
+func WeightedMedian(values []WeightedValue) (math.LegacyDec, error) {
+	sorted := make([]WeightedValue, 0, len(values))
+	totalWeight := math.ZeroInt()
+	for _, v := range values {
+		if v.Weight <= 0 {
+			continue
+		}
+
+		sorted = append(sorted, v)
+		totalWeight = totalWeight.AddRaw(v.Weight)
+	}
+
+	if len(sorted) == 0 {
+		return math.LegacyDec{}, errors.New("no values to aggregate")
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value.LT(sorted[j].Value)
+	})
+
+	weight := math.ZeroInt()
+	for _, v := range sorted {
+		weight = weight.AddRaw(v.Weight)
+		if weight.MulRaw(2).GTE(totalWeight) {
+			return v.Value, nil
+		}
+	}
+
+	// unreachable, the weight of all the values is the total weight
+	return sorted[len(sorted)-1].Value, nil
+}
+
+// StakeWeightedMedians decodes the vote extensions of the extended commit info
+// into numeric values by key, e.g. prices by asset, and returns the median of
+// the values of each key weighted by the voting power of the validators. Votes
+// which are not commit votes and vote extensions which fail to decode are
+// ignored. It returns an error if no vote extension could be decoded.
+func StakeWeightedMedians(
+	extCommit abci.ExtendedCommitInfo,
+	decode func(voteExtension []byte) (map[string]math.LegacyDec, error),
+) (map[string]math.LegacyDec, error) {
+	valuesByKey := make(map[string][]WeightedValue)
+	decoded := false
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		values, err := decode(vote.VoteExtension)
+		if err != nil {
+			continue
+		}
+
+		decoded = true
+		for key, value := range values {
+			valuesByKey[key] = append(valuesByKey[key], WeightedValue{Value: value, Weight: vote.Validator.Power})
+		}
+	}
+
+	if !decoded {
+		return nil, errors.New("no vote extension to aggregate")
+	}
+
+	medians := make(map[string]math.LegacyDec, len(valuesByKey))
+	for key, values := range valuesByKey {
+		median, err := WeightedMedian(values)
+		if err != nil {
+			continue
+		}
+
+		medians[key] = median
+	}
+
+	return medians, nil
+}
+
+// StakeWeightedMedian decodes the vote extensions of the extended commit info
+// into a numeric value and returns their median weighted by the voting power of
+// the validators, as StakeWeightedMedians does for a single key.
+func StakeWeightedMedian(
+	extCommit abci.ExtendedCommitInfo,
+	decode func(voteExtension []byte) (math.LegacyDec, error),
+) (math.LegacyDec, error) {
+	medians, err := StakeWeightedMedians(extCommit, func(voteExtension []byte) (map[string]math.LegacyDec, error) {
+		value, err := decode(voteExtension)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]math.LegacyDec{"": value}, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	median, ok := medians[""]
+	if !ok {
+		return math.LegacyDec{}, errors.New("no values to aggregate")
+	}
+
+	return median, nil
+}
+
 type (
 	// ProposalTxVerifier defines the interface that is implemented by BaseApp,
 	// that any custom ABCI PrepareProposal and ProcessProposal handler can use
@@ -306,6 +536,175 @@ func (h DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHand
 	}
 }
 
+// LaneProposalHandler defines the ABCI PrepareProposal and ProcessProposal
+// handlers of an application using a mempool.LaneMempool, which reserve to the
+// transactions of each lane at most its share of the block space and order the
+// transactions of a proposal by lane.
+type LaneProposalHandler struct {
+	mempool    *mempool.LaneMempool
+	txVerifier ProposalTxVerifier
+}
+
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) LaneProposalHandler {
+	return LaneProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler selecting the
+// transactions lane by lane, in the order of the lanes. The valid transactions
+// of each lane are added to the proposal, as in DefaultProposalHandler, until
+// the lane reaches its maximum block space, or the block reaches
+// RequestPrepareProposal.MaxTxBytes or its maximum gas. As in
+// ProcessProposalHandler, the maximum block space of a lane is computed from the
+// maximum block bytes and gas of the consensus params, so that both handlers
+// enforce the same limits.
+func (h LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var maxBlockBytes, maxBlockGas int64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockBytes = b.MaxBytes
+			maxBlockGas = b.MaxGas
+		}
+
+		var (
+			selectedTxs  [][]byte
+			totalTxBytes int64
+			totalTxGas   uint64
+		)
+
+		for _, lane := range h.mempool.Lanes() {
+			laneMaxTxBytes, laneMaxGas := laneLimits(lane, maxBlockBytes, maxBlockGas)
+
+			var (
+				laneTxBytes int64
+				laneTxGas   uint64
+			)
+
+			for iterator := lane.Mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+				memTx := iterator.Tx()
+
+				bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					err := h.mempool.Remove(memTx)
+					if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						panic(err)
+					}
+
+					continue
+				}
+
+				var txGasLimit uint64
+				txSize := int64(len(bz))
+
+				gasTx, ok := memTx.(GasTx)
+				if ok {
+					txGasLimit = gasTx.GetGas()
+				}
+
+				// only add the transaction to the proposal if both the lane and the
+				// block have enough capacity
+				fitsBytes := (txSize+totalTxBytes) < req.MaxTxBytes && (maxBlockBytes <= 0 || (txSize+laneTxBytes) <= laneMaxTxBytes)
+				fitsGas := maxBlockGas <= 0 || ((txGasLimit+totalTxGas) <= uint64(maxBlockGas) && (txGasLimit+laneTxGas) <= laneMaxGas)
+				if fitsBytes && fitsGas {
+					totalTxBytes += txSize
+					laneTxBytes += txSize
+					totalTxGas += txGasLimit
+					laneTxGas += txGasLimit
+					selectedTxs = append(selectedTxs, bz)
+				}
+
+				// Check if the lane has reached its capacity. If so, we cannot select
+				// any more transactions from it.
+				if (maxBlockBytes > 0 && laneTxBytes >= laneMaxTxBytes) || totalTxBytes >= req.MaxTxBytes ||
+					(maxBlockGas > 0 && (laneTxGas >= laneMaxGas || totalTxGas >= uint64(maxBlockGas))) {
+					break
+				}
+			}
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: selectedTxs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler which, on top of the
+// checks of DefaultProposalHandler, rejects the proposal if its transactions are
+// not ordered by lane, if a transaction matches no lane, or if the transactions
+// of a lane exceed its maximum block space, computed from the maximum block
+// bytes and gas of the consensus params.
+func (h LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var maxBlockBytes, maxBlockGas int64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockBytes = b.MaxBytes
+			maxBlockGas = b.MaxGas
+		}
+
+		lanes := h.mempool.Lanes()
+		laneTxBytes := make([]int64, len(lanes))
+		laneTxGas := make([]uint64, len(lanes))
+
+		var (
+			totalTxGas  uint64
+			currentLane int
+		)
+
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			// the lanes of the transactions must be in the order of the lanes
+			i := h.mempool.LaneIndex(tx)
+			if i < currentLane {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			currentLane = i
+
+			laneMaxTxBytes, laneMaxGas := laneLimits(lanes[i], maxBlockBytes, maxBlockGas)
+
+			if maxBlockBytes > 0 {
+				laneTxBytes[i] += int64(len(txBytes))
+				if laneTxBytes[i] > laneMaxTxBytes {
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+			}
+
+			if maxBlockGas > 0 {
+				var txGasLimit uint64
+				gasTx, ok := tx.(GasTx)
+				if ok {
+					txGasLimit = gasTx.GetGas()
+				}
+
+				totalTxGas += txGasLimit
+				laneTxGas[i] += txGasLimit
+				if totalTxGas > uint64(maxBlockGas) || laneTxGas[i] > laneMaxGas {
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// laneLimits returns the maximum bytes and gas the transactions of the lane may
+// use in a block of the given maximum bytes and gas. A lane without a maximum
+// block space may use the whole block.
+func laneLimits(lane mempool.Lane, maxBlockBytes, maxBlockGas int64) (int64, uint64) {
+	if lane.MaxBlockSpace.IsNil() || lane.MaxBlockSpace.IsZero() {
+		return maxBlockBytes, uint64(maxBlockGas)
+	}
+
+	maxBytes := lane.MaxBlockSpace.MulInt64(maxBlockBytes).TruncateInt64()
+	maxGas := lane.MaxBlockSpace.MulInt64(maxBlockGas).TruncateInt64()
+
+	return maxBytes, uint64(maxGas)
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
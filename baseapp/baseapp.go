@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -19,9 +20,12 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -91,6 +95,11 @@ type BaseApp struct {
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// stores the state of each block to serve the historical queries, if set
+	stateStorage *storage.Store
+	// keys of the stores only listened to write their changes into the state storage
+	stateStorageKeys map[string]bool
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
@@ -123,6 +132,10 @@ type BaseApp struct {
 	// application parameter store.
 	paramStore ParamStore
 
+	// gasScheduleStore is used to query for the gas schedule of the store
+	// operations charged to the transactions.
+	gasScheduleStore GasScheduleStore
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -168,6 +181,24 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// injectVoteExtensions defines whether the first transaction of the
+	// proposals is the extended commit info injected by
+	// VoteExtensionProposalHandler, which must not be executed.
+	injectVoteExtensions bool
+
+	// optimisticExec contains the context required for Optimistic Execution,
+	// including the goroutine handling. This is nil when OE is disabled.
+	optimisticExec *oe.OptimisticExecution
+
+	// parallelTxWorkers is the number of goroutines executing the transactions
+	// of a block speculatively. The transactions are executed sequentially
+	// when it is 0.
+	parallelTxWorkers int
+
+	// mempoolMtx guards the removal of the txs delivered concurrently from
+	// the mempool.
+	mempoolMtx *sync.Mutex
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
@@ -192,6 +223,7 @@ func NewBaseApp(
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
+		mempoolMtx:       &sync.Mutex{},
 	}
 
 	for _, option := range options {
@@ -407,9 +439,42 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	if app.stateStorage != nil {
+		if err := app.initStateStorage(); err != nil {
+			return err
+		}
+	}
+
 	return app.cms.GetPruning().Validate()
 }
 
+// initStateStorage listens to the committed stores to write their changes into the state
+// storage, which is synced with the loaded state, and serves the queries from it.
+func (app *BaseApp) initStateStorage() error {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("state storage requires a rootmulti.Store, got %T", app.cms)
+	}
+
+	// the changes of the stores not exposed to the ABCI listeners are not streamed to them
+	keys := storage.CommittedStoreKeys(rms)
+	app.stateStorageKeys = make(map[string]bool)
+	for _, key := range keys {
+		if !app.cms.ListeningEnabled(key) {
+			app.stateStorageKeys[key.Name()] = true
+		}
+	}
+	app.cms.AddListeners(keys)
+
+	if err := app.stateStorage.Sync(rms); err != nil {
+		return fmt.Errorf("failed to sync the state storage: %w", err)
+	}
+	if app.qms == nil {
+		app.qms = storage.NewMultiStore(rms, app.stateStorage)
+	}
+	return nil
+}
+
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
@@ -501,6 +566,22 @@ func (app *BaseApp) GetConsensusParams(ctx sdk.Context) cmtproto.ConsensusParams
 	return cp
 }
 
+// GetGasSchedule returns the current gas schedule from the BaseApp's
+// GasScheduleStore. If the BaseApp has no GasScheduleStore defined, the default
+// gas schedule is returned.
+func (app *BaseApp) GetGasSchedule(ctx sdk.Context) sdk.GasSchedule {
+	if app.gasScheduleStore == nil {
+		return sdk.DefaultGasSchedule()
+	}
+
+	schedule, err := app.gasScheduleStore.GetGasSchedule(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to get gas schedule: %w", err))
+	}
+
+	return schedule
+}
+
 // StoreConsensusParams sets the consensus parameters to the BaseApp's param
 // store.
 //
@@ -627,7 +708,9 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 		WithTxBytes(txBytes)
 	// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
 
-	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.
+		WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithGasSchedule(app.GetGasSchedule(ctx))
 
 	if mode == execModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
@@ -635,6 +718,7 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 
 	if mode == execModeSimulate {
 		ctx, _ = ctx.CacheContext()
+		ctx = ctx.WithExecMode(sdk.ExecModeSimulate)
 	}
 
 	return ctx
@@ -686,7 +770,13 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) sdk.BeginBlock {
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	return app.execTxResult(gInfo, result, anteEvents, err)
+}
+
+// execTxResult returns the ExecTxResult of a tx delivered in FinalizeBlock
+// from the outputs of runTx.
+func (app *BaseApp) execTxResult(gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) *abci.ExecTxResult {
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
@@ -698,7 +788,6 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
@@ -756,12 +845,17 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is runTx executing the tx with the given context, which the
+// parallel tx executor uses to run txs on their own branch of the block state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -865,7 +959,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
+		app.mempoolMtx.Lock()
 		err = app.mempool.Remove(tx)
+		app.mempoolMtx.Unlock()
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -893,6 +989,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// We clear this to correctly order events without duplicates.
 			// Note that the state is still preserved.
 			postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+			if mode == execModeSimulate {
+				setSimulationStep(postCtx, simulationStepPost)
+			}
 
 			newCtx, err := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
 			if err != nil {
@@ -938,6 +1037,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 
+		if mode == execModeSimulate {
+			setSimulationStep(ctx, i)
+		}
+
 		// ADR 031 request type routing
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
@@ -1057,5 +1160,8 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 
 // Close is called in start cmd to gracefully cleanup resources.
 func (app *BaseApp) Close() error {
+	if app.stateStorage != nil {
+		return app.stateStorage.Close()
+	}
 	return nil
 }
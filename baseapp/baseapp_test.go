@@ -431,6 +431,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		suite.baseApp.SetFauxMerkleMode()
 	})
+	require.Panics(t, func() {
+		suite.baseApp.SetGasScheduleStore(nil)
+	})
 }
 
 func TestTxDecoder(t *testing.T) {
@@ -620,6 +623,33 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, ctx.MinGasPrices())
 }
 
+type gasScheduleStore sdk.GasSchedule
+
+func (gs gasScheduleStore) GetGasSchedule(context.Context) (sdk.GasSchedule, error) {
+	return sdk.GasSchedule(gs), nil
+}
+
+func TestGasSchedule(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DefaultGasSchedule(), suite.baseApp.GetContextForCheckTx(nil).GasSchedule())
+
+	schedule := sdk.DefaultGasSchedule()
+	schedule.Stores = map[string]storetypes.GasConfig{capKey1.Name(): {ReadCostFlat: 10}}
+	suite = NewBaseAppSuite(t, func(app *baseapp.BaseApp) {
+		app.SetGasScheduleStore(gasScheduleStore(schedule))
+	})
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	ctx := suite.baseApp.GetContextForCheckTx(nil).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.Equal(t, schedule, ctx.GasSchedule())
+
+	ctx.KVStore(capKey1).Get([]byte("key"))
+	require.Equal(t, storetypes.Gas(10), ctx.GasMeter().GasConsumed())
+}
+
 func TestGetMaximumBlockGas(t *testing.T) {
 	suite := NewBaseAppSuite(t)
 	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{})
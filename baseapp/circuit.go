@@ -1,8 +1,22 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is an optional extension of CircuitBreaker which is given
+// the message to execute instead of only its type URL, allowing the circuit
+// breaker to disable messages depending on their fields or to rate limit them.
+// When the circuit breaker implements it, IsMsgAllowed is called in place of
+// IsAllowed before each message execution.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+}
@@ -135,7 +135,16 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 
 			if msr.circuitBreaker != nil {
 				msgURL := sdk.MsgTypeURL(msg)
-				isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+
+				var (
+					isAllowed bool
+					err       error
+				)
+				if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+					isAllowed, err = cb.IsMsgAllowed(ctx, msg)
+				} else {
+					isAllowed, err = msr.circuitBreaker.IsAllowed(ctx, msgURL)
+				}
 				if err != nil {
 					return nil, err
 				}
@@ -5,17 +5,20 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -165,3 +168,67 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+// dogCircuitBreaker disables all the msgs of a type URL, or only the
+// MsgCreateDog msgs creating a dog with a given name.
+type dogCircuitBreaker struct {
+	disabledURL  string
+	disabledName string
+}
+
+func (cb dogCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return typeURL != cb.disabledURL, nil
+}
+
+func (cb dogCircuitBreaker) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	if dog, ok := msg.(*testdata.MsgCreateDog); ok && dog.Dog.Name == cb.disabledName {
+		return false, nil
+	}
+	return cb.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	spot := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	rex := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}}
+	handler := app.MsgServiceRouter().Handler(spot)
+
+	testCases := []struct {
+		name     string
+		cb       baseapp.CircuitBreaker
+		msg      sdk.Msg
+		disabled bool
+	}{
+		{"msg filtered", dogCircuitBreaker{disabledName: "Spot"}, spot, true},
+		{"msg not filtered", dogCircuitBreaker{disabledName: "Spot"}, rex, false},
+		{"type URL disabled", dogCircuitBreaker{disabledURL: sdk.MsgTypeURL(rex)}, rex, true},
+	}
+
+	for _, tc := range testCases {
+		app.SetCircuitBreaker(tc.cb)
+		_, err := handler(ctx, tc.msg)
+		if tc.disabled {
+			require.ErrorContains(t, err, "circuit breaker disables execution of this message", tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
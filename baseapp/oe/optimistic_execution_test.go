@@ -0,0 +1,59 @@
+package oe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func testFinalizeBlock(_ context.Context, _ *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	return nil, errors.New("test error")
+}
+
+func TestOptimisticExecution(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock)
+	require.True(t, oe.Enabled())
+	require.False(t, oe.Initialized())
+
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("test")})
+	require.True(t, oe.Initialized())
+	require.False(t, oe.AbortIfNeeded([]byte("test")))
+	require.True(t, oe.AbortIfNeeded([]byte("wrong_hash")))
+
+	resp, err := oe.WaitResult()
+	require.Nil(t, resp)
+	require.EqualError(t, err, "test error")
+
+	oe.Reset()
+	require.False(t, oe.Initialized())
+	oe.Abort()
+
+	// a disabled optimistic execution is a nil one
+	var disabled *OptimisticExecution
+	require.False(t, disabled.Enabled())
+	require.False(t, disabled.Initialized())
+	require.False(t, disabled.AbortIfNeeded([]byte("test")))
+	disabled.Abort()
+}
+
+func TestOptimisticExecution_Cancel(t *testing.T) {
+	started := make(chan struct{})
+	fn := func(ctx context.Context, _ *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	oe := NewOptimisticExecution(log.NewNopLogger(), fn)
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("test")})
+	<-started
+	oe.Abort()
+
+	_, err := oe.WaitResult()
+	require.ErrorIs(t, err, context.Canceled)
+}
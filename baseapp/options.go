@@ -10,8 +10,10 @@ import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +28,16 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning strategies of the stores, by store name,
+// overriding the pruning strategy set by SetPruning for these stores.
+func SetStorePruning(opts map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for storeName, storeOpts := range opts {
+			bapp.cms.SetStorePruning(storeName, storeOpts)
+		}
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -84,6 +96,11 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetStateStorage sets the state storage serving the historical queries on BaseApp.
+func SetStateStorage(stateStorage *storage.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateStorage(stateStorage) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
@@ -111,6 +128,16 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetGasScheduleStore sets the store of the gas schedule charged to the
+// transactions on the BaseApp.
+func (app *BaseApp) SetGasScheduleStore(gs GasScheduleStore) {
+	if app.sealed {
+		panic("SetGasScheduleStore() on sealed BaseApp")
+	}
+
+	app.gasScheduleStore = gs
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
@@ -188,6 +215,35 @@ func (app *BaseApp) SetPreFinalizeBlockHook(hook sdk.PreFinalizeBlockHook) {
 	app.preFinalizeBlockHook = hook
 }
 
+// SetInjectVoteExtensions sets whether the proposals hold the extended commit
+// info injected by VoteExtensionProposalHandler as their first transaction, in
+// which case FinalizeBlock neither decodes nor executes it.
+func (app *BaseApp) SetInjectVoteExtensions(inject bool) {
+	if app.sealed {
+		panic("SetInjectVoteExtensions() on sealed BaseApp")
+	}
+
+	app.injectVoteExtensions = inject
+}
+
+// SetOptimisticExecution enables optimistic execution: once ProcessProposal
+// accepts a proposal, the block is executed in a background goroutine, whose
+// result is returned by FinalizeBlock if it is called for the same proposal.
+func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
+	}
+}
+
+// SetParallelTxExecution executes the txs of the blocks speculatively on the
+// given number of goroutines, each tx on its own branch of the block state, and
+// executes again the txs conflicting with the txs preceding them in the block.
+// The results are identical to a sequential execution as long as the txs only
+// share state through the stores and get their gas meter from the AnteHandler.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.parallelTxWorkers = workers }
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -252,10 +308,28 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		app.snapshotManager = nil
 		return
 	}
-	app.cms.SetSnapshotInterval(opts.Interval)
+	if opts.DifferentialInterval > 0 {
+		// differential snapshots are taken between the full ones, the heights of both must be retained
+		app.cms.SetSnapshotInterval(opts.DifferentialInterval)
+	} else {
+		app.cms.SetSnapshotInterval(opts.Interval)
+	}
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetStateStorage sets the state storage the state of each block is written into, to serve
+// the queries of the historical versions without reading them from the committed stores.
+// It requires the CommitMultiStore to be a rootmulti.Store. The state of the version the
+// app is loaded at is imported into the state storage if it is empty or behind, e.g. once
+// a snapshot is restored, in which case the versions it stored before are dropped. The
+// state storage is closed by Close.
+func (app *BaseApp) SetStateStorage(stateStorage *storage.Store) {
+	if app.sealed {
+		panic("SetStateStorage() on sealed BaseApp")
+	}
+	app.stateStorage = stateStorage
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,339 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ storetypes.KVStore         = (*trackedKVStore)(nil)
+	_ storetypes.CacheMultiStore = (*txBranch)(nil)
+)
+
+// keyRange is the domain [start, end) of an iterator, a nil start or end
+// leaving the domain unbounded on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// trackedKVStore wraps a KVStore and records the keys read, the domains
+// iterated and the keys written through it.
+type trackedKVStore struct {
+	storetypes.KVStore
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+}
+
+func newTrackedKVStore(parent storetypes.KVStore) *trackedKVStore {
+	return &trackedKVStore{
+		KVStore: parent,
+		reads:   make(map[string]struct{}),
+		writes:  make(map[string]struct{}),
+	}
+}
+
+func (s *trackedKVStore) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+	return s.KVStore.Get(key)
+}
+
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+	return s.KVStore.Has(key)
+}
+
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.KVStore.Set(key, value)
+}
+
+func (s *trackedKVStore) Delete(key []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.KVStore.Delete(key)
+}
+
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s *trackedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackedKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// txBranch is a branch of a multi-store whose substores are branched on first
+// access. When tracked, the accesses to the substores of the parent are
+// recorded, which allows to detect the conflicts between txs executed
+// speculatively on their own branch of the block state.
+type txBranch struct {
+	parent  storetypes.MultiStore
+	stores  map[storetypes.StoreKey]storetypes.CacheKVStore
+	tracked map[storetypes.StoreKey]*trackedKVStore
+}
+
+func newTxBranch(parent storetypes.MultiStore, tracked bool) *txBranch {
+	b := &txBranch{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+	if tracked {
+		b.tracked = make(map[storetypes.StoreKey]*trackedKVStore)
+	}
+
+	return b
+}
+
+func (b *txBranch) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (b *txBranch) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore()
+}
+
+func (b *txBranch) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheMultiStore()
+}
+
+func (b *txBranch) CacheMultiStore() storetypes.CacheMultiStore {
+	return newTxBranch(b, false)
+}
+
+func (b *txBranch) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errors.New("cannot branch a tx branch at a version")
+}
+
+func (b *txBranch) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+func (b *txBranch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if store, ok := b.stores[key]; ok {
+		return store
+	}
+
+	parent := b.parent.GetKVStore(key)
+	if b.tracked != nil {
+		tracked := newTrackedKVStore(parent)
+		b.tracked[key] = tracked
+		parent = tracked
+	}
+
+	store := cachekv.NewStore(parent)
+	b.stores[key] = store
+
+	return store
+}
+
+func (b *txBranch) TracingEnabled() bool {
+	return false
+}
+
+func (b *txBranch) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return b
+}
+
+func (b *txBranch) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return b
+}
+
+func (b *txBranch) LatestVersion() int64 {
+	return b.parent.LatestVersion()
+}
+
+// Write writes the substores to the parent, in the order of their names.
+func (b *txBranch) Write() {
+	keys := make([]storetypes.StoreKey, 0, len(b.stores))
+	for key := range b.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	for _, key := range keys {
+		b.stores[key].Write()
+	}
+}
+
+// speculativeTx is the outcome of a tx executed speculatively on its own
+// branch of the block state.
+type speculativeTx struct {
+	branch   *txBranch
+	blockGas uint64
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// parallelExecution executes the txs of a block in two phases. The txs are
+// first executed speculatively and concurrently, each on a tracked branch of
+// the block state. They are then committed in the block order: the branch of a
+// tx is written to the block state unless the tx read a key, or iterated a
+// domain, written by a tx committed before it, or its block gas would have
+// exceeded the block gas limit, in which case the tx is executed again on the
+// block state. The results are thus identical to a sequential execution.
+type parallelExecution struct {
+	app     *BaseApp
+	txs     []*speculativeTx
+	written map[storetypes.StoreKey]map[string]struct{}
+}
+
+// executeTxsSpeculatively executes the txs concurrently on the given number of
+// workers, skipping the nil ones. It returns ctx.Err() if ctx is canceled.
+func (app *BaseApp) executeTxsSpeculatively(ctx context.Context, txs [][]byte, workers int) (*parallelExecution, error) {
+	exec := &parallelExecution{
+		app:     app,
+		txs:     make([]*speculativeTx, len(txs)),
+		written: make(map[storetypes.StoreKey]map[string]struct{}),
+	}
+
+	indexes := make(chan int, len(txs))
+	for i, tx := range txs {
+		if tx != nil {
+			indexes <- i
+		}
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					return
+				}
+
+				exec.txs[i] = exec.speculate(txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return exec, nil
+}
+
+// txContext returns the context executing a tx on the given branch of the
+// block state.
+func (e *parallelExecution) txContext(branch *txBranch, blockGasMeter storetypes.GasMeter, txBytes []byte) sdk.Context {
+	ctx := e.app.finalizeBlockState.ctx.
+		WithMultiStore(branch).
+		WithBlockGasMeter(blockGasMeter).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithTxBytes(txBytes)
+
+	return ctx.
+		WithConsensusParams(e.app.GetConsensusParams(ctx)).
+		WithGasSchedule(e.app.GetGasSchedule(ctx))
+}
+
+// speculate executes the tx on its own branch of the block state, with an
+// unlimited block gas meter recording its block gas.
+func (e *parallelExecution) speculate(txBytes []byte) *speculativeTx {
+	branch := newTxBranch(e.app.finalizeBlockState.ctx.MultiStore(), true)
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+
+	tx := &speculativeTx{branch: branch}
+	tx.gInfo, tx.result, tx.anteEvents, tx.err = e.app.runTxWithContext(
+		e.txContext(branch, blockGasMeter, txBytes), execModeFinalize, txBytes,
+	)
+	tx.blockGas = blockGasMeter.GasConsumed()
+
+	return tx
+}
+
+// commit writes the tx of the given index to the block state, executing it
+// again if its speculative execution is invalid, and returns its result.
+func (e *parallelExecution) commit(i int, txBytes []byte) *abci.ExecTxResult {
+	tx := e.txs[i]
+	blockGasMeter := e.app.finalizeBlockState.ctx.BlockGasMeter()
+
+	if e.conflicts(tx.branch) || blockGasMeter.IsOutOfGas() || tx.blockGas > blockGasMeter.GasRemaining() {
+		tx = &speculativeTx{branch: newTxBranch(e.app.finalizeBlockState.ctx.MultiStore(), true)}
+		tx.gInfo, tx.result, tx.anteEvents, tx.err = e.app.runTxWithContext(
+			e.txContext(tx.branch, blockGasMeter, txBytes), execModeFinalize, txBytes,
+		)
+	} else {
+		blockGasMeter.ConsumeGas(tx.blockGas, "block gas meter")
+	}
+
+	tx.branch.Write()
+	for key, store := range tx.branch.tracked {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		written, ok := e.written[key]
+		if !ok {
+			written = make(map[string]struct{}, len(store.writes))
+			e.written[key] = written
+		}
+		for k := range store.writes {
+			written[k] = struct{}{}
+		}
+	}
+
+	return e.app.execTxResult(tx.gInfo, tx.result, tx.anteEvents, tx.err)
+}
+
+// conflicts returns whether the branch read a key, or iterated a domain,
+// written by the txs committed so far.
+func (e *parallelExecution) conflicts(branch *txBranch) bool {
+	for key, store := range branch.tracked {
+		written := e.written[key]
+		if len(written) == 0 {
+			continue
+		}
+
+		for k := range store.reads {
+			if _, ok := written[k]; ok {
+				return true
+			}
+		}
+
+		for _, r := range store.ranges {
+			for k := range written {
+				if r.contains([]byte(k)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ParamStore defines the interface the parameter store used by the BaseApp must
@@ -13,3 +15,9 @@ type ParamStore interface {
 	Has(ctx context.Context) (bool, error)
 	Set(ctx context.Context, cp cmtproto.ConsensusParams) error
 }
+
+// GasScheduleStore defines the interface the gas schedule store used by the
+// BaseApp must fulfill.
+type GasScheduleStore interface {
+	GetGasSchedule(ctx context.Context) (sdk.GasSchedule, error)
+}
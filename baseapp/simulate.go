@@ -0,0 +1,318 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// simulationStepAnte and simulationStepPost are the trace steps of the
+	// ante and post handlers, the steps of the msgs being their indexes.
+	simulationStepAnte = -1
+	simulationStepPost = -2
+
+	// traceStoreKey is the trace context key of the store key name.
+	traceStoreKey = "store_key"
+)
+
+var _ storetypes.CacheMultiStore = (*tracedMultiStore)(nil)
+
+// SimulateWithOptions simulates the tx like Simulate, on the state at the
+// given height with the given overrides applied. If requested, it returns the
+// trace of the store operations and events of each step of the simulation,
+// which is also returned, along with the error, if the tx fails.
+func (app *BaseApp) SimulateWithOptions(txBytes []byte, opts txtypes.SimulateOptions) (sdk.GasInfo, *sdk.Result, *txtypes.SimulationTrace, error) {
+	ctx, err := app.getContextForSimulation(txBytes, opts.Height)
+	if err != nil {
+		return sdk.GasInfo{}, nil, nil, err
+	}
+
+	if err := app.applyStateOverrides(ctx, opts.StateOverrides); err != nil {
+		return sdk.GasInfo{}, nil, nil, err
+	}
+
+	if !opts.Trace {
+		gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+		return gasInfo, result, nil, err
+	}
+
+	tracer := &simulationTracer{step: simulationStepAnte}
+	ctx = ctx.WithMultiStore(&tracedMultiStore{parent: ctx.MultiStore().CacheMultiStore(), tracer: tracer})
+
+	gasInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	if tracer.err != nil {
+		return gasInfo, nil, nil, tracer.err
+	}
+
+	return gasInfo, result, tracer.simulationTrace(anteEvents, result), err
+}
+
+// getContextForSimulation returns the context simulating the tx on the state
+// at the given height, the check state if 0.
+func (app *BaseApp) getContextForSimulation(txBytes []byte, height int64) (sdk.Context, error) {
+	if height == 0 {
+		return app.getContextForTx(execModeSimulate, txBytes), nil
+	}
+
+	// the multi-store of the query context is a branch of the state at the
+	// given height, the writes of the simulation are thus discarded
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+
+	return ctx.
+		WithTxBytes(txBytes).
+		WithExecMode(sdk.ExecModeSimulate).
+		WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithGasSchedule(app.GetGasSchedule(ctx)), nil
+}
+
+// applyStateOverrides applies the state overrides to the multi-store of the
+// context, without consuming gas.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides []txtypes.StateOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "state overrides are only supported by the rootmulti store")
+	}
+	keys := rms.StoreKeysByName()
+
+	for i, override := range overrides {
+		key, ok := keys[override.StoreKey]
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown store key %q in state override %d", override.StoreKey, i)
+		}
+		if len(override.Key) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty key in state override %d", i)
+		}
+
+		store := ctx.MultiStore().GetKVStore(key)
+		if override.Delete {
+			store.Delete(override.Key)
+			continue
+		}
+
+		value := override.Value
+		if value == nil {
+			value = []byte{}
+		}
+		store.Set(override.Key, value)
+	}
+
+	return nil
+}
+
+// setSimulationStep sets the trace step of the simulation executed with the
+// context, if traced.
+func setSimulationStep(ctx sdk.Context, step int) {
+	if ms, ok := ctx.MultiStore().(*tracedMultiStore); ok {
+		ms.tracer.step = step
+	}
+}
+
+// simulationTracer collects the store operations written by the tracekv
+// stores of a simulation, by trace step.
+type simulationTracer struct {
+	step int
+	line []byte
+	err  error
+
+	ante []txtypes.StoreOperation
+	msgs [][]txtypes.StoreOperation
+	post []txtypes.StoreOperation
+}
+
+// Write implements io.Writer, decoding the operations traced by tracekv, one
+// per line.
+func (t *simulationTracer) Write(p []byte) (int, error) {
+	t.line = append(t.line, p...)
+	for {
+		i := bytes.IndexByte(t.line, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		if err := t.addOperation(t.line[:i]); err != nil && t.err == nil {
+			t.err = err
+		}
+		t.line = t.line[i+1:]
+	}
+}
+
+func (t *simulationTracer) addOperation(line []byte) error {
+	var traced struct {
+		Operation string                 `json:"operation"`
+		Key       string                 `json:"key"`
+		Value     string                 `json:"value"`
+		Metadata  map[string]interface{} `json:"metadata"`
+	}
+	if err := json.Unmarshal(line, &traced); err != nil {
+		return err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(traced.Key)
+	if err != nil {
+		return err
+	}
+	value, err := base64.StdEncoding.DecodeString(traced.Value)
+	if err != nil {
+		return err
+	}
+	storeKey, ok := traced.Metadata[traceStoreKey].(string)
+	if !ok {
+		return errors.New("traced store operation without store key")
+	}
+
+	op := txtypes.StoreOperation{
+		Operation: traced.Operation,
+		StoreKey:  storeKey,
+		Key:       key,
+		Value:     value,
+	}
+	switch t.step {
+	case simulationStepAnte:
+		t.ante = append(t.ante, op)
+	case simulationStepPost:
+		t.post = append(t.post, op)
+	default:
+		t.growMsgs(t.step)
+		t.msgs[t.step] = append(t.msgs[t.step], op)
+	}
+
+	return nil
+}
+
+// growMsgs grows the msgs steps to contain the one of the given index.
+func (t *simulationTracer) growMsgs(i int) {
+	for len(t.msgs) <= i {
+		t.msgs = append(t.msgs, nil)
+	}
+}
+
+// simulationTrace returns the trace of the simulation, the events of the
+// result being split by step: the events of the ante handler come first,
+// followed by the ones of the msgs, identified by their msg_index
+// attribute, and by the ones of the post handler.
+func (t *simulationTracer) simulationTrace(anteEvents []abci.Event, result *sdk.Result) *txtypes.SimulationTrace {
+	trace := &txtypes.SimulationTrace{
+		Ante: txtypes.SimulationTraceStep{Operations: t.ante, Events: anteEvents},
+		Post: txtypes.SimulationTraceStep{Operations: t.post},
+	}
+
+	var msgEvents [][]abci.Event
+	if result != nil && len(result.Events) >= len(anteEvents) {
+		for _, event := range result.Events[len(anteEvents):] {
+			i, ok := msgIndex(event)
+			if !ok {
+				trace.Post.Events = append(trace.Post.Events, event)
+				continue
+			}
+
+			t.growMsgs(i)
+			for len(msgEvents) <= i {
+				msgEvents = append(msgEvents, nil)
+			}
+			msgEvents[i] = append(msgEvents[i], event)
+		}
+	}
+
+	trace.Msgs = make([]txtypes.SimulationTraceStep, len(t.msgs))
+	for i, ops := range t.msgs {
+		trace.Msgs[i].Operations = ops
+		if i < len(msgEvents) {
+			trace.Msgs[i].Events = msgEvents[i]
+		}
+	}
+
+	return trace
+}
+
+// msgIndex returns the value of the msg_index attribute of the event.
+func msgIndex(event abci.Event) (int, bool) {
+	for _, attr := range event.Attributes {
+		if attr.Key != "msg_index" {
+			continue
+		}
+
+		i, err := strconv.Atoi(attr.Value)
+		return i, err == nil && i >= 0
+	}
+
+	return 0, false
+}
+
+// tracedMultiStore is a branch of a multi-store whose substores trace their
+// operations with tracekv. Its branches are also traced, so that the
+// operations are traced when they are performed, whatever the depth of the
+// branch performing them, and only then.
+type tracedMultiStore struct {
+	parent storetypes.CacheMultiStore
+	tracer *simulationTracer
+}
+
+func (ms *tracedMultiStore) GetStoreType() storetypes.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+func (ms *tracedMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *tracedMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *tracedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return &tracedMultiStore{parent: ms.parent.CacheMultiStore(), tracer: ms.tracer}
+}
+
+func (ms *tracedMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errors.New("cannot branch a traced multi-store at a version")
+}
+
+func (ms *tracedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *tracedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return tracekv.NewStore(ms.parent.GetKVStore(key), ms.tracer, storetypes.TraceContext{traceStoreKey: key.Name()})
+}
+
+func (ms *tracedMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (ms *tracedMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *tracedMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *tracedMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+func (ms *tracedMultiStore) Write() {
+	ms.parent.Write()
+}
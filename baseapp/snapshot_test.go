@@ -6,10 +6,14 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 )
 
 func TestABCI_ListSnapshots(t *testing.T) {
@@ -292,7 +296,8 @@ func TestABCI_ApplySnapshotChunk(t *testing.T) {
 		snapshotKeepRecent: 2,
 		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 	}
-	targetSuite := NewBaseAppSuiteWithSnapshots(t, targetCfg)
+	stateStorage := storage.NewStore(dbm.NewMemDB())
+	targetSuite := NewBaseAppSuiteWithSnapshots(t, targetCfg, baseapp.SetStateStorage(stateStorage))
 
 	// fetch latest snapshot to restore
 	respList, err := srcSuite.baseApp.ListSnapshots(&abci.RequestListSnapshots{})
@@ -344,4 +349,12 @@ func TestABCI_ApplySnapshotChunk(t *testing.T) {
 
 	// the target should now have the same hash as the source
 	require.Equal(t, srcSuite.baseApp.LastCommitID(), targetSuite.baseApp.LastCommitID())
+
+	// the restored state is imported into the state storage
+	height := int64(snapshot.Height)
+	require.Equal(t, height, stateStorage.InitialVersion())
+	require.Equal(t, height, stateStorage.LatestVersion())
+	value := stateStorage.KVStore(capKey2.Name(), height).Get([]byte("0"))
+	require.NotNil(t, value)
+	require.Equal(t, srcSuite.baseApp.CommitMultiStore().GetKVStore(capKey2).Get([]byte("0")), value)
 }
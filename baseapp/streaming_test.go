@@ -7,8 +7,11 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,3 +149,49 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestABCI_StateStorage(t *testing.T) {
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	mockListener := NewMockABCIListener("lis_1")
+	streamingManager := storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{&mockListener}}
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+	addListenerOpt := func(bapp *baseapp.BaseApp) { bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{distKey1}) }
+	stateStorage := storage.NewStore(dbm.NewMemDB())
+	suite := NewBaseAppSuite(t, distOpt, streamingManagerOpt, addListenerOpt,
+		baseapp.SetStateStorage(stateStorage), baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	key := []byte("key")
+	nBlocks := int64(12)
+	for height := int64(1); height <= nBlocks; height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+
+		value := []byte(fmt.Sprintf("value%d", height))
+		getFinalizeBlockStateCtx(suite.baseApp).KVStore(capKey1).Set(key, value)
+		getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set(key, value)
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+		require.Equal(t, height, stateStorage.LatestVersion())
+
+		// the changes of the stores only listened to by the state storage are not streamed
+		require.Equal(t, []*storetypes.StoreKVPair{{StoreKey: distKey1.Name(), Key: key, Value: value}}, mockListener.ChangeSet)
+	}
+
+	// the pruned heights are queried from the state storage
+	for height := int64(1); height <= nBlocks; height++ {
+		ctx, err := suite.baseApp.CreateQueryContext(height, false)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", height)), ctx.KVStore(capKey1).Get(key))
+		require.Equal(t, []byte(fmt.Sprintf("value%d", height)), ctx.KVStore(distKey1).Get(key))
+	}
+	_, err = suite.baseApp.CommitMultiStore().CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+}
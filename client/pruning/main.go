@@ -3,10 +3,12 @@ package pruning
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
@@ -34,6 +36,10 @@ The pruning option is provided via the 'pruning' argument or alternatively with
 - everything: 2 latest states will be kept
 - custom: allow pruning options to be manually specified through 'pruning-keep-recent'
 
+The stores with their own pruning strategy in the 'pruning-stores' table of app.toml keep their own
+number of recent heights instead, or are not pruned at all if their pruning strategy is 'nothing'.
+The stores with 'pruning-keep-every' set archive every n-th height before pruning it.
+
 Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
 Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 		Example: fmt.Sprintf("%s prune custom --pruning-keep-recent 100 --app-db-backend 'goleveldb'", version.AppName),
@@ -64,6 +70,24 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 				pruningOptions.KeepRecent,
 			)
 
+			// the pruning strategies of the stores are only read from app.toml.
+			vp.Set(server.FlagPruningStores, server.GetServerContextFromCmd(cmd).Viper.Get(server.FlagPruningStores))
+			storePruningOptions, err := server.GetStorePruningOptionsFromFlags(vp)
+			if err != nil {
+				return err
+			}
+
+			storeNames := maps.Keys(storePruningOptions)
+			sort.Strings(storeNames)
+			for _, storeName := range storeNames {
+				cmd.Printf("get pruning options of store %s from app.toml, strategy: %v, keep-recent: %v, keep-every: %v\n",
+					storeName,
+					storePruningOptions[storeName].Strategy,
+					storePruningOptions[storeName].KeepRecent,
+					storePruningOptions[storeName].KeepEvery,
+				)
+			}
+
 			home := vp.GetString(flags.FlagHome)
 			db, err := openDB(home, server.GetAppDBBackend(vp))
 			if err != nil {
@@ -78,6 +102,10 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 			if !ok {
 				return fmt.Errorf("currently only support the pruning of rootmulti.Store type")
 			}
+			for storeName, opts := range storePruningOptions {
+				rootMultiStore.SetStorePruning(storeName, opts)
+			}
+
 			latestHeight := rootmulti.GetLatestVersion(db)
 			// valid heights should be greater than 0.
 			if latestHeight <= 0 {
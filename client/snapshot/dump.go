@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
@@ -19,7 +20,10 @@ func DumpArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
 		Short: "Dump the snapshot as portable archive format",
-		Args:  cobra.ExactArgs(2),
+		Long: `Dump the snapshot as portable archive format.
+The archive starts with a manifest of the chain-id, height, app hash, chunk hashes and compression,
+which is verified when loading it.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
@@ -31,6 +35,13 @@ func DumpArchiveCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			compression, err := cmd.Flags().GetString("compression")
+			if err != nil {
+				return err
+			}
+			if err := validateCompression(compression); err != nil {
+				return err
+			}
 
 			height, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -42,7 +53,10 @@ func DumpArchiveCmd() *cobra.Command {
 			}
 
 			if output == "" {
-				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+				if compression == CompressionGzip {
+					output += ".gz"
+				}
 			}
 
 			snapshot, err := snapshotStore.Get(height, uint32(format))
@@ -54,6 +68,19 @@ func DumpArchiveCmd() *cobra.Command {
 				return errors.New("snapshot doesn't exist")
 			}
 
+			chainID, err := getChainID(cmd, ctx)
+			if err != nil {
+				return err
+			}
+			appHash, err := getAppHash(ctx, height)
+			if err != nil {
+				return err
+			}
+			manifestBz, err := marshalManifest(NewManifest(chainID, appHash, snapshot, compression))
+			if err != nil {
+				return err
+			}
+
 			bz, err := snapshot.Marshal()
 			if err != nil {
 				return err
@@ -65,12 +92,29 @@ func DumpArchiveCmd() *cobra.Command {
 			}
 			defer fp.Close()
 
-			// since the chunk files are already compressed, we just use fastest compression here
-			gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
-			if err != nil {
-				return err
+			var (
+				archiveWriter io.Writer = fp
+				gzipWriter    *gzip.Writer
+			)
+			if compression == CompressionGzip {
+				// since the chunk files are already compressed, we just use fastest compression here
+				gzipWriter, err = gzip.NewWriterLevel(fp, gzip.BestSpeed)
+				if err != nil {
+					return err
+				}
+				archiveWriter = gzipWriter
+			}
+			tarWriter := tar.NewWriter(archiveWriter)
+			if err := tarWriter.WriteHeader(&tar.Header{
+				Name: ManifestFileName,
+				Mode: 0o644,
+				Size: int64(len(manifestBz)),
+			}); err != nil {
+				return fmt.Errorf("failed to write manifest header to tar: %w", err)
+			}
+			if _, err := tarWriter.Write(manifestBz); err != nil {
+				return fmt.Errorf("failed to write manifest to tar: %w", err)
 			}
-			tarWriter := tar.NewWriter(gzipWriter)
 			if err := tarWriter.WriteHeader(&tar.Header{
 				Name: SnapshotFileName,
 				Mode: 0o644,
@@ -112,15 +156,20 @@ func DumpArchiveCmd() *cobra.Command {
 				return fmt.Errorf("failed to close tar writer: %w", err)
 			}
 
-			if err := gzipWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close gzip writer: %w", err)
+			if gzipWriter != nil {
+				if err := gzipWriter.Close(); err != nil {
+					return fmt.Errorf("failed to close gzip writer: %w", err)
+				}
 			}
 
+			cmd.Printf("Snapshot dumped to %s, app hash %X\n", output, appHash)
 			return fp.Close()
 		},
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().String("compression", CompressionGzip, fmt.Sprintf("archive compression, %s or %s", CompressionGzip, CompressionNone))
+	cmd.Flags().String(flags.FlagChainID, "", "chain-id of the snapshot, default to the genesis one")
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -22,6 +23,10 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			differential, err := cmd.Flags().GetBool("differential")
+			if err != nil {
+				return err
+			}
 
 			home := ctx.Config.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
@@ -38,17 +43,28 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			cmd.Printf("Exporting snapshot for height %d\n", height)
 
 			sm := app.SnapshotManager()
-			snapshot, err := sm.Create(uint64(height))
+			var snapshot *snapshottypes.Snapshot
+			if differential {
+				snapshot, err = sm.CreateDifferential(uint64(height))
+			} else {
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
 
+			if snapshot.IsDifferential() {
+				cmd.Printf("Differential snapshot created at height %d, format %d, chunks %d, base height %d, base format %d\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+				return nil
+			}
 			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
 			return nil
 		},
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool("differential", false, "Export only the changes since the latest snapshot, restorable on top of it")
 
 	return cmd
 }
@@ -23,6 +23,11 @@ var ListSnapshotsCmd = &cobra.Command{
 			return fmt.Errorf("failed to list snapshots: %w", err)
 		}
 		for _, snapshot := range snapshots {
+			if snapshot.IsDifferential() {
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks,
+					"base height:", snapshot.Metadata.BaseHeight, "base format:", snapshot.Metadata.BaseFormat)
+				continue
+			}
 			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
 		}
 
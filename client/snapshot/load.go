@@ -3,7 +3,6 @@ package snapshot
 import (
 	"archive/tar"
 	"bytes"
-	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
@@ -14,17 +13,28 @@ import (
 
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
-const SnapshotFileName = "_snapshot"
+const (
+	SnapshotFileName = "_snapshot"
+
+	// FlagAppHash is the flag of the trusted app hash to verify a snapshot against.
+	FlagAppHash = "app-hash"
+)
 
 // LoadArchiveCmd load a portable archive format snapshot into snapshot store
 func LoadArchiveCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "load <archive-file>",
 		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
-		Args:  cobra.ExactArgs(1),
+		Long: `Load a snapshot archive file (.tar.gz) into snapshot store.
+The archive chain-id and chunk hashes are verified against its manifest, which protects against a
+corrupted archive, not against a forged one. The --app-hash flag, the trusted app hash at the
+snapshot height, is only compared to the app hash claimed by the manifest: nothing ties it to the
+chunks until the snapshot is restored with the restore command and its --app-hash flag.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
@@ -32,26 +42,69 @@ func LoadArchiveCmd() *cobra.Command {
 				return err
 			}
 
+			appHash, err := cmd.Flags().GetString(FlagAppHash)
+			if err != nil {
+				return err
+			}
+
 			path := args[0]
 			fp, err := os.Open(path)
 			if err != nil {
 				return fmt.Errorf("failed to open archive file: %w", err)
 			}
-			reader, err := gzip.NewReader(fp)
+			defer fp.Close()
+			reader, compression, err := openArchive(fp)
 			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
+				return err
 			}
 
 			var snapshot snapshottypes.Snapshot
 			tr := tar.NewReader(reader)
-			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
-			}
 
 			hdr, err := tr.Next()
 			if err != nil {
 				return fmt.Errorf("failed to read snapshot file header: %w", err)
 			}
+
+			var manifest *Manifest
+			if hdr.Name == ManifestFileName {
+				bz, err := io.ReadAll(tr)
+				if err != nil {
+					return fmt.Errorf("failed to read manifest file: %w", err)
+				}
+				m, err := unmarshalManifest(bz)
+				if err != nil {
+					return err
+				}
+				manifest = &m
+
+				if manifest.Compression != compression {
+					return fmt.Errorf("invalid archive, manifest compression %s, got: %s", manifest.Compression, compression)
+				}
+				chainID, err := getChainID(cmd, ctx)
+				if err != nil {
+					return err
+				}
+				if manifest.ChainID != chainID {
+					return fmt.Errorf("invalid archive, expect chain-id: %s, got: %s", chainID, manifest.ChainID)
+				}
+				if appHash != "" {
+					if err := manifest.VerifyAppHash(appHash); err != nil {
+						return fmt.Errorf("invalid archive: %w", err)
+					}
+				} else {
+					cmd.Printf("Archive app hash %s is not verified, compare it to the trusted app hash at height %d\n",
+						manifest.AppHash, manifest.Height)
+				}
+
+				hdr, err = tr.Next()
+				if err != nil {
+					return fmt.Errorf("failed to read snapshot file header: %w", err)
+				}
+			} else if appHash != "" {
+				return fmt.Errorf("invalid archive, no manifest to verify the app hash against")
+			}
+
 			if hdr.Name != SnapshotFileName {
 				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
 			}
@@ -62,6 +115,11 @@ func LoadArchiveCmd() *cobra.Command {
 			if err := snapshot.Unmarshal(bz); err != nil {
 				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
 			}
+			if manifest != nil {
+				if err := manifest.VerifySnapshot(&snapshot); err != nil {
+					return fmt.Errorf("invalid archive: %w", err)
+				}
+			}
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -69,7 +127,15 @@ func LoadArchiveCmd() *cobra.Command {
 			go func() {
 				defer close(quitChan)
 
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				var (
+					savedSnapshot *snapshottypes.Snapshot
+					err           error
+				)
+				if snapshot.IsDifferential() {
+					savedSnapshot, err = snapshotStore.SaveDifferential(snapshot.Height, snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat, chunks)
+				} else {
+					savedSnapshot, err = snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				}
 				if err != nil {
 					cmd.Println("failed to save snapshot", err)
 					return
@@ -77,22 +143,36 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
+			// abort stops saving the snapshot, and deletes what was saved of it
+			abort := func(err error) error {
+				close(chunks)
+				if savedSnapshot := <-quitChan; savedSnapshot != nil {
+					_ = snapshotStore.Delete(savedSnapshot.Height, savedSnapshot.Format)
+				}
+				return err
+			}
+
 			for i := uint32(0); i < snapshot.Chunks; i++ {
 				hdr, err = tr.Next()
 				if err != nil {
 					if err == io.EOF {
 						break
 					}
-					return err
+					return abort(err)
 				}
 
 				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+					return abort(fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name))
 				}
 
 				bz, err := io.ReadAll(tr)
 				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
+					return abort(fmt.Errorf("failed to read chunk file: %w", err))
+				}
+				if manifest != nil {
+					if err := manifest.VerifyChunk(i, bz); err != nil {
+						return abort(fmt.Errorf("invalid archive: %w", err))
+					}
 				}
 				chunks <- io.NopCloser(bytes.NewReader(bz))
 			}
@@ -108,7 +188,14 @@ func LoadArchiveCmd() *cobra.Command {
 				return fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
 			}
 
+			cmd.Printf("Snapshot loaded at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			cmd.Println("Restore it with the --app-hash flag to verify its chunks produce the trusted app hash")
 			return nil
 		},
 	}
+
+	cmd.Flags().String(FlagAppHash, "", "Trusted app hash (hex) at the snapshot height to verify the archive against")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain-id to verify the archive against, default to the genesis one")
+
+	return cmd
 }
@@ -0,0 +1,230 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file, the first file of a snapshot archive.
+	ManifestFileName = "_manifest"
+
+	// ManifestVersion is the version of the snapshot archive format described by the manifest.
+	ManifestVersion uint32 = 1
+
+	// CompressionGzip is the compression of gzip compressed snapshot archives.
+	CompressionGzip = "gzip"
+	// CompressionNone is the compression of uncompressed snapshot archives.
+	CompressionNone = "none"
+)
+
+// Manifest describes a portable snapshot archive, to verify its content before loading it into
+// a snapshot store. The hashes are hex encoded.
+type Manifest struct {
+	// Version is the version of the archive format.
+	Version uint32 `json:"version"`
+	// ChainID is the chain-id of the chain the snapshot is taken from.
+	ChainID string `json:"chain_id"`
+	// Height is the height of the snapshot.
+	Height uint64 `json:"height"`
+	// Format is the format of the snapshot.
+	Format uint32 `json:"format"`
+	// AppHash is the app hash of the state at the snapshot height.
+	AppHash string `json:"app_hash"`
+	// SnapshotHash is the hash of the snapshot, over all its chunks.
+	SnapshotHash string `json:"snapshot_hash"`
+	// ChunkHashes are the SHA-256 hashes of the snapshot chunks.
+	ChunkHashes []string `json:"chunk_hashes"`
+	// Compression is the compression of the archive.
+	Compression string `json:"compression"`
+}
+
+// NewManifest returns the manifest of an archive of the given snapshot.
+func NewManifest(chainID string, appHash []byte, snapshot *snapshottypes.Snapshot, compression string) Manifest {
+	chunkHashes := make([]string, len(snapshot.Metadata.ChunkHashes))
+	for i, chunkHash := range snapshot.Metadata.ChunkHashes {
+		chunkHashes[i] = hex.EncodeToString(chunkHash)
+	}
+	return Manifest{
+		Version:      ManifestVersion,
+		ChainID:      chainID,
+		Height:       snapshot.Height,
+		Format:       snapshot.Format,
+		AppHash:      hex.EncodeToString(appHash),
+		SnapshotHash: hex.EncodeToString(snapshot.Hash),
+		ChunkHashes:  chunkHashes,
+		Compression:  compression,
+	}
+}
+
+// Validate performs a basic validation of the manifest fields.
+func (m Manifest) Validate() error {
+	if m.Version != ManifestVersion {
+		return fmt.Errorf("unsupported archive version %d, expected %d", m.Version, ManifestVersion)
+	}
+	if m.ChainID == "" {
+		return errors.New("chain-id cannot be empty")
+	}
+	if m.Height == 0 {
+		return errors.New("height cannot be 0")
+	}
+	if err := validateCompression(m.Compression); err != nil {
+		return err
+	}
+	if len(m.ChunkHashes) == 0 {
+		return errors.New("no chunk hashes")
+	}
+	if _, err := hex.DecodeString(m.SnapshotHash); err != nil {
+		return fmt.Errorf("invalid snapshot hash: %w", err)
+	}
+	appHash, err := hex.DecodeString(m.AppHash)
+	if err != nil {
+		return fmt.Errorf("invalid app hash: %w", err)
+	}
+	if len(appHash) == 0 {
+		return errors.New("app hash cannot be empty")
+	}
+	for i, chunkHash := range m.ChunkHashes {
+		if bz, err := hex.DecodeString(chunkHash); err != nil || len(bz) != sha256.Size {
+			return fmt.Errorf("invalid hash of chunk %d: %s", i, chunkHash)
+		}
+	}
+	return nil
+}
+
+// VerifyAppHash verifies the manifest app hash matches the given hex encoded app hash. It only
+// checks the app hash claimed by the manifest, not that the snapshot chunks produce it, which is
+// only verified by restoring the snapshot.
+func (m Manifest) VerifyAppHash(appHash string) error {
+	if !strings.EqualFold(m.AppHash, appHash) {
+		return fmt.Errorf("app hash mismatch: expected %s, archive has %s", appHash, m.AppHash)
+	}
+	return nil
+}
+
+// VerifySnapshot verifies the snapshot metadata matches the manifest.
+func (m Manifest) VerifySnapshot(snapshot *snapshottypes.Snapshot) error {
+	if snapshot.Height != m.Height || snapshot.Format != m.Format {
+		return fmt.Errorf("snapshot at height %d, format %d doesn't match the manifest height %d, format %d",
+			snapshot.Height, snapshot.Format, m.Height, m.Format)
+	}
+	if hex.EncodeToString(snapshot.Hash) != strings.ToLower(m.SnapshotHash) {
+		return fmt.Errorf("snapshot hash %X doesn't match the manifest", snapshot.Hash)
+	}
+	if int(snapshot.Chunks) != len(m.ChunkHashes) || len(snapshot.Metadata.ChunkHashes) != len(m.ChunkHashes) {
+		return fmt.Errorf("snapshot has %d chunks, but the manifest %d", snapshot.Chunks, len(m.ChunkHashes))
+	}
+	for i, chunkHash := range snapshot.Metadata.ChunkHashes {
+		if hex.EncodeToString(chunkHash) != strings.ToLower(m.ChunkHashes[i]) {
+			return fmt.Errorf("snapshot hash of chunk %d doesn't match the manifest", i)
+		}
+	}
+	return nil
+}
+
+// VerifyChunk verifies the content of the chunk at the given index matches its manifest hash.
+func (m Manifest) VerifyChunk(index uint32, chunk []byte) error {
+	if int(index) >= len(m.ChunkHashes) {
+		return fmt.Errorf("unexpected chunk %d", index)
+	}
+	hash := sha256.Sum256(chunk)
+	if hex.EncodeToString(hash[:]) != strings.ToLower(m.ChunkHashes[index]) {
+		return fmt.Errorf("%w: chunk %d", snapshottypes.ErrChunkHashMismatch, index)
+	}
+	return nil
+}
+
+// validateCompression returns an error if the archive compression is unknown.
+func validateCompression(compression string) error {
+	switch compression {
+	case CompressionGzip, CompressionNone:
+		return nil
+	default:
+		return fmt.Errorf("unknown archive compression %q", compression)
+	}
+}
+
+// openArchive detects whether the archive is gzip compressed, returning the reader of its
+// decompressed content and its compression.
+func openArchive(r io.Reader) (io.Reader, string, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read archive: %w", err)
+	}
+	if !bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return br, CompressionNone, nil
+	}
+	reader, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	return reader, CompressionGzip, nil
+}
+
+// marshalManifest encodes the manifest as indented JSON, to be readable from the archive.
+func marshalManifest(manifest Manifest) ([]byte, error) {
+	return json.MarshalIndent(manifest, "", "  ")
+}
+
+// unmarshalManifest decodes and validates a manifest.
+func unmarshalManifest(bz []byte) (Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// getChainID returns the chain-id of the node, from the --chain-id flag or its genesis file.
+func getChainID(cmd *cobra.Command, ctx *server.Context) (string, error) {
+	chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+	if err != nil {
+		return "", err
+	}
+	if chainID != "" {
+		return chainID, nil
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(ctx.Config.GenesisFile())
+	if err != nil {
+		return "", fmt.Errorf("failed to read the chain-id from genesis: %w", err)
+	}
+	return appGenesis.ChainID, nil
+}
+
+// getAppHash returns the app hash of the node state committed at the given height.
+func getAppHash(ctx *server.Context, height uint64) ([]byte, error) {
+	db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(int64(height))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the app hash at height %d: %w", height, err)
+	}
+	return commitInfo.Hash(), nil
+}
@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+func testSnapshot(chunks ...[]byte) *snapshottypes.Snapshot {
+	snapshot := &snapshottypes.Snapshot{
+		Height: 10,
+		Format: snapshottypes.CurrentFormat,
+		Chunks: uint32(len(chunks)),
+	}
+	hasher := sha256.New()
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash[:])
+		hasher.Write(chunk)
+	}
+	snapshot.Hash = hasher.Sum(nil)
+	return snapshot
+}
+
+func TestManifest(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	snapshot := testSnapshot(chunks...)
+	manifest := NewManifest("test-chain", []byte{0xab, 0xcd}, snapshot, CompressionGzip)
+
+	bz, err := marshalManifest(manifest)
+	require.NoError(t, err)
+	decoded, err := unmarshalManifest(bz)
+	require.NoError(t, err)
+	require.Equal(t, manifest, decoded)
+
+	require.NoError(t, manifest.VerifyAppHash("ABCD"))
+	require.Error(t, manifest.VerifyAppHash("abce"))
+
+	require.NoError(t, manifest.VerifySnapshot(snapshot))
+	require.Error(t, manifest.VerifySnapshot(testSnapshot(chunks[0])))
+	require.Error(t, manifest.VerifySnapshot(testSnapshot(chunks[1], chunks[0])))
+
+	require.NoError(t, manifest.VerifyChunk(0, chunks[0]))
+	require.NoError(t, manifest.VerifyChunk(1, chunks[1]))
+	require.ErrorIs(t, manifest.VerifyChunk(1, chunks[0]), snapshottypes.ErrChunkHashMismatch)
+	require.Error(t, manifest.VerifyChunk(2, chunks[0]))
+}
+
+func TestManifest_Validate(t *testing.T) {
+	valid := NewManifest("test-chain", []byte{0xab}, testSnapshot([]byte{1}), CompressionNone)
+
+	testCases := map[string]struct {
+		malleate func(m *Manifest)
+		expErr   bool
+	}{
+		"valid":               {func(m *Manifest) {}, false},
+		"unknown version":     {func(m *Manifest) { m.Version = 2 }, true},
+		"empty chain-id":      {func(m *Manifest) { m.ChainID = "" }, true},
+		"zero height":         {func(m *Manifest) { m.Height = 0 }, true},
+		"unknown compression": {func(m *Manifest) { m.Compression = "zstd" }, true},
+		"no chunks":           {func(m *Manifest) { m.ChunkHashes = nil }, true},
+		"empty app hash":      {func(m *Manifest) { m.AppHash = "" }, true},
+		"invalid app hash":    {func(m *Manifest) { m.AppHash = "xyz" }, true},
+		"invalid chunk hash":  {func(m *Manifest) { m.ChunkHashes[0] = "abcd" }, true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			manifest := valid
+			manifest.ChunkHashes = append([]string{}, valid.ChunkHashes...)
+			tc.malleate(&manifest)
+			err := manifest.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOpenArchive(t *testing.T) {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_, err := gzipWriter.Write([]byte("archive"))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	reader, compression, err := openArchive(&buf)
+	require.NoError(t, err)
+	require.Equal(t, CompressionGzip, compression)
+	bz, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(bz))
+
+	reader, compression, err = openArchive(strings.NewReader("archive"))
+	require.NoError(t, err)
+	require.Equal(t, CompressionNone, compression)
+	bz, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(bz))
+}
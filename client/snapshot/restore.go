@@ -1,6 +1,10 @@
 package snapshot
 
 import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 
@@ -13,13 +17,25 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const (
+	// appDBName is the name of the app DB in the data directory.
+	appDBName = "application"
+	// scratchDBName is the name of the DB a snapshot is restored into before
+	// its app hash is verified and it replaces the app DB.
+	scratchDBName = "application-restore"
+)
+
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Long: `Restore app state from local snapshot.
+A differential snapshot is restored on top of its base snapshots, which must be available locally.
+If the --app-hash flag is given, the snapshot is restored into a scratch DB, which replaces the app
+state only once its app hash is verified against the flag. The app state is left untouched otherwise.
+This is the only verification that the snapshot chunks produce the trusted state.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 
@@ -31,23 +47,97 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			home := ctx.Config.RootDir
-			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
+			appHash, err := cmd.Flags().GetString(FlagAppHash)
 			if err != nil {
 				return err
 			}
+
+			dataDir := filepath.Join(ctx.Config.RootDir, "data")
+			backend := server.GetAppDBBackend(ctx.Viper)
 			logger := log.NewLogger(cmd.OutOrStdout())
-			app := appCreator(logger, db, nil, ctx.Viper)
 
-			sm := app.SnapshotManager()
-			return sm.RestoreLocalSnapshot(height, uint32(format))
+			if appHash == "" {
+				_, err := restoreSnapshot(appCreator, logger, ctx.Viper, dataDir, appDBName, backend, height, uint32(format))
+				return err
+			}
+
+			expectedAppHash, err := hex.DecodeString(appHash)
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+
+			scratchDir := filepath.Join(dataDir, scratchDBName+".db")
+			if err := os.RemoveAll(scratchDir); err != nil {
+				return err
+			}
+
+			restored, err := restoreSnapshot(appCreator, logger, ctx.Viper, dataDir, scratchDBName, backend, height, uint32(format))
+			if err == nil && !bytes.Equal(restored, expectedAppHash) {
+				err = fmt.Errorf("app hash mismatch: expected %X, restored %X", expectedAppHash, restored)
+			}
+			if err != nil {
+				_ = os.RemoveAll(scratchDir)
+				return err
+			}
+
+			return replaceDB(dataDir, appDBName, scratchDBName)
 		},
 	}
+
+	cmd.Flags().String(FlagAppHash, "", "Trusted app hash (hex) at the snapshot height to verify the restored state against")
+
 	return cmd
 }
 
+// restoreSnapshot restores the local snapshot into the DB of the given name,
+// returning the app hash of the restored state.
+func restoreSnapshot(
+	appCreator servertypes.AppCreator,
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+	dataDir, dbName string,
+	backend dbm.BackendType,
+	height uint64,
+	format uint32,
+) ([]byte, error) {
+	db, err := dbm.NewDB(dbName, backend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	app := appCreator(logger, db, nil, appOpts)
+	defer app.Close()
+
+	if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+		return nil, err
+	}
+
+	return app.CommitMultiStore().LastCommitID().Hash, nil
+}
+
+// replaceDB replaces the DB of the given name in the data directory by the
+// source DB, which must be closed. The replaced DB is only deleted once the
+// source DB is in place.
+func replaceDB(dataDir, name, source string) error {
+	target := filepath.Join(dataDir, name+".db")
+	backup := target + ".bak"
+
+	if err := os.RemoveAll(backup); err != nil {
+		return err
+	}
+	if err := os.Rename(target, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(filepath.Join(dataDir, source+".db"), target); err != nil {
+		_ = os.Rename(backup, target)
+		return err
+	}
+
+	return os.RemoveAll(backup)
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return dbm.NewDB("application", backendType, dataDir)
+	return dbm.NewDB(appDBName, backendType, dataDir)
 }
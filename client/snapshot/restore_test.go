@@ -0,0 +1,45 @@
+package snapshot
+
+import (
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceDB(t *testing.T) {
+	dataDir := t.TempDir()
+
+	writeDB := func(name string, value []byte) {
+		db, err := dbm.NewDB(name, dbm.GoLevelDBBackend, dataDir)
+		require.NoError(t, err)
+		require.NoError(t, db.Set([]byte("key"), value))
+		require.NoError(t, db.Close())
+	}
+	readDB := func(name string) []byte {
+		db, err := dbm.NewDB(name, dbm.GoLevelDBBackend, dataDir)
+		require.NoError(t, err)
+		defer db.Close()
+		value, err := db.Get([]byte("key"))
+		require.NoError(t, err)
+		return value
+	}
+
+	// the app DB is created if it doesn't exist
+	writeDB(scratchDBName, []byte("first"))
+	require.NoError(t, replaceDB(dataDir, appDBName, scratchDBName))
+	require.Equal(t, []byte("first"), readDB(appDBName))
+
+	// the app DB is replaced otherwise
+	writeDB(scratchDBName, []byte("second"))
+	require.NoError(t, replaceDB(dataDir, appDBName, scratchDBName))
+	require.Equal(t, []byte("second"), readDB(appDBName))
+
+	require.NoDirExists(t, filepath.Join(dataDir, scratchDBName+".db"))
+	require.NoDirExists(t, filepath.Join(dataDir, appDBName+".db.bak"))
+
+	// a missing source DB leaves the app DB untouched
+	require.Error(t, replaceDB(dataDir, appDBName, scratchDBName))
+	require.Equal(t, []byte("second"), readDB(appDBName))
+}
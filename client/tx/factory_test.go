@@ -33,3 +33,14 @@ func TestFactoryPrepate(t *testing.T) {
 	require.Equal(t, output.AccountNumber(), uint64(10))
 	require.Equal(t, output.Sequence(), uint64(1))
 }
+
+func TestFactoryPrepareUnordered(t *testing.T) {
+	t.Parallel()
+
+	clientCtx := client.Context{}
+	factory := tx.Factory{}.WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 10, ReturnAccSeq: 1}).WithUnordered(true)
+	output, err := factory.Prepare(clientCtx.WithFrom("foo"))
+	require.NoError(t, err)
+	require.Equal(t, output.AccountNumber(), uint64(10))
+	require.Equal(t, output.Sequence(), uint64(0))
+}
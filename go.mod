@@ -162,9 +162,21 @@ require (
 
 // Here are the short-lived replace from the Cosmos SDK
 // Replace here are pending PRs, or version to be tagged
-// replace (
-// 	<temporary replace>
-// )
+//
+// Go ignores the replace of the dependencies, so the apps importing this version
+// of the SDK must replace the modules below in their own go.mod too, with the
+// modules of the same commit of this repository, e.g. from a checkout of it:
+//
+//	replace (
+//		cosmossdk.io/api => <cosmos-sdk checkout>/api
+//		cosmossdk.io/store => <cosmos-sdk checkout>/store
+//	)
+replace (
+	// cosmossdk.io/api with the x/auth key rotation, until it is tagged
+	cosmossdk.io/api => ./api
+	// cosmossdk.io/store with the differential snapshots, until it is tagged
+	cosmossdk.io/store => ./store
+)
 
 // Below are the long-lived replace of the Cosmos SDK
 replace (
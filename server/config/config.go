@@ -17,6 +17,12 @@ import (
 const (
 	defaultMinGasPrices = ""
 
+	// pruningOptionKeepEvery is the pruning strategy, keeping every n-th height
+	// on top of the recent ones, of older versions of the SDK, which is rejected.
+	pruningOptionKeepEvery = "keep-every"
+	keepEveryPruningMsg    = "keep-every pruning is not supported: set 'pruning-keep-every' of the stores in the 'pruning-stores' table " +
+		"with the 'custom' pruning strategy instead"
+
 	// DefaultAPIAddress defines the default address to bind the API server to.
 	DefaultAPIAddress = "tcp://localhost:1317"
 
@@ -90,6 +96,17 @@ type BaseConfig struct {
 	AppDBBackend string `mapstructure:"app-db-backend"`
 }
 
+// StorePruningConfig defines the pruning strategy of a store, overriding the
+// pruning strategy of the BaseConfig for this store.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+	// PruningKeepEvery keeps every n-th height of the store on top of the recent
+	// ones, with the custom pruning strategy only.
+	PruningKeepEvery string `mapstructure:"pruning-keep-every"`
+}
+
 // APIConfig defines the API listener configuration.
 type APIConfig struct {
 	// Enable defines if the API server should be enabled.
@@ -153,6 +170,24 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotDifferentialInterval sets the interval at which differential snapshots,
+	// containing the changes since the latest snapshot, are taken between the state
+	// sync snapshots. 0 disables differential snapshots.
+	SnapshotDifferentialInterval uint64 `mapstructure:"snapshot-differential-interval"`
+}
+
+// StateStorageConfig defines the state storage configuration.
+type StateStorageConfig struct {
+	// Enable defines if the state of each block should be written into the state
+	// storage, serving the historical queries instead of the IAVL stores.
+	Enable bool `mapstructure:"enable"`
+
+	// Pruning, PruningKeepRecent and PruningInterval define the pruning strategy
+	// of the state storage, like the ones of the BaseConfig for the IAVL stores.
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -183,6 +218,9 @@ type (
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
+	// PruningStores defines the pruning strategies of the stores, by store name
+	PruningStores map[string]StorePruningConfig `mapstructure:"pruning-stores"`
+
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
@@ -191,6 +229,9 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+
+	// StateStorage defines the state storage configuration
+	StateStorage StateStorageConfig `mapstructure:"state-storage"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -235,6 +276,7 @@ func DefaultConfig() *Config {
 			IAVLDisableFastNode: false,
 			AppDBBackend:        "",
 		},
+		PruningStores: make(map[string]StorePruningConfig),
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
@@ -269,6 +311,11 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		StateStorage: StateStorageConfig{
+			Pruning:           pruningtypes.PruningOptionNothing,
+			PruningKeepRecent: "0",
+			PruningInterval:   "0",
+		},
 	}
 }
 
@@ -291,6 +338,27 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Pruning == pruningOptionKeepEvery {
+		return sdkerrors.ErrAppConfig.Wrap(keepEveryPruningMsg)
+	}
+	for storeName, storePruning := range c.PruningStores {
+		if storePruning.Pruning == pruningOptionKeepEvery {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid pruning setting of store %s: %s", storeName, keepEveryPruningMsg)
+		}
+		if keepEvery := storePruning.PruningKeepEvery; keepEvery != "" && keepEvery != "0" && storePruning.Pruning != pruningtypes.PruningOptionCustom {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"invalid pruning setting of store %s: pruning-keep-every requires the '%s' pruning setting", storeName, pruningtypes.PruningOptionCustom,
+			)
+		}
+		if storePruning.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, storeName,
+			)
+		}
+	}
+	if interval := c.StateSync.SnapshotDifferentialInterval; interval > 0 && c.StateSync.SnapshotInterval%interval != 0 {
+		return sdkerrors.ErrAppConfig.Wrap("snapshot-interval must be a multiple of snapshot-differential-interval")
+	}
 
 	return nil
 }
@@ -138,6 +138,51 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestPruningStoresWriteRead(t *testing.T) {
+	expected := map[string]StorePruningConfig{
+		"bank": {Pruning: "nothing"},
+		"wasm": {Pruning: "custom", PruningKeepRecent: "100", PruningInterval: "10"},
+	}
+
+	// Create config with two PruningStores entries, and write it to a file.
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.PruningStores = expected
+	WriteConfigFile(confFile, conf)
+
+	// Read that file into viper.
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	rerr := vpr.ReadInConfig()
+	require.NoError(t, rerr, "reading config file into viper")
+	// Check that the raw viper value is correct.
+	require.Equal(t, "100", vpr.GetString("pruning-stores.wasm.pruning-keep-recent"), "viper value")
+	// Check that it is parsed into the config correctly.
+	cfg, perr := ParseConfig(vpr)
+	require.NoError(t, perr, "parsing config")
+	require.Equal(t, expected, cfg.PruningStores, "config value")
+}
+
+func TestValidateBasicKeepEveryPruning(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1)})
+	cfg.PruningStores = map[string]StorePruningConfig{"bank": {Pruning: "nothing"}}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Pruning = "keep-every"
+	require.ErrorContains(t, cfg.ValidateBasic(), "keep-every pruning is not supported")
+
+	cfg.Pruning = "default"
+	cfg.PruningStores["wasm"] = StorePruningConfig{Pruning: "keep-every"}
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid pruning setting of store wasm: keep-every pruning is not supported")
+
+	cfg.PruningStores["wasm"] = StorePruningConfig{Pruning: "custom", PruningKeepRecent: "100", PruningInterval: "10", PruningKeepEvery: "1000"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PruningStores["wasm"] = StorePruningConfig{Pruning: "default", PruningKeepEvery: "1000"}
+	require.ErrorContains(t, cfg.ValidateBasic(), "invalid pruning setting of store wasm: pruning-keep-every requires the 'custom' pruning setting")
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -83,6 +83,34 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+###############################################################################
+###                       Store Pruning Configuration                       ###
+###############################################################################
+
+# PruningStores overrides the pruning strategy above for the stores with the given
+# names, with the same pruning, pruning-keep-recent and pruning-interval options.
+# A store pruned with its own strategy may be missing from historical queries at
+# heights it has already pruned. With the custom strategy, pruning-keep-every keeps
+# every n-th height of the store on top of the recent ones (0 to disable). The kept
+# heights are copied into an archive of the store before they are pruned, they can
+# be queried but cannot be proven.
+#
+# Example:
+# [pruning-stores.wasm]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+# pruning-keep-every = "10000"
+[pruning-stores]
+{{- range $name, $store := .PruningStores }}
+
+[pruning-stores.{{ $name }}]
+pruning = "{{ $store.Pruning }}"
+pruning-keep-recent = "{{ $store.PruningKeepRecent }}"
+pruning-interval = "{{ $store.PruningInterval }}"
+pruning-keep-every = "{{ $store.PruningKeepEvery }}"
+{{- end }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -194,6 +222,35 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-differential-interval specifies the block interval at which differential snapshots,
+# containing only the changes since the latest snapshot, are taken in between the state sync
+# snapshots (0 to disable). They can only be restored locally, on top of their base snapshots.
+# snapshot-interval must be a multiple of it, and pruning-keep-recent must be at least as large
+# for the changes to still be available, otherwise a full snapshot is taken instead.
+snapshot-differential-interval = {{ .StateSync.SnapshotDifferentialInterval }}
+
+###############################################################################
+###                         State Storage Configuration                     ###
+###############################################################################
+
+# The state storage records the state of each block into a flat key-value database, in the
+# data directory, serving the historical queries instead of the IAVL stores. The IAVL stores
+# can then be pruned aggressively while preserving the queries of the pruned heights.
+[state-storage]
+
+# enable defines if the state storage should be written and queried. The state of the
+# latest height is imported when it is first enabled, and again once a state sync snapshot
+# is restored; the heights before are only queryable if they are retained by the IAVL stores.
+enable = {{ .StateStorage.Enable }}
+
+# The pruning strategy of the state storage, with the same options as the pruning strategy
+# of the IAVL stores above: default, nothing, everything or custom, along with
+# pruning-keep-recent and pruning-interval for custom. The state storage is queryable at
+# the heights it retains.
+pruning = "{{ .StateStorage.Pruning }}"
+pruning-keep-recent = "{{ .StateStorage.PruningKeepRecent }}"
+pruning-interval = "{{ .StateStorage.PruningInterval }}"
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -138,6 +138,14 @@ func (ms multiStore) SetIAVLCacheSize(size int) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStorePruning(storeName string, opts pruningtypes.PruningOptions) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetCommitmentBackend(storeName string, backend storetypes.CommitmentBackend) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetIAVLDisableFastNode(disable bool) {
 	panic("not implemented")
 }
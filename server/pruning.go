@@ -15,16 +15,69 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(
+		appOpts.Get(FlagPruning),
+		appOpts.Get(FlagPruningKeepRecent),
+		appOpts.Get(FlagPruningInterval),
+	)
+}
+
+// GetStorePruningOptionsFromFlags parses the pruning options of the stores with
+// their own pruning strategy, by store name, from the 'pruning-stores' table.
+// Each store accepts the same 'pruning', 'pruning-keep-recent' and
+// 'pruning-interval' options as the pruning strategy of all the stores, and
+// the 'pruning-keep-every' option of the custom pruning strategy.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	v := appOpts.Get(FlagPruningStores)
+	if v == nil {
+		return map[string]pruningtypes.PruningOptions{}, nil
+	}
+
+	storesOpts, err := cast.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", FlagPruningStores, err)
+	}
+
+	storePruningOpts := make(map[string]pruningtypes.PruningOptions, len(storesOpts))
+	for storeName, v := range storesOpts {
+		storeOpts, err := cast.ToStringMapE(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", storeName, err)
+		}
+
+		opts, err := parsePruningOptions(
+			storeOpts[FlagPruning],
+			storeOpts[FlagPruningKeepRecent],
+			storeOpts[FlagPruningInterval],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning options of store %s: %w", storeName, err)
+		}
+
+		if keepEvery := cast.ToUint64(storeOpts[FlagPruningKeepEvery]); keepEvery > 0 {
+			opts.KeepEvery = keepEvery
+			if err := opts.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid pruning options of store %s: %w", storeName, err)
+			}
+		}
+
+		storePruningOpts[storeName] = opts
+	}
+
+	return storePruningOpts, nil
+}
 
-	switch strategy {
+// parsePruningOptions returns the PruningOptions of the given pruning strategy,
+// using the keep-recent and interval values if and only if it is custom.
+func parsePruningOptions(strategy, keepRecent, interval interface{}) (pruningtypes.PruningOptions, error) {
+	switch strategy := strings.ToLower(cast.ToString(strategy)); strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
 		return pruningtypes.NewPruningOptionsFromString(strategy), nil
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecent),
+			cast.ToUint64(interval),
 		)
 
 		if err := opts.Validate(); err != nil {
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
 func TestGetPruningOptionsFromFlags(t *testing.T) {
@@ -66,3 +68,122 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		pruningStores   interface{}
+		expectedOptions map[string]pruningtypes.PruningOptions
+		wantErr         bool
+	}{
+		{
+			name:            "no store pruning options",
+			expectedOptions: map[string]pruningtypes.PruningOptions{},
+		},
+		{
+			name: "store pruning options",
+			pruningStores: map[string]interface{}{
+				"bank": map[string]interface{}{
+					FlagPruning: pruningtypes.PruningOptionNothing,
+				},
+				"wasm": map[string]interface{}{
+					FlagPruning:           pruningtypes.PruningOptionCustom,
+					FlagPruningKeepRecent: "100",
+					FlagPruningInterval:   "10",
+				},
+			},
+			expectedOptions: map[string]pruningtypes.PruningOptions{
+				"bank": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+				"wasm": pruningtypes.NewCustomPruningOptions(100, 10),
+			},
+		},
+		{
+			name: "keep-every store pruning options",
+			pruningStores: map[string]interface{}{
+				"wasm": map[string]interface{}{
+					FlagPruning:           pruningtypes.PruningOptionCustom,
+					FlagPruningKeepRecent: "100",
+					FlagPruningInterval:   "10",
+					FlagPruningKeepEvery:  "1000",
+				},
+			},
+			expectedOptions: map[string]pruningtypes.PruningOptions{
+				"wasm": pruningtypes.NewKeepEveryPruningOptions(100, 1000, 10),
+			},
+		},
+		{
+			name: "keep-every store pruning options not custom",
+			pruningStores: map[string]interface{}{
+				"wasm": map[string]interface{}{
+					FlagPruning:          pruningtypes.PruningOptionDefault,
+					FlagPruningKeepEvery: "1000",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid custom store pruning options",
+			pruningStores: map[string]interface{}{
+				"wasm": map[string]interface{}{
+					FlagPruning:           pruningtypes.PruningOptionCustom,
+					FlagPruningKeepRecent: "100",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown store pruning strategy",
+			pruningStores: map[string]interface{}{
+				"wasm": map[string]interface{}{
+					FlagPruning: "keep-every",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			if tt.pruningStores != nil {
+				v.Set(FlagPruningStores, tt.pruningStores)
+			}
+
+			opts, err := GetStorePruningOptionsFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOptions, opts)
+		})
+	}
+}
+
+func TestGetStateStoragePruningOptions(t *testing.T) {
+	v := viper.New()
+	v.Set(flags.FlagHome, t.TempDir())
+	v.Set(FlagStateStorageEnable, true)
+	v.Set(FlagStateStoragePruning, pruningtypes.PruningOptionCustom)
+	v.Set(FlagStateStoragePruningKeepRecent, "100")
+	v.Set(FlagStateStoragePruningInterval, "10")
+
+	stateStorage, err := GetStateStorage(v)
+	require.NoError(t, err)
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(100, 10), stateStorage.GetPruning())
+	require.NoError(t, stateStorage.Close())
+
+	// the state storage prunes nothing by default
+	v.Set(FlagStateStoragePruning, "")
+	stateStorage, err = GetStateStorage(v)
+	require.NoError(t, err)
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), stateStorage.GetPruning())
+	require.NoError(t, stateStorage.Close())
+
+	v.Set(FlagStateStoragePruning, "keep-every")
+	_, err = GetStateStorage(v)
+	require.Error(t, err)
+}
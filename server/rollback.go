@@ -1,7 +1,10 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	"github.com/spf13/cobra"
@@ -23,6 +26,11 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also rolls back to height n - 1. No blocks are removed, so upon
 restarting CometBFT the transactions in block n will be re-executed against the
 application.
+
+If the rolled back height is the height of an upgrade with a contingency plan, as read
+from the upgrade-info.json file, the upgrade is recorded as failed in the
+upgrade-failure-info.json file. Cosmovisor then switches back to the previous binary,
+which skips the upgrade when re-executing block n.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
@@ -44,6 +52,14 @@ application.
 			}
 
 			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+
+			name, err := recordRolledBackUpgrade(ctx.Config.RootDir, height)
+			if err != nil {
+				return fmt.Errorf("failed to record the rolled back upgrade: %w", err)
+			}
+			if name != "" {
+				fmt.Printf("Recorded upgrade %s as failed, the previous binary skips it\n", name)
+			}
 			return nil
 		},
 	}
@@ -51,3 +67,49 @@ application.
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
 	return cmd
 }
+
+const (
+	// upgradeInfoFilename is the file x/upgrade writes the upgrade plan to
+	// when halting at its height.
+	upgradeInfoFilename = "upgrade-info.json"
+	// upgradeFailureInfoFilename is the file x/upgrade reads the failed upgrade
+	// from, to skip it in the previous binary.
+	upgradeFailureInfoFilename = "upgrade-failure-info.json"
+)
+
+// recordRolledBackUpgrade records the upgrade at height + 1 as failed if it
+// has a contingency plan, the block at that height being rolled back. It
+// returns the name of the recorded upgrade, if any.
+func recordRolledBackUpgrade(rootDir string, height int64) (string, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	bz, err := os.ReadFile(filepath.Join(dataDir, upgradeInfoFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var plan struct {
+		Name        string          `json:"name"`
+		Height      int64           `json:"height"`
+		Contingency json.RawMessage `json:"contingency"`
+	}
+	if err := json.Unmarshal(bz, &plan); err != nil {
+		return "", err
+	}
+
+	if plan.Name == "" || plan.Height != height+1 || len(plan.Contingency) == 0 || string(plan.Contingency) == "null" {
+		return "", nil
+	}
+
+	failure, err := json.Marshal(map[string]any{
+		"plan":  json.RawMessage(bz),
+		"error": fmt.Sprintf("upgrade rolled back to height %d", height),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return plan.Name, os.WriteFile(filepath.Join(dataDir, upgradeFailureInfoFilename), failure, 0o600)
+}
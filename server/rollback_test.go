@@ -0,0 +1,44 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRolledBackUpgrade(t *testing.T) {
+	rootDir := t.TempDir()
+	dataDir := filepath.Join(rootDir, "data")
+	require.NoError(t, os.MkdirAll(dataDir, 0o700))
+	failureFile := filepath.Join(dataDir, upgradeFailureInfoFilename)
+
+	// no upgrade
+	name, err := recordRolledBackUpgrade(rootDir, 10)
+	require.NoError(t, err)
+	require.Empty(t, name)
+
+	// an upgrade without a contingency plan cannot be skipped
+	plan := `{"name":"v2","height":11}`
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, upgradeInfoFilename), []byte(plan), 0o600))
+	name, err = recordRolledBackUpgrade(rootDir, 10)
+	require.NoError(t, err)
+	require.Empty(t, name)
+	require.NoFileExists(t, failureFile)
+
+	// the rolled back block is not the upgrade one
+	plan = `{"name":"v2","height":11,"contingency":{"info":"v1"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, upgradeInfoFilename), []byte(plan), 0o600))
+	name, err = recordRolledBackUpgrade(rootDir, 11)
+	require.NoError(t, err)
+	require.Empty(t, name)
+	require.NoFileExists(t, failureFile)
+
+	name, err = recordRolledBackUpgrade(rootDir, 10)
+	require.NoError(t, err)
+	require.Equal(t, "v2", name)
+	bz, err := os.ReadFile(failureFile)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"plan":`+plan+`,"error":"upgrade rolled back to height 10"}`, string(bz))
+}
@@ -59,14 +59,23 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningKeepEvery    = "pruning-keep-every"
+	FlagPruningStores       = "pruning-stores"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval             = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent           = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotDifferentialInterval = "state-sync.snapshot-differential-interval"
+
+	// state storage-related flags
+	FlagStateStorageEnable            = "state-storage.enable"
+	FlagStateStoragePruning           = "state-storage.pruning"
+	FlagStateStoragePruningKeepRecent = "state-storage.pruning-keep-recent"
+	FlagStateStoragePruningInterval   = "state-storage.pruning-interval"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -203,6 +212,11 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotDifferentialInterval, 0, "Differential snapshot interval, between state sync snapshots")
+	cmd.Flags().Bool(FlagStateStorageEnable, false, "Write the state of each block into the state storage, serving the historical queries")
+	cmd.Flags().String(FlagStateStoragePruning, pruningtypes.PruningOptionNothing, "State storage pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagStateStoragePruningKeepRecent, 0, "Number of recent heights kept by the state storage (ignored if its pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagStateStoragePruningInterval, 0, "Height interval at which the state storage is pruned (ignored if its pruning is not 'custom')")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 
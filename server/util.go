@@ -26,8 +26,10 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -471,6 +473,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	storePruningOpts, err := GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -488,11 +495,17 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
-	snapshotOptions := snapshottypes.NewSnapshotOptions(
+	snapshotOptions := snapshottypes.NewDifferentialSnapshotOptions(
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
+		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotDifferentialInterval)),
 	)
 
+	stateStorage, err := GetStateStorage(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
 		defaultMempool = baseapp.SetMempool(
@@ -504,6 +517,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStorePruning(storePruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
@@ -512,6 +526,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		baseapp.SetStateStorage(stateStorage),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		defaultMempool,
@@ -519,6 +534,38 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	}
 }
 
+// GetStateStorage returns the state storage in the data directory, or nil if it is not
+// enabled. It prunes nothing unless a pruning strategy is set for it. Its database is
+// closed by BaseApp.Close.
+func GetStateStorage(appOpts types.AppOptions) (*storage.Store, error) {
+	if !cast.ToBool(appOpts.Get(FlagStateStorageEnable)) {
+		return nil, nil
+	}
+
+	pruningOpts := pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+	if strategy := appOpts.Get(FlagStateStoragePruning); cast.ToString(strategy) != "" {
+		var err error
+		pruningOpts, err = parsePruningOptions(
+			strategy,
+			appOpts.Get(FlagStateStoragePruningKeepRecent),
+			appOpts.Get(FlagStateStoragePruningInterval),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state storage pruning options: %w", err)
+		}
+	}
+
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	db, err := dbm.NewDB("state_storage", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the state storage: %w", err)
+	}
+
+	stateStorage := storage.NewStore(db)
+	stateStorage.SetPruning(pruningOpts)
+	return stateStorage, nil
+}
+
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
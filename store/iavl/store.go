@@ -8,7 +8,6 @@ import (
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/iavl"
-	ics23 "github.com/cosmos/ics23/go"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -30,6 +29,8 @@ var (
 	_ types.CommitKVStore           = (*Store)(nil)
 	_ types.Queryable               = (*Store)(nil)
 	_ types.StoreWithInitialVersion = (*Store)(nil)
+	_ types.CommitmentStore         = (*Store)(nil)
+	_ types.CommitmentBackend       = (*CommitmentBackend)(nil)
 )
 
 // Store Implements types.KVStore and CommitKVStore.
@@ -83,6 +84,36 @@ func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKe
 	}, nil
 }
 
+// CommitmentBackend is the types.CommitmentBackend of the IAVL stores, the default
+// commitment backend of the stores mounted in rootmulti.Store.
+type CommitmentBackend struct {
+	logger          log.Logger
+	cacheSize       int
+	disableFastNode bool
+	metrics         metrics.StoreMetrics
+}
+
+// NewCommitmentBackend returns the CommitmentBackend of the IAVL stores with the given
+// options.
+func NewCommitmentBackend(logger log.Logger, cacheSize int, disableFastNode bool, metrics metrics.StoreMetrics) *CommitmentBackend {
+	return &CommitmentBackend{
+		logger:          logger,
+		cacheSize:       cacheSize,
+		disableFastNode: disableFastNode,
+		metrics:         metrics,
+	}
+}
+
+// LoadStore implements types.CommitmentBackend, loading the IAVL store with
+// LoadStoreWithInitialVersion.
+func (b *CommitmentBackend) LoadStore(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitmentStore, error) {
+	store, err := LoadStoreWithInitialVersion(db, b.logger, key, id, initialVersion, b.cacheSize, b.disableFastNode, b.metrics)
+	if err != nil {
+		return nil, err
+	}
+	return store.(*Store), nil
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
@@ -117,6 +148,16 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}, nil
 }
 
+// GetImmutableStore implements types.CommitmentStore, returning the store of
+// GetImmutable.
+func (st *Store) GetImmutableStore(version int64) (types.KVStore, error) {
+	istore, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return istore, nil
+}
+
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
@@ -281,6 +322,15 @@ func (st *Store) Import(version int64) (*iavl.Importer, error) {
 	return tree.Import(version)
 }
 
+// SaveChangeSet replays a change set as the next version of the IAVL store, returning the new version.
+func (st *Store) SaveChangeSet(cs *iavl.ChangeSet) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, errors.New("iavl change set replay failed: unable to find mutable tree")
+	}
+	return tree.SaveChangeSet(cs)
+}
+
 // Handle gatest the latest height, if height is 0
 func getHeight(tree Tree, req *types.RequestQuery) int64 {
 	height := req.Height
@@ -388,27 +438,11 @@ func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(ve
 // appropriate merkle.Proof. Since this must be called after querying for the value, this function should never error
 // Thus, it will panic on error rather than returning it
 func getProofFromTree(tree *iavl.MutableTree, key []byte, exists bool) *cmtprotocrypto.ProofOps {
-	var (
-		commitmentProof *ics23.CommitmentProof
-		err             error
-	)
-
-	if exists {
-		// value was found
-		commitmentProof, err = tree.GetMembershipProof(key)
-		if err != nil {
-			// sanity check: If value was found, membership proof must be creatable
-			panic(fmt.Sprintf("unexpected value for empty proof: %s", err.Error()))
-		}
-	} else {
-		// value wasn't found
-		commitmentProof, err = tree.GetNonMembershipProof(key)
-		if err != nil {
-			// sanity check: If value wasn't found, nonmembership proof must be creatable
-			panic(fmt.Sprintf("unexpected error for nonexistence proof: %s", err.Error()))
-		}
+	proofOps, err := types.ProveCommitment(types.ProofOpIAVLCommitment, tree, key, exists)
+	if err != nil {
+		// sanity check: If value was found, membership proof must be creatable, and
+		// if value wasn't found, nonmembership proof must be creatable
+		panic(fmt.Sprintf("unexpected error for commitment proof: %s", err.Error()))
 	}
-
-	op := types.NewIavlCommitmentOp(key, commitmentProof)
-	return &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op.ProofOp()}}
+	return proofOps
 }
@@ -0,0 +1,38 @@
+package proofs
+
+import (
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// Prover creates the ICS-23 commitment proofs of the keys of a committed state,
+// such as the tree of a store.
+type Prover interface {
+	// GetMembershipProof returns the proof that the key exists in the state with its value.
+	GetMembershipProof(key []byte) (*ics23.CommitmentProof, error)
+	// GetNonMembershipProof returns the proof that the key doesn't exist in the state.
+	GetNonMembershipProof(key []byte) (*ics23.CommitmentProof, error)
+}
+
+// CreateCommitmentProof creates the membership proof of the key with the prover if
+// it exists, or its non-membership proof otherwise.
+func CreateCommitmentProof(prover Prover, key []byte, exists bool) (*ics23.CommitmentProof, error) {
+	if exists {
+		return prover.GetMembershipProof(key)
+	}
+	return prover.GetNonMembershipProof(key)
+}
+
+// MapProver is the Prover of the simple merkle tree of a map.
+type MapProver map[string][]byte
+
+var _ Prover = MapProver{}
+
+// GetMembershipProof implements Prover.
+func (p MapProver) GetMembershipProof(key []byte) (*ics23.CommitmentProof, error) {
+	return CreateMembershipProof(p, key)
+}
+
+// GetNonMembershipProof implements Prover.
+func (p MapProver) GetNonMembershipProof(key []byte) (*ics23.CommitmentProof, error) {
+	return CreateNonMembershipProof(p, key)
+}
@@ -0,0 +1,31 @@
+package proofs
+
+import (
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCommitmentProof(t *testing.T) {
+	data := BuildMap(100)
+	allkeys := SortedKeys(data)
+	key := GetKey(allkeys, Middle)
+	nonKey := GetNonKey(allkeys, Middle)
+	root := CalcRoot(data)
+	prover := MapProver(data)
+
+	proof, err := CreateCommitmentProof(prover, []byte(key), true)
+	assert.NoError(t, err)
+	assert.True(t, ics23.VerifyMembership(ics23.TendermintSpec, root, proof, []byte(key), data[key]))
+
+	proof, err = CreateCommitmentProof(prover, []byte(nonKey), false)
+	assert.NoError(t, err)
+	assert.True(t, ics23.VerifyNonMembership(ics23.TendermintSpec, root, proof, []byte(nonKey)))
+
+	// the proof must match whether the key exists
+	_, err = CreateCommitmentProof(prover, []byte(nonKey), true)
+	assert.Error(t, err)
+	_, err = CreateCommitmentProof(prover, []byte(key), false)
+	assert.Error(t, err)
+}
@@ -16,9 +16,11 @@ import (
 // determining when to prune old heights of the store
 // based on the strategy described by the pruning options.
 type Manager struct {
-	db               dbm.DB
-	logger           log.Logger
-	opts             types.PruningOptions
+	db     dbm.DB
+	logger log.Logger
+	opts   types.PruningOptions
+	// storeOpts are the pruning strategies of the stores, by store name, overriding opts.
+	storeOpts        map[string]types.PruningOptions
 	snapshotInterval uint64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleSnapshotHeight.
@@ -51,6 +53,7 @@ func NewManager(db dbm.DB, logger log.Logger) *Manager {
 		db:                   db,
 		logger:               logger,
 		opts:                 types.NewPruningOptions(types.PruningNothing),
+		storeOpts:            make(map[string]types.PruningOptions),
 		pruneSnapshotHeights: []int64{0},
 	}
 }
@@ -65,12 +68,40 @@ func (m *Manager) GetOptions() types.PruningOptions {
 	return m.opts
 }
 
+// SetStoreOptions sets the pruning strategy of the store with the given name, overriding
+// the pruning strategy of the manager.
+func (m *Manager) SetStoreOptions(storeName string, opts types.PruningOptions) {
+	m.storeOpts[storeName] = opts
+}
+
+// GetStoreOptions fetches the pruning strategy of the store with the given name, and
+// whether it overrides the pruning strategy of the manager.
+func (m *Manager) GetStoreOptions(storeName string) (types.PruningOptions, bool) {
+	if opts, ok := m.storeOpts[storeName]; ok {
+		return opts, true
+	}
+	return m.opts, false
+}
+
+// prunesNothing returns whether no store is pruned by any of the pruning strategies.
+func (m *Manager) prunesNothing() bool {
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		return false
+	}
+	for _, opts := range m.storeOpts {
+		if opts.GetPruningStrategy() != types.PruningNothing {
+			return false
+		}
+	}
+	return true
+}
+
 // HandleSnapshotHeight persists the snapshot height to be pruned at the next appropriate
 // height defined by the pruning strategy. It flushes the update to disk and panics if the flush fails.
 // The input height must be greater than 0, and the pruning strategy must not be set to pruning nothing.
 // If either of these conditions is not met, this function does nothing.
 func (m *Manager) HandleSnapshotHeight(height int64) {
-	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
+	if m.prunesNothing() || height <= 0 {
 		return
 	}
 
@@ -101,20 +132,58 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 
 // GetPruningHeight returns the height which can prune upto if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	return m.getPruningHeight(m.opts, height)
+}
+
+// GetStorePruningHeight returns the height which the store with the given name can prune upto
+// if it is able to prune at the given height, according to its own pruning strategy if any.
+func (m *Manager) GetStorePruningHeight(storeName string, height int64) int64 {
+	opts, _ := m.GetStoreOptions(storeName)
+	return m.getPruningHeight(opts, height)
+}
+
+// getPruningHeight returns the height which can prune upto with the given pruning strategy
+// if it is able to prune at the given height.
+func (m *Manager) getPruningHeight(opts types.PruningOptions, height int64) int64 {
+	if opts.GetPruningStrategy() == types.PruningNothing {
 		return 0
 	}
-	if m.opts.Interval <= 0 {
+	if opts.Interval <= 0 {
 		return 0
 	}
 
-	if height%int64(m.opts.Interval) != 0 || height <= int64(m.opts.KeepRecent) {
+	if height%int64(opts.Interval) != 0 || height <= int64(opts.KeepRecent) {
 		return 0
 	}
 
 	// Consider the snapshot height
-	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	pruneHeight := height - 1 - int64(opts.KeepRecent) // we should keep the current height at least
+
+	return m.snapshotPruningHeight(pruneHeight)
+}
+
+// GetStorePruneToHeight returns the height which the store with the given name can prune upto
+// when all the stores are explicitly pruned upto the given height, the latest height being the
+// given one. The stores with their own pruning strategy keep their own number of recent heights
+// instead, or are not pruned if they prune nothing. Unlike GetStorePruningHeight, it does not wait
+// for the pruning interval, but it keeps the heights of the pending snapshots all the same.
+func (m *Manager) GetStorePruneToHeight(storeName string, latestHeight, pruneHeight int64) int64 {
+	if opts, ok := m.storeOpts[storeName]; ok {
+		if opts.GetPruningStrategy() == types.PruningNothing {
+			return 0
+		}
+		pruneHeight = latestHeight - int64(opts.KeepRecent)
+	}
+	if pruneHeight <= 0 {
+		return 0
+	}
+
+	return m.snapshotPruningHeight(pruneHeight)
+}
 
+// snapshotPruningHeight returns the height which can prune upto, no further than the given
+// height, without pruning the heights of the pending snapshots.
+func (m *Manager) snapshotPruningHeight(pruneHeight int64) int64 {
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
 
@@ -138,7 +207,7 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	if m.prunesNothing() {
 		return nil
 	}
 
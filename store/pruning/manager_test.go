@@ -196,6 +196,50 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestStorePruningHeight(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	require.NotNil(t, manager)
+	manager.SetOptions(types.NewPruningOptions(types.PruningNothing))
+	manager.SetStoreOptions("custom", types.NewCustomPruningOptions(2, 5))
+
+	opts, ok := manager.GetStoreOptions("custom")
+	require.True(t, ok)
+	require.Equal(t, types.NewCustomPruningOptions(2, 5), opts)
+	opts, ok = manager.GetStoreOptions("other")
+	require.False(t, ok)
+	require.Equal(t, types.NewPruningOptions(types.PruningNothing), opts)
+
+	require.Equal(t, int64(0), manager.GetPruningHeight(10))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("other", 10))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("custom", 9))
+	require.Equal(t, int64(7), manager.GetStorePruningHeight("custom", 10))
+
+	// the snapshot heights are kept by the stores with their own pruning strategy
+	manager.SetSnapshotInterval(3)
+	manager.HandleSnapshotHeight(3)
+	require.Equal(t, int64(5), manager.GetStorePruningHeight("custom", 10))
+}
+
+func TestStorePruneToHeight(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	require.NotNil(t, manager)
+	manager.SetOptions(types.NewCustomPruningOptions(2, 5))
+	manager.SetStoreOptions("custom", types.NewCustomPruningOptions(4, 5))
+	manager.SetStoreOptions("nothing", types.NewPruningOptions(types.PruningNothing))
+
+	// the stores are pruned regardless of the pruning interval
+	require.Equal(t, int64(8), manager.GetStorePruneToHeight("other", 11, 8))
+	require.Equal(t, int64(7), manager.GetStorePruneToHeight("custom", 11, 8))
+	require.Equal(t, int64(0), manager.GetStorePruneToHeight("nothing", 11, 8))
+	require.Equal(t, int64(0), manager.GetStorePruneToHeight("custom", 3, 1))
+
+	// the heights of the pending snapshots are kept
+	manager.SetSnapshotInterval(3)
+	manager.HandleSnapshotHeight(3)
+	require.Equal(t, int64(5), manager.GetStorePruneToHeight("other", 11, 8))
+	require.Equal(t, int64(5), manager.GetStorePruneToHeight("custom", 11, 8))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
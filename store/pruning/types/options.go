@@ -14,6 +14,10 @@ type PruningOptions struct {
 	// Interval defines when the pruned heights are removed from disk.
 	Interval uint64
 
+	// KeepEvery defines, if not 0, that every KeepEvery-th height is kept on top
+	// of the recent ones. It is only supported by the custom pruning strategy.
+	KeepEvery uint64
+
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
 }
@@ -56,6 +60,7 @@ var (
 	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
 	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
 	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningKeepEveryNotCustom = errors.New("'pruning-keep-every' can only be set with pruning = \"custom\"")
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -93,11 +98,22 @@ func NewCustomPruningOptions(keepRecent, interval uint64) PruningOptions {
 	}
 }
 
+// NewKeepEveryPruningOptions returns the custom pruning options keeping every
+// keepEvery-th height on top of the keepRecent recent heights.
+func NewKeepEveryPruningOptions(keepRecent, keepEvery, interval uint64) PruningOptions {
+	opts := NewCustomPruningOptions(keepRecent, interval)
+	opts.KeepEvery = keepEvery
+	return opts
+}
+
 func (po PruningOptions) GetPruningStrategy() PruningStrategy {
 	return po.Strategy
 }
 
 func (po PruningOptions) Validate() error {
+	if po.KeepEvery > 0 && po.Strategy != PruningCustom {
+		return ErrPruningKeepEveryNotCustom
+	}
 	if po.Strategy == PruningNothing {
 		return nil
 	}
@@ -21,6 +21,9 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{NewKeepEveryPruningOptions(2, 100, 10), nil},
+		{NewKeepEveryPruningOptions(1, 100, 10), ErrPruningKeepRecentTooSmall},
+		{PruningOptions{KeepEvery: 100, Strategy: PruningNothing}, ErrPruningKeepEveryNotCustom},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,309 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+const archivePrefixFmt = "s/a:%s/" // s/a:<name>/
+
+var (
+	archiveTreePrefix   = []byte("t/")
+	archiveHeightPrefix = []byte("h/") // h/<height> -> <archive version>
+)
+
+// archive keeps the heights of a store with the keep-every pruning strategy once
+// they are pruned from the store. IAVL can only delete all the versions of a tree
+// up to a height, so the state of every kept height is copied, before it is pruned,
+// into the next version of a tree of its own, indexed by height.
+//
+// NOTE: The hashes of an IAVL tree depend on the versions of its nodes, so the
+// states of the archive are not provable against the app hashes of their heights.
+type archive struct {
+	db    dbm.DB
+	store types.CommitmentStore
+}
+
+// getArchive returns the archive of the store with the given name, loading it on
+// first use, or nil if the pruning strategy of the store keeps no n-th height.
+func (rs *Store) getArchive(storeName string) (*archive, error) {
+	if rs.GetStorePruning(storeName).KeepEvery == 0 {
+		return nil, nil
+	}
+
+	rs.archivesMtx.Lock()
+	defer rs.archivesMtx.Unlock()
+
+	if a, ok := rs.archives[storeName]; ok {
+		return a, nil
+	}
+
+	db := dbm.NewPrefixDB(rs.db, []byte(fmt.Sprintf(archivePrefixFmt, storeName)))
+	store, err := rs.iavlCommitmentBackend().LoadStore(
+		dbm.NewPrefixDB(db, archiveTreePrefix), types.NewKVStoreKey(storeName), types.CommitID{}, 0,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to load the archive of store %s", storeName)
+	}
+
+	a := &archive{db: db, store: store}
+	rs.archives[storeName] = a
+	return a, nil
+}
+
+// archiveVersions copies the heights the keep-every pruning strategy of the store
+// keeps, up to the pruning height, into its archive before they are pruned.
+func (rs *Store) archiveVersions(storeName string, store types.CommitmentStore, pruningHeight int64) error {
+	a, err := rs.getArchive(storeName)
+	if err != nil || a == nil {
+		return err
+	}
+
+	lastHeight, err := a.lastHeight()
+	if err != nil {
+		return err
+	}
+
+	keepEvery := int64(rs.GetStorePruning(storeName).KeepEvery)
+	for height := (lastHeight/keepEvery + 1) * keepEvery; height <= pruningHeight; height += keepEvery {
+		// the height may be pruned before the strategy of the store kept it
+		if !store.VersionExists(height) {
+			continue
+		}
+
+		state, err := store.GetImmutableStore(height)
+		if err != nil {
+			return err
+		}
+		if err := a.save(height, state); err != nil {
+			return errorsmod.Wrapf(err, "failed to archive height %d of store %s", height, storeName)
+		}
+
+		rs.logger.Debug("archived store height", "store", storeName, "height", height)
+	}
+
+	return nil
+}
+
+// archivedStore returns the read-only store of the given height of the store
+// from its archive, or nil if the height is not archived.
+func (rs *Store) archivedStore(storeName string, height int64) (types.KVStore, error) {
+	a, err := rs.getArchive(storeName)
+	if err != nil || a == nil {
+		return nil, err
+	}
+
+	version, err := a.version(height)
+	if err != nil || version == 0 {
+		return nil, err
+	}
+
+	return a.store.GetImmutableStore(version)
+}
+
+// queryArchive queries the given height of the store from its archive, returning
+// false if the height is not archived. The archived heights cannot be proven.
+func (rs *Store) queryArchive(storeName string, req *types.RequestQuery) (*types.ResponseQuery, bool, error) {
+	a, err := rs.getArchive(storeName)
+	if err != nil {
+		return &types.ResponseQuery{}, true, err
+	}
+	if a == nil {
+		return nil, false, nil
+	}
+
+	version, err := a.version(req.Height)
+	if err != nil {
+		return &types.ResponseQuery{}, true, err
+	}
+	if version == 0 {
+		return nil, false, nil
+	}
+
+	if req.Prove && RequireProof(req.Path) {
+		return &types.ResponseQuery{}, true, errorsmod.Wrapf(
+			types.ErrInvalidRequest, "height %d of store %s is archived by its keep-every pruning strategy and cannot be proven", req.Height, storeName,
+		)
+	}
+
+	archiveReq := *req
+	archiveReq.Height = version
+	res, err := a.store.Query(&archiveReq)
+	if res != nil {
+		res.Height = req.Height
+	}
+
+	return res, true, err
+}
+
+// rollbackArchives deletes the heights after the target height from the archives.
+func (rs *Store) rollbackArchives(target int64) error {
+	for key := range rs.stores {
+		a, err := rs.getArchive(key.Name())
+		if err != nil {
+			return err
+		}
+		if a == nil {
+			continue
+		}
+
+		if err := a.rollback(target); err != nil {
+			return errorsmod.Wrapf(err, "failed to roll back the archive of store %s", key.Name())
+		}
+
+		// the archive is loaded again on next use
+		rs.archivesMtx.Lock()
+		delete(rs.archives, key.Name())
+		rs.archivesMtx.Unlock()
+	}
+
+	return nil
+}
+
+func archiveHeightKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64(bytes.Clone(archiveHeightPrefix), uint64(height))
+}
+
+// version returns the version of the archive holding the height, or 0 if the
+// height is not archived.
+func (a *archive) version(height int64) (int64, error) {
+	bz, err := a.db.Get(archiveHeightKey(height))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// lastHeight returns the last archived height, or 0 if none is.
+func (a *archive) lastHeight() (int64, error) {
+	it, err := a.db.ReverseIterator(archiveHeightPrefix, types.PrefixEndBytes(archiveHeightPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, it.Error()
+	}
+
+	return int64(binary.BigEndian.Uint64(it.Key()[len(archiveHeightPrefix):])), nil
+}
+
+// save saves the state of the height as the next version of the archive, writing
+// the differences from the state of its last version.
+func (a *archive) save(height int64, state types.KVStore) error {
+	var last types.KVStore = dbadapter.Store{DB: dbm.NewMemDB()}
+	if version := a.store.LastCommitID().Version; version > 0 {
+		var err error
+		if last, err = a.store.GetImmutableStore(version); err != nil {
+			return err
+		}
+	}
+
+	it := state.Iterator(nil, nil)
+	defer it.Close()
+	lastIt := last.Iterator(nil, nil)
+	defer lastIt.Close()
+
+	for it.Valid() || lastIt.Valid() {
+		cmp := 0
+		switch {
+		case !lastIt.Valid():
+			cmp = -1
+		case !it.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(it.Key(), lastIt.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			a.store.Set(it.Key(), it.Value())
+			it.Next()
+		case cmp > 0:
+			a.store.Delete(lastIt.Key())
+			lastIt.Next()
+		default:
+			if !bytes.Equal(it.Value(), lastIt.Value()) {
+				a.store.Set(it.Key(), it.Value())
+			}
+			it.Next()
+			lastIt.Next()
+		}
+	}
+
+	version := a.store.Commit().Version
+	return a.db.SetSync(archiveHeightKey(height), binary.BigEndian.AppendUint64(nil, uint64(version)))
+}
+
+// rollback deletes the heights after the target height, and the versions of the
+// archive holding them.
+func (a *archive) rollback(target int64) error {
+	it, err := a.db.Iterator(archiveHeightKey(target+1), types.PrefixEndBytes(archiveHeightPrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.db.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	lastHeight, err := a.lastHeight()
+	if err != nil {
+		return err
+	}
+	if lastHeight == 0 {
+		return a.clear()
+	}
+
+	version, err := a.version(lastHeight)
+	if err != nil {
+		return err
+	}
+
+	return a.store.LoadVersionForOverwriting(version)
+}
+
+// clear deletes all the data of the archive.
+func (a *archive) clear() error {
+	it, err := a.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := a.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
@@ -0,0 +1,59 @@
+package rootmulti
+
+import (
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = prunedStore{}
+
+// prunedStore is the KVStore of a store in a branch of the multi-store at a
+// version the store has pruned by its own pruning strategy, while the other
+// stores retain it. Its operations panic with ErrVersionPruned, rather than the
+// store being missing from the branch.
+type prunedStore struct {
+	name    string
+	version int64
+}
+
+func (s prunedStore) err() error {
+	return errorsmod.Wrapf(types.ErrVersionPruned, "version %d of store %s is pruned by its own pruning strategy", s.version, s.name)
+}
+
+func (s prunedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+func (s prunedStore) CacheWrap() types.CacheWrap {
+	panic(s.err())
+}
+
+func (s prunedStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic(s.err())
+}
+
+func (s prunedStore) Get(_ []byte) []byte {
+	panic(s.err())
+}
+
+func (s prunedStore) Has(_ []byte) bool {
+	panic(s.err())
+}
+
+func (s prunedStore) Set(_, _ []byte) {
+	panic(s.err())
+}
+
+func (s prunedStore) Delete(_ []byte) {
+	panic(s.err())
+}
+
+func (s prunedStore) Iterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
+
+func (s prunedStore) ReverseIterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
@@ -233,6 +233,69 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestore_Differential(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	// a version without any change
+	source.Commit()
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 4, version)
+
+	restore := func(height uint64, format uint32, snapshot func(protoWriter *snapshots.StreamWriter) error) {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(t, streamWriter)
+			defer streamWriter.Close()
+			require.NoError(t, snapshot(streamWriter))
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		_, err = target.Restore(height, format, streamReader)
+		require.NoError(t, err)
+	}
+
+	restore(1, snapshottypes.CurrentFormat, func(protoWriter *snapshots.StreamWriter) error {
+		return source.Snapshot(1, protoWriter)
+	})
+	require.EqualValues(t, 1, target.LastCommitID().Version)
+
+	restore(version, snapshottypes.DifferentialFormat, func(protoWriter *snapshots.StreamWriter) error {
+		return source.SnapshotDifferential(1, version, protoWriter)
+	})
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func TestMultistoreSnapshotDifferential_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+
+	testcases := map[string]struct {
+		baseHeight uint64
+		height     uint64
+	}{
+		"0 base height":       {0, 3},
+		"base above height":   {3, 2},
+		"unknown height":      {1, 9},
+		"unknown base height": {9, 10},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.SnapshotDifferential(tc.baseHeight, tc.height, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -34,6 +35,9 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	storePrefixFmt           = "s/k:%s/" // s/k:<name>/
+	commitmentStorePrefixFmt = "s/c:%s/" // s/c:<name>/
 )
 
 const iavlDisablefastNodeDefault = false
@@ -73,6 +77,20 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+
+	// commitmentBackends are the commitment backends of the stores, by store name,
+	// loading them in place of the IAVL stores.
+	commitmentBackends map[string]types.CommitmentBackend
+	// migratedDBs are the databases of the IAVL stores migrated to their commitment
+	// backend, to be deleted once the migration is committed.
+	migratedDBs []dbm.DB
+	// snapshotsEnabled is whether state sync snapshots are taken, which only
+	// support the IAVL stores.
+	snapshotsEnabled bool
+	// archives are the archives of the stores with the keep-every pruning
+	// strategy, by store name, loaded on first use.
+	archives    map[string]*archive
+	archivesMtx sync.Mutex
 }
 
 var (
@@ -97,6 +115,8 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		removalMap:          make(map[types.StoreKey]bool),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
+		commitmentBackends:  make(map[string]types.CommitmentBackend),
+		archives:            make(map[string]*archive),
 	}
 }
 
@@ -112,6 +132,34 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// GetStorePruning fetches the pruning strategy of the store with the given name, the
+// pruning strategy of the root store unless it is overridden by SetStorePruning.
+func (rs *Store) GetStorePruning(storeName string) pruningtypes.PruningOptions {
+	opts, _ := rs.pruningManager.GetStoreOptions(storeName)
+	return opts
+}
+
+// SetStorePruning sets the pruning strategy of the store with the given name, overriding
+// the pruning strategy of the root store. A store pruned independently of the others can
+// be missing from the multistores of past versions, see CacheMultiStoreWithVersion. The
+// heights kept by a keep-every pruning strategy remain readable once pruned, but cannot
+// be proven, see Query.
+func (rs *Store) SetStorePruning(storeName string, opts pruningtypes.PruningOptions) {
+	rs.pruningManager.SetStoreOptions(storeName, opts)
+}
+
+// SetCommitmentBackend sets the commitment backend loading the store with the given
+// name, mounted with StoreTypeIAVL or StoreTypeSMT, in place of an IAVL store. It
+// must be set before the store is loaded. The stores of a commitment backend are kept
+// apart from the IAVL stores, an existing IAVL store is converted into a store of its
+// commitment backend when loaded with StoreUpgrades migrating it.
+//
+// NOTE: Only the IAVL stores are supported by state sync snapshots, so the store fails
+// to load if its backend does not load an IAVL store and snapshots are enabled.
+func (rs *Store) SetCommitmentBackend(storeName string, backend types.CommitmentBackend) {
+	rs.commitmentBackends[storeName] = backend
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -119,8 +167,10 @@ func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
+// Once snapshots are enabled, the stores of a commitment backend other than IAVL cannot be loaded.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
+	rs.snapshotsEnabled = snapshotInterval > 0
 }
 
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
@@ -237,6 +287,9 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		})
 	}
 
+	// the IAVL stores migrated by the upgrades to their commitment backend
+	rs.migratedDBs = nil
+
 	for _, key := range storesKeys {
 		storeParams := rs.storesParams[key]
 		commitID := rs.getCommitID(infos, key.Name())
@@ -245,11 +298,22 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		// If it has been added, set the initial version
 		if upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "" {
 			storeParams.initialVersion = uint64(ver) + 1
-		} else if commitID.Version != ver && storeParams.typ == types.StoreTypeIAVL {
+		} else if commitID.Version != ver && isCommitmentStoreType(storeParams.typ) {
 			return fmt.Errorf("version of store %s mismatch root store's version; expected %d got %d; new stores should be added using StoreUpgrades", key.Name(), ver, commitID.Version)
 		}
 
-		store, err := rs.loadCommitStoreFromParams(key, commitID, storeParams)
+		// If it is migrated, load the new store of its commitment backend, empty
+		// until the data of the IAVL store is copied to it
+		storeCommitID := commitID
+		if upgrades.IsMigrated(key.Name()) {
+			if _, ok := rs.commitmentBackends[key.Name()]; !ok {
+				return fmt.Errorf("cannot migrate store %s without commitment backend", key.Name())
+			}
+			storeParams.initialVersion = uint64(ver) + 1
+			storeCommitID = types.CommitID{}
+		}
+
+		store, err := rs.loadCommitStoreFromParams(key, storeCommitID, storeParams)
 		if err != nil {
 			return errorsmod.Wrap(err, "failed to load store")
 		}
@@ -283,6 +347,19 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			newStores[oldKey] = oldStore
 			// this will ensure it's not perpetually stored in commitInfo
 			rs.removalMap[oldKey] = true
+		} else if upgrades.IsMigrated(key.Name()) {
+			// load the IAVL store to migrate
+			oldDB := rs.storeDB(storeParams, false)
+			oldStore, err := rs.iavlCommitmentBackend().LoadStore(oldDB, key, commitID, 0)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to load IAVL store %s to migrate", key.Name())
+			}
+
+			// copy all data, committed in the next version of the new store
+			copyKVStoreData(oldStore, store.(types.KVStore))
+
+			// the IAVL store is deleted once the migration is committed
+			rs.migratedDBs = append(rs.migratedDBs, oldDB)
 		}
 	}
 
@@ -325,15 +402,57 @@ func deleteKVStore(kv types.KVStore) error {
 // we simulate move by a copy and delete
 func moveKVStoreData(oldDB, newDB types.KVStore) error {
 	// we read from one and write to another
+	copyKVStoreData(oldDB, newDB)
+
+	// then delete the old store
+	return deleteKVStore(oldDB)
+}
+
+func copyKVStoreData(oldDB, newDB types.KVStore) {
 	itr := oldDB.Iterator(nil, nil)
 	for itr.Valid() {
 		newDB.Set(itr.Key(), itr.Value())
 		itr.Next()
 	}
 	itr.Close()
+}
 
-	// then delete the old store
-	return deleteKVStore(oldDB)
+// deleteDBData deletes all the data of the database, in batches of keys as it cannot
+// be written while iterating.
+func deleteDBData(db dbm.DB) error {
+	const batchSize = 10000
+
+	for {
+		itr, err := db.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		var keys [][]byte
+		for ; itr.Valid() && len(keys) < batchSize; itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+		err = itr.Error()
+		itr.Close()
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
 }
 
 // PruneSnapshotHeight prunes the given height according to the prune strategy.
@@ -465,6 +584,8 @@ func (rs *Store) Commit() types.CommitID {
 
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
+	// deferred first to delete the migrated IAVL stores once the metadata is flushed
+	defer rs.deleteMigratedStores()
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
@@ -489,6 +610,17 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
+// deleteMigratedStores deletes the data of the IAVL stores migrated to their
+// commitment backend. It panics if the data cannot be deleted.
+func (rs *Store) deleteMigratedStores() {
+	for _, db := range rs.migratedDBs {
+		if err := deleteDBData(db); err != nil {
+			panic(fmt.Errorf("failed to delete migrated IAVL store: %w", err))
+		}
+	}
+	rs.migratedDBs = nil
+}
+
 // WorkingHash returns the current hash of the store.
 // it will be used to get the current app hash before commit.
 func (rs *Store) WorkingHash() []byte {
@@ -498,7 +630,7 @@ func (rs *Store) WorkingHash() []byte {
 	for _, key := range storeKeys {
 		store := rs.stores[key]
 
-		if store.GetStoreType() != types.StoreTypeIAVL {
+		if !isCommitmentStoreType(store.GetStoreType()) {
 			continue
 		}
 
@@ -557,15 +689,26 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
+		case types.StoreTypeIAVL, types.StoreTypeSMT:
 			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
+			// it to get the underlying commitment store.
 			store = rs.GetCommitKVStore(key)
 
-			// Attempt to lazy-load an already saved IAVL store version. If the
+			// Attempt to lazy-load an already saved store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			var err error
-			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			cacheStore, err = store.(types.CommitmentStore).GetImmutableStore(version)
+			if err != nil {
+				// the heights kept by the keep-every pruning strategy of the store
+				// are read from its archive once pruned
+				var errArchive error
+				if cacheStore, errArchive = rs.archivedStore(key.Name(), version); errArchive != nil {
+					return nil, errArchive
+				}
+				if cacheStore != nil {
+					err = nil
+				}
+			}
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -583,11 +726,19 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 					}
 				}
 
+				// If the store did not exist at this version, it is missing from the branch.
+				if !storeInfos[key.Name()] {
+					continue
+				}
+
 				// If the store existed at this version, it means there's actually an error
-				// getting the root store at this version.
-				if storeInfos[key.Name()] {
+				// getting the root store at this version, unless it is pruned by its own
+				// pruning strategy, independently of the other stores. Reading it then
+				// fails with an explicit error.
+				if _, ok := rs.pruningManager.GetStoreOptions(key.Name()); !ok {
 					return nil, err
 				}
+				cacheStore = prunedStore{name: key.Name(), version: version}
 			}
 
 		default:
@@ -645,33 +796,50 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 }
 
 func (rs *Store) handlePruning(version int64) error {
-	pruneHeight := rs.pruningManager.GetPruningHeight(version)
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
-	return rs.PruneStores(pruneHeight)
+	return rs.pruneStores(func(key types.StoreKey) int64 {
+		return rs.pruningManager.GetStorePruningHeight(key.Name(), version)
+	})
 }
 
-// PruneStores prunes all history upto the specific height of the multi store.
+// PruneStores prunes all history upto the specific height of the multi store. The stores
+// with their own pruning strategy, set by SetStorePruning, are pruned upto the height that
+// keeps their own number of recent heights instead, or not at all if they prune nothing.
+// The heights of the snapshots pending in the pruning manager are kept all the same.
 func (rs *Store) PruneStores(pruningHeight int64) (err error) {
-	if pruningHeight <= 0 {
-		rs.logger.Debug("pruning skipped, height is less than or equal to 0")
-		return nil
-	}
-
-	rs.logger.Debug("pruning store", "heights", pruningHeight)
+	latestVersion := rs.LastCommitID().Version
+	return rs.pruneStores(func(key types.StoreKey) int64 {
+		return rs.pruningManager.GetStorePruneToHeight(key.Name(), latestVersion, pruningHeight)
+	})
+}
 
+// pruneStores prunes the history of each IAVL store upto the height returned for its key.
+func (rs *Store) pruneStores(pruningHeightOf func(key types.StoreKey) int64) error {
 	for key, store := range rs.stores {
-		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
-
 		// If the store is wrapped with an inter-block cache, we must first unwrap
-		// it to get the underlying IAVL store.
-		if store.GetStoreType() != types.StoreTypeIAVL {
+		// it to get the underlying commitment store.
+		if !isCommitmentStoreType(store.GetStoreType()) {
 			continue
 		}
 
+		pruningHeight := pruningHeightOf(key)
+		if pruningHeight <= 0 {
+			rs.logger.Debug("pruning skipped, height is less than or equal to 0", "key", key)
+			continue
+		}
+
+		rs.logger.Debug("pruning store", "key", key, "heights", pruningHeight) // Also log store.name (a private variable)?
+
 		store = rs.GetCommitKVStore(key)
 
-		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
+		// the heights kept by the keep-every pruning strategy of the store are
+		// archived first, the store is not pruned if they cannot be.
+		if err := rs.archiveVersions(key.Name(), store.(types.CommitmentStore), pruningHeight); err != nil {
+			return err
+		}
+
+		err := store.(types.CommitmentStore).DeleteVersionsTo(pruningHeight)
 		if err == nil {
 			continue
 		}
@@ -701,6 +869,8 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 // Query calls substore.Query with the same `req` where `req.Path` is
 // modified to remove the substore prefix.
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
+// The heights kept by the keep-every pruning strategy of a store, once pruned, are
+// queried from its archive, and cannot be proven.
 // TODO: add proof for `multistore -> substore`.
 func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 	path := req.Path
@@ -721,6 +891,11 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 
 	// trim the path and make the query
 	req.Path = subpath
+	if cs, ok := store.(types.CommitmentStore); ok && req.Height > 0 && !cs.VersionExists(req.Height) {
+		if res, ok, err := rs.queryArchive(storeName, req); ok {
+			return res, err
+		}
+	}
 	res, err := queryable.Query(req)
 
 	if !req.Prove || !RequireProof(subpath) {
@@ -756,12 +931,12 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
+	// Loop through all the stores, if it's a commitment store, then set initial
 	// version on it.
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
+		if isCommitmentStoreType(store.GetStoreType()) {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
+			// it to get the underlying commitment store.
 			store = rs.GetCommitKVStore(key)
 			store.(types.StoreWithInitialVersion).SetInitialVersion(version)
 		}
@@ -790,23 +965,14 @@ func parsePath(path string) (storeName, subpath string, err error) {
 
 //---------------------- Snapshotting ------------------
 
-// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
-// identical across nodes such that chunks from different sources fit together. If the output for a
-// given format changes (at the byte level), the snapshot format must be bumped - see
-// TestMultistoreSnapshot_Checksum test.
-func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
-	if height == 0 {
-		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
-	}
-	if height > uint64(GetLatestVersion(rs.db)) {
-		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
-	}
+// namedStore is an IAVL store with its name, as snapshotted.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
+// snapshotStores collects the stores to snapshot sorted by name (only IAVL stores are supported).
+func (rs *Store) snapshotStores() ([]namedStore, error) {
 	stores := []namedStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
@@ -817,13 +983,32 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return errorsmod.Wrapf(types.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
+			return nil, errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T, only IAVL stores can be snapshotted", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped - see
+// TestMultistoreSnapshot_Checksum test.
+func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	if height == 0 {
+		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
@@ -888,11 +1073,81 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
+// SnapshotDifferential implements snapshottypes.DifferentialSnapshotter. Every IAVL store is
+// serialized as a SnapshotStoreItem with its name and root hash at height, followed by a
+// SnapshotIAVLChangeItem for each change made to it after baseHeight, in version order.
+// The IAVL stores must retain the versions from baseHeight on.
+func (rs *Store) SnapshotDifferential(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid base height %v for differential snapshot at height %v", baseHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if !store.VersionExists(int64(baseHeight)) {
+			return errorsmod.Wrapf(types.ErrLogic,
+				"cannot snapshot store %q differentially, base height %v has been pruned", store.name, baseHeight)
+		}
+		istore, err := store.GetImmutable(int64(height))
+		if err != nil {
+			return err
+		}
+
+		rs.logger.Debug("starting differential snapshot", "store", store.name, "base", baseHeight, "height", height)
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+					Hash: istore.LastCommitID().Hash,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = store.TraverseStateChanges(int64(baseHeight)+1, int64(height), func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVLChange{
+						IAVLChange: &snapshottypes.SnapshotIAVLChangeItem{
+							Version: version,
+							Key:     pair.Key,
+							Value:   pair.Value,
+							Delete:  pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to traverse state changes of store %q", store.name)
+		}
+	}
+
+	return nil
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	if format == snapshottypes.DifferentialFormat {
+		return rs.restoreDifferential(height, protoReader)
+	}
+
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
@@ -974,34 +1229,181 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+// restoreDifferential applies a differential snapshot on top of the restored base snapshot, by
+// replaying the changes of each IAVL store as its versions up to height, including the versions
+// without any change. The resulting root hashes are verified against the snapshot.
+func (rs *Store) restoreDifferential(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	var (
+		store     *iavl.Store
+		storeItem *snapshottypes.SnapshotStoreItem
+		version   int64
+		pairs     []*iavltree.KVPair
+	)
+	restored := make(map[string]bool)
+
+	// saveVersionsTo replays the pending changes as the next version of the current store,
+	// followed by empty versions until the store reaches the given version.
+	saveVersionsTo := func(to int64) error {
+		for version < to {
+			v, err := store.SaveChangeSet(&iavltree.ChangeSet{Pairs: pairs})
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to replay version %v of store %q", version+1, storeItem.Name)
+			}
+			version, pairs = v, nil
+		}
+		return nil
+	}
+	// finishStore brings the current store to height and verifies its root hash.
+	finishStore := func() error {
+		if store == nil {
+			return nil
+		}
+		if err := saveVersionsTo(int64(height)); err != nil {
+			return err
+		}
+		if hash := store.LastCommitID().Hash; !bytes.Equal(hash, storeItem.Hash) {
+			return errorsmod.Wrapf(types.ErrLogic, "store %q hash mismatch at height %v: expected %X, got %X",
+				storeItem.Name, height, storeItem.Hash, hash)
+		}
+		restored[storeItem.Name] = true
+		return nil
+	}
+
+	var snapshotItem snapshottypes.SnapshotItem
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := finishStore(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot apply changes to non-IAVL store %q", item.Store.Name)
+			}
+			storeItem = item.Store
+			version = store.LastCommitID().Version
+			if version >= int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"store %q is at version %v, not below snapshot height %v", item.Store.Name, version, height)
+			}
+			rs.logger.Debug("applying differential snapshot", "store", item.Store.Name, "version", version)
+
+		case *snapshottypes.SnapshotItem_IAVLChange:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL change item before store item")
+			}
+			change := item.IAVLChange
+			if change.Version <= version || change.Version > int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"IAVL change version %v out of range (%v, %v]", change.Version, version, height)
+			}
+			if err := saveVersionsTo(change.Version - 1); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			// Protobuf does not differentiate between []byte{} and nil, but IAVL does not
+			// allow nil keys nor nil values, so we can always set them to empty.
+			pair := &iavltree.KVPair{Key: change.Key, Value: change.Value, Delete: change.Delete}
+			if pair.Key == nil {
+				pair.Key = []byte{}
+			}
+			if !pair.Delete && pair.Value == nil {
+				pair.Value = []byte{}
+			}
+			pairs = append(pairs, pair)
+
+		default:
+			break loop
+		}
+	}
+
+	if err := finishStore(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	for _, store := range stores {
+		if !restored[store.name] {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "store %q missing from differential snapshot", store.name)
+		}
+	}
 
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// storeDB returns the database of the store, prefixed by its name unless it is
+// mounted with its own database. The data of the stores loaded by the commitment
+// backend set by SetCommitmentBackend is kept apart from the data of the IAVL stores.
+func (rs *Store) storeDB(params storeParams, commitmentBackend bool) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		if commitmentBackend {
+			return dbm.NewPrefixDB(params.db, []byte("s/c/"))
+		}
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefixFmt := storePrefixFmt
+	if commitmentBackend {
+		prefixFmt = commitmentStorePrefixFmt
 	}
+	return dbm.NewPrefixDB(rs.db, []byte(fmt.Sprintf(prefixFmt, params.key.Name())))
+}
+
+// iavlCommitmentBackend returns the commitment backend of the IAVL stores.
+func (rs *Store) iavlCommitmentBackend() types.CommitmentBackend {
+	return iavl.NewCommitmentBackend(rs.logger, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
+}
+
+// isCommitmentStoreType returns whether the stores of the type are loaded by a
+// commitment backend, their state being committed into the app hash.
+func isCommitmentStoreType(typ types.StoreType) bool {
+	return typ == types.StoreTypeIAVL || typ == types.StoreTypeSMT
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params, false)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
-	case types.StoreTypeIAVL:
-		var store types.CommitKVStore
-		var err error
-
-		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
-		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
+	case types.StoreTypeIAVL, types.StoreTypeSMT:
+		backend, ok := rs.commitmentBackends[key.Name()]
+		switch {
+		case ok:
+			db = rs.storeDB(params, true)
+		case params.typ == types.StoreTypeIAVL:
+			backend = rs.iavlCommitmentBackend()
+		default:
+			return nil, fmt.Errorf("no commitment backend for store %s of type %s", key.Name(), params.typ)
 		}
 
+		commitmentStore, err := backend.LoadStore(db, key, id, params.initialVersion)
 		if err != nil {
 			return nil, err
 		}
 
+		// the snapshots export and import the nodes of the IAVL trees
+		if _, isIAVL := commitmentStore.(*iavl.Store); !isIAVL && rs.snapshotsEnabled {
+			return nil, fmt.Errorf("store %s of commitment backend %T cannot be loaded with state sync snapshots enabled, "+
+				"only IAVL stores can be snapshotted", key.Name(), backend)
+		}
+
+		var store types.CommitKVStore = commitmentStore
+
 		if rs.interBlockCache != nil {
 			// Wrap and get a CommitKVStore with inter-block caching. Note, this should
 			// only wrap the primary CommitKVStore, not any store that is already
@@ -1061,17 +1463,21 @@ func (rs *Store) RollbackToVersion(target int64) error {
 	}
 
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
+		if isCommitmentStoreType(store.GetStoreType()) {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
+			// it to get the underlying commitment store.
 			store = rs.GetCommitKVStore(key)
-			err := store.(*iavl.Store).LoadVersionForOverwriting(target)
+			err := store.(types.CommitmentStore).LoadVersionForOverwriting(target)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
+	if err := rs.rollbackArchives(target); err != nil {
+		return err
+	}
+
 	rs.flushMetadata(rs.db, target, rs.buildCommitInfo(target))
 
 	return rs.LoadLatestVersion()
@@ -322,6 +322,139 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store4"})
 }
 
+// testCommitmentBackend is a CommitmentBackend of IAVL stores counting the loaded stores.
+type testCommitmentBackend struct {
+	*iavl.CommitmentBackend
+	loaded int
+}
+
+func newTestCommitmentBackend() *testCommitmentBackend {
+	return &testCommitmentBackend{
+		CommitmentBackend: iavl.NewCommitmentBackend(log.NewNopLogger(), iavl.DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics()),
+	}
+}
+
+func (b *testCommitmentBackend) LoadStore(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitmentStore, error) {
+	b.loaded++
+	return b.CommitmentBackend.LoadStore(db, key, id, initialVersion)
+}
+
+func countDBKeys(t *testing.T, db dbm.DB) int {
+	t.Helper()
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	return count
+}
+
+func TestMultistoreCommitmentBackend(t *testing.T) {
+	db := dbm.NewMemDB()
+	backend := newTestCommitmentBackend()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", backend)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, 1, backend.loaded)
+
+	k, v := []byte("key"), []byte("value")
+	store.GetStoreByName("store1").(types.KVStore).Set(k, v)
+	commitID := store.Commit()
+	require.Equal(t, getExpectedCommitID(store, 1), commitID)
+
+	// the store of the backend is kept apart from the IAVL stores
+	require.Zero(t, countDBKeys(t, dbm.NewPrefixDB(db, []byte("s/k:store1/"))))
+	require.NotZero(t, countDBKeys(t, dbm.NewPrefixDB(db, []byte("s/c:store1/"))))
+
+	cms, err := store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, v, cms.GetKVStore(testStoreKey1).Get(k))
+
+	res, err := store.Query(&types.RequestQuery{Path: "/store1/key", Data: k, Height: 1, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, v, res.Value)
+	require.NotNil(t, res.ProofOps)
+
+	// the store can't be loaded without its backend
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, store.LoadLatestVersion())
+}
+
+// nonIAVLCommitmentBackend is a CommitmentBackend of stores other than IAVL stores.
+type nonIAVLCommitmentBackend struct {
+	*testCommitmentBackend
+}
+
+func (b nonIAVLCommitmentBackend) LoadStore(db dbm.DB, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitmentStore, error) {
+	store, err := b.testCommitmentBackend.LoadStore(db, key, id, initialVersion)
+	return struct{ types.CommitmentStore }{store}, err
+}
+
+func TestMultistoreCommitmentBackendSnapshots(t *testing.T) {
+	// the stores of an IAVL backend can be snapshotted
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", newTestCommitmentBackend())
+	store.SetSnapshotInterval(10)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// the stores of other backends can't be loaded with snapshots enabled
+	store = newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", nonIAVLCommitmentBackend{newTestCommitmentBackend()})
+	store.SetSnapshotInterval(10)
+	require.ErrorContains(t, store.LoadLatestVersion(), "only IAVL stores can be snapshotted")
+
+	store = newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", nonIAVLCommitmentBackend{newTestCommitmentBackend()})
+	require.NoError(t, store.LoadLatestVersion())
+}
+
+func TestMultistoreMigrateCommitmentBackend(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	k1, v1 := []byte("first"), []byte("store")
+	store.GetStoreByName("store1").(types.KVStore).Set(k1, v1)
+	k2, v2 := []byte("second"), []byte("store")
+	store.GetStoreByName("store2").(types.KVStore).Set(k2, v2)
+	store.Commit()
+
+	upgrades := &types.StoreUpgrades{Migrated: []string{"store1"}}
+
+	// a store can't be migrated without commitment backend
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, store.LoadLatestVersionAndUpgrade(upgrades))
+
+	backend := newTestCommitmentBackend()
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", backend)
+	require.NoError(t, store.LoadLatestVersionAndUpgrade(upgrades))
+	require.Equal(t, 1, backend.loaded)
+
+	// the data of the IAVL store is copied to the store of the backend
+	require.Equal(t, v1, store.GetStoreByName("store1").(types.KVStore).Get(k1))
+	require.Equal(t, v2, store.GetStoreByName("store2").(types.KVStore).Get(k2))
+
+	// the IAVL store is deleted once the migration is committed
+	iavlDB := dbm.NewPrefixDB(db, []byte("s/k:store1/"))
+	require.NotZero(t, countDBKeys(t, iavlDB))
+	commitID := store.Commit()
+	require.Equal(t, getExpectedCommitID(store, 2), commitID)
+	require.Zero(t, countDBKeys(t, iavlDB))
+
+	// the migrated store is loaded by its backend
+	backend = newTestCommitmentBackend()
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetCommitmentBackend("store1", backend)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, 1, backend.loaded)
+	require.Equal(t, commitID, store.LastCommitID())
+	require.Equal(t, v1, store.GetStoreByName("store1").(types.KVStore).Get(k1))
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -582,6 +715,152 @@ func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	require.Equal(t, numVersions+1, lastCommitInfo.Version)
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey1.Name(), pruningtypes.NewCustomPruningOptions(2, 10))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 10), ms.GetStorePruning(testStoreKey1.Name()))
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), ms.GetStorePruning(testStoreKey2.Name()))
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// store1 is pruned upto height 7, the other stores are not pruned
+	for v := int64(1); v <= 7; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		require.PanicsWithError(t, fmt.Sprintf("version %d of store %s is pruned by its own pruning strategy: version pruned", v, testStoreKey1.Name()),
+			func() { cms.GetKVStore(testStoreKey1).Get([]byte("key")) })
+		require.NotPanics(t, func() { cms.GetKVStore(testStoreKey2).Get([]byte("key")) })
+		require.NotPanics(t, func() { cms.GetKVStore(testStoreKey3).Get([]byte("key")) })
+	}
+	for v := int64(8); v <= 10; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		require.NotPanics(t, func() { cms.GetKVStore(testStoreKey1).Get([]byte("key")) })
+	}
+
+	// store2 keeps its own number of recent heights when pruning the stores
+	ms.SetStorePruning(testStoreKey2.Name(), pruningtypes.NewCustomPruningOptions(5, 10))
+	require.NoError(t, ms.PruneStores(8))
+
+	for _, tc := range []struct {
+		key      types.StoreKey
+		versions int64
+	}{
+		{testStoreKey1, 2},
+		{testStoreKey2, 5},
+		{testStoreKey3, 2},
+	} {
+		tree := ms.GetCommitKVStore(tc.key).(*iavl.Store)
+		for v := int64(1); v <= 10; v++ {
+			require.Equal(t, v > 10-tc.versions, tree.VersionExists(v), "store %s, height %d", tc.key.Name(), v)
+		}
+	}
+}
+
+func TestMultiStore_StorePruningKeepEvery(t *testing.T) {
+	db := dbm.NewMemDB()
+	opts := pruningtypes.NewKeepEveryPruningOptions(2, 3, 10)
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey1.Name(), opts)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commit := func(to int64) {
+		for v := ms.LatestVersion() + 1; v <= to; v++ {
+			store := ms.GetKVStore(testStoreKey1)
+			store.Set([]byte("key"), []byte(fmt.Sprint(v)))
+			switch v {
+			case 4:
+				store.Set([]byte("deleted"), []byte("value"))
+			case 7:
+				store.Delete([]byte("deleted"))
+			}
+			ms.Commit()
+		}
+	}
+	requireKept := func(v int64, deleted bool) {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		store := cms.GetKVStore(testStoreKey1)
+		require.Equal(t, []byte(fmt.Sprint(v)), store.Get([]byte("key")), "height %d", v)
+		require.Equal(t, !deleted, store.Has([]byte("deleted")), "height %d", v)
+	}
+	requirePruned := func(v int64) {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+		require.PanicsWithError(t, fmt.Sprintf("version %d of store %s is pruned by its own pruning strategy: version pruned", v, testStoreKey1.Name()),
+			func() { cms.GetKVStore(testStoreKey1).Get([]byte("key")) })
+	}
+
+	// store1 is pruned upto height 7, keeping heights 3 and 6
+	commit(10)
+	for v := int64(1); v <= 7; v++ {
+		if v%3 == 0 {
+			requireKept(v, v < 4)
+		} else {
+			requirePruned(v)
+		}
+	}
+	requireKept(8, true)
+
+	// the kept heights are queried from the archive, without proofs
+	res, err := ms.Query(&types.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 6})
+	require.NoError(t, err)
+	require.Equal(t, []byte("6"), res.Value)
+	require.Equal(t, int64(6), res.Height)
+	_, err = ms.Query(&types.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 6, Prove: true})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// the archive is loaded again on restart
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey1.Name(), opts)
+	require.NoError(t, ms.LoadLatestVersion())
+	commit(20)
+	for _, v := range []int64{3, 6, 9, 12, 15, 18} {
+		requireKept(v, v < 4 || v >= 7)
+	}
+	requirePruned(17)
+
+	// the heights after the target height are rolled back from the archive, the
+	// next kept heights being archived after the remaining ones when store1 is
+	// pruned upto height 18, keeping its own number of recent heights
+	require.NoError(t, ms.rollbackArchives(10))
+	requireKept(9, true)
+	requirePruned(12)
+	requirePruned(15)
+	require.NoError(t, ms.PruneStores(10))
+	requireKept(18, true)
+}
+
+func TestMultiStore_PruneStoresSnapshotHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 100))
+	ms.SetSnapshotInterval(3)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	tree := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+
+	// the height of the pending snapshot is kept
+	require.NoError(t, ms.PruneStores(8))
+	for v := int64(1); v <= 10; v++ {
+		require.Equal(t, v > 2, tree.VersionExists(v), "height %d", v)
+	}
+
+	// the heights are pruned once the snapshot is taken
+	ms.PruneSnapshotHeight(3)
+	require.NoError(t, ms.PruneStores(8))
+	for v := int64(1); v <= 10; v++ {
+		require.Equal(t, v > 5, tree.VersionExists(v), "height %d", v)
+	}
+}
+
 func TestMultiStore_PruningRestart(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 11))
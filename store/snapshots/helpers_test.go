@@ -110,6 +110,7 @@ type mockSnapshotter struct {
 	items            [][]byte
 	prunedHeights    map[int64]struct{}
 	snapshotInterval uint64
+	restoredHeights  []uint64
 }
 
 func (m *mockSnapshotter) Restore(
@@ -118,9 +119,10 @@ func (m *mockSnapshotter) Restore(
 	if format == 0 {
 		return snapshottypes.SnapshotItem{}, snapshottypes.ErrUnknownFormat
 	}
-	if m.items != nil {
+	if m.items != nil && format != snapshottypes.DifferentialFormat {
 		return snapshottypes.SnapshotItem{}, errors.New("already has contents")
 	}
+	m.restoredHeights = append(m.restoredHeights, height)
 
 	var item snapshottypes.SnapshotItem
 	m.items = [][]byte{}
@@ -151,6 +153,10 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 	return nil
 }
 
+func (m *mockSnapshotter) SnapshotDifferential(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	return m.Snapshot(height, protoWriter)
+}
+
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
 	return snapshottypes.CurrentFormat
 }
@@ -166,6 +166,11 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	defer m.multistore.PruneSnapshotHeight(int64(height))
 
+	return m.create(height)
+}
+
+// create creates a snapshot, without handling the snapshot height for pruning.
+func (m *Manager) create(height uint64) (*types.Snapshot, error) {
 	err := m.begin(opSnapshot)
 	if err != nil {
 		return nil, err
@@ -188,9 +193,64 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateDifferential creates a differential snapshot containing the changes since the latest
+// snapshot, and returns its metadata.
+func (m *Manager) CreateDifferential(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	defer m.multistore.PruneSnapshotHeight(int64(height))
+
+	return m.createDifferential(height)
+}
+
+// createDifferential creates a differential snapshot, without handling the snapshot height for pruning.
+func (m *Manager) createDifferential(height uint64) (*types.Snapshot, error) {
+	multistore, ok := m.multistore.(types.DifferentialSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat,
+			"multistore %T does not support differential snapshots", m.multistore)
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrapf(types.ErrBaseSnapshotNotFound,
+			"no snapshot to take a differential snapshot at height %v on", height)
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshotWith(height, ch, func(streamWriter *StreamWriter) error {
+		return multistore.SnapshotDifferential(base.Height, height, streamWriter)
+	})
+
+	return m.store.SaveDifferential(height, base.Height, base.Format, ch)
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	m.createSnapshotWith(height, ch, func(streamWriter *StreamWriter) error {
+		return m.multistore.Snapshot(height, streamWriter)
+	})
+}
+
+// createSnapshotWith writes the multistore items with the given function, followed by the
+// extension items, to the channel.
+func (m *Manager) createSnapshotWith(height uint64, ch chan<- io.ReadCloser, snapshotMultistore func(*StreamWriter) error) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -201,7 +261,7 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if err := snapshotMultistore(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -460,9 +520,10 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A differential snapshot is
+// restored by restoring its chain of base snapshots first.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -471,6 +532,20 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.IsDifferential() {
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return err
+		}
+		if base == nil {
+			return errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height: %d, format: %d",
+				snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		}
+		chain = append([]*types.Snapshot{base}, chain...)
+		snapshot = base
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -480,7 +555,17 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		snapshot, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return errorsmod.Wrapf(err, "restore snapshot at height %d, format %d", snapshot.Height, snapshot.Format)
+		}
+	}
+	return nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -520,9 +605,21 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
 func (m *Manager) shouldTakeSnapshot(height int64) bool {
+	return m.shouldTakeFullSnapshot(height) || m.shouldTakeDifferentialSnapshot(height)
+}
+
+// shouldTakeFullSnapshot returns true if a full snapshot should be taken at height.
+func (m *Manager) shouldTakeFullSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDifferentialSnapshot returns true if a differential snapshot should be taken at height,
+// differential snapshots are only taken in between full snapshots.
+func (m *Manager) shouldTakeDifferentialSnapshot(height int64) bool {
+	return m.opts.Interval > 0 && m.opts.DifferentialInterval > 0 &&
+		uint64(height)%m.opts.DifferentialInterval == 0 && !m.shouldTakeFullSnapshot(height)
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -531,7 +628,22 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	defer m.multistore.PruneSnapshotHeight(height)
+
+	var (
+		snapshot *types.Snapshot
+		err      error
+	)
+	if m.shouldTakeDifferentialSnapshot(height) {
+		snapshot, err = m.createDifferential(uint64(height))
+		if err != nil {
+			// the base snapshot may be missing, or its height already pruned from the multistore
+			m.logger.Info("failed to create differential state snapshot, creating a full one", "height", height, "err", err)
+		}
+	}
+	if snapshot == nil {
+		snapshot, err = m.create(uint64(height))
+	}
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
@@ -113,6 +113,66 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_TakeDifferential(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	snapshotter := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+
+	// a differential snapshot needs a base snapshot
+	_, err = manager.CreateDifferential(3)
+	require.ErrorIs(t, err, types.ErrBaseSnapshotNotFound)
+	_, didPruneHeight := snapshotter.prunedHeights[3]
+	require.True(t, didPruneHeight)
+
+	_, err = manager.Create(5)
+	require.NoError(t, err)
+
+	// a differential snapshot must be above its base
+	_, err = manager.CreateDifferential(5)
+	require.Error(t, err)
+
+	snapshot, err := manager.CreateDifferential(7)
+	require.NoError(t, err)
+	require.True(t, snapshot.IsDifferential())
+	require.EqualValues(t, 5, snapshot.Metadata.BaseHeight)
+	require.Equal(t, types.CurrentFormat, snapshot.Metadata.BaseFormat)
+
+	// differential snapshots are chained on the latest snapshot
+	snapshot, err = manager.CreateDifferential(9)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, snapshot.Metadata.BaseHeight)
+	require.Equal(t, types.DifferentialFormat, snapshot.Metadata.BaseFormat)
+
+	storeSnapshot, err := store.Get(9, types.DifferentialFormat)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, storeSnapshot)
+
+	// restoring a differential snapshot restores its chain of bases first
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	manager = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	err = manager.RestoreLocalSnapshot(9, types.DifferentialFormat)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5, 7, 9}, target.restoredHeights)
+	assert.Equal(t, items, target.items)
+
+	// a differential snapshot can't be restored without its base
+	require.NoError(t, store.Delete(5, types.CurrentFormat))
+	err = manager.RestoreLocalSnapshot(9, types.DifferentialFormat)
+	require.ErrorIs(t, err, types.ErrBaseSnapshotNotFound)
+
+	// nor through state sync
+	err = manager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
@@ -181,7 +181,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// together with the base snapshots the retained differential snapshots are built upon.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -192,13 +193,23 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	// bases are the keys of the snapshots retained differential snapshots depend on,
+	// which always sort before them.
+	bases := make(map[string]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
+		if skip[height] || uint32(len(skip)) < retain || bases[string(iter.Key())] {
 			skip[height] = true
+			if format == types.DifferentialFormat {
+				snapshot := &types.Snapshot{}
+				if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+					return 0, errors.Wrap(err, "failed to prune snapshots")
+				}
+				bases[string(encodeKey(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat))] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -211,7 +222,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
 	// the height directory as well
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !skip[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
@@ -225,7 +236,36 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{
+		Height: height,
+		Format: format,
+	}, chunks)
+}
+
+// SaveDifferential saves a differential snapshot on top of the given base snapshot to disk,
+// returning it.
+func (s *Store) SaveDifferential(
+	height, baseHeight uint64, baseFormat uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic,
+			"invalid base height %v for differential snapshot at height %v", baseHeight, height)
+	}
+	return s.save(&types.Snapshot{
+		Height: height,
+		Format: types.DifferentialFormat,
+		Metadata: types.Metadata{
+			BaseHeight: baseHeight,
+			BaseFormat: baseFormat,
+		},
+	}, chunks)
+}
+
+// save saves the chunks of the given snapshot to disk, and its metadata to the database.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -253,11 +293,6 @@ func (s *Store) Save(
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
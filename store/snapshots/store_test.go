@@ -262,6 +262,43 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDifferential(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = store.Save(1, types.CurrentFormat, makeChunks([][]byte{{1}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, types.CurrentFormat, makeChunks([][]byte{{2}}))
+	require.NoError(t, err)
+	_, err = store.SaveDifferential(3, 2, types.CurrentFormat, makeChunks([][]byte{{3}}))
+	require.NoError(t, err)
+	_, err = store.SaveDifferential(4, 3, types.DifferentialFormat, makeChunks([][]byte{{4}}))
+	require.NoError(t, err)
+
+	// the bases of the retained differential snapshots are retained as well
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, snapshot := range snapshots {
+		heights = append(heights, snapshot.Height)
+	}
+	assert.Equal(t, []uint64{4, 3, 2}, heights)
+	assert.EqualValues(t, 3, snapshots[0].Metadata.BaseHeight)
+	assert.Equal(t, types.DifferentialFormat, snapshots[0].Metadata.BaseFormat)
+}
+
+func TestStore_SaveDifferential_InvalidBase(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDifferential(3, 3, 2, makeChunks([][]byte{{1}}))
+	require.Error(t, err)
+	_, err = store.SaveDifferential(3, 0, 2, makeChunks([][]byte{{1}}))
+	require.Error(t, err)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
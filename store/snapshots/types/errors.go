@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrBaseSnapshotNotFound is returned when the base of a differential snapshot is missing.
+	ErrBaseSnapshotNotFound = errors.New("base snapshot not found")
 )
@@ -4,3 +4,13 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DifferentialFormat is the format of differential snapshots, which only contain the changes
+// of the IAVL stores since a base snapshot. They can only be restored locally on top of their
+// base, so they use a format distinct from CurrentFormat that state sync peers reject.
+const DifferentialFormat uint32 = 1<<16 | CurrentFormat
+
+// IsDifferential returns whether the snapshot is a differential snapshot.
+func (s Snapshot) IsDifferential() bool {
+	return s.Format == DifferentialFormat
+}
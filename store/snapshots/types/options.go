@@ -8,6 +8,11 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// DifferentialInterval defines at which heights, between the full snapshots taken
+	// every Interval, a differential snapshot on top of the latest snapshot is taken.
+	// 0 disables differential snapshots.
+	DifferentialInterval uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -16,3 +21,11 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// NewDifferentialSnapshotOptions returns snapshot options that also take differential
+// snapshots every differentialInterval heights between the full snapshots.
+func NewDifferentialSnapshotOptions(interval uint64, keepRecent uint32, differentialInterval uint64) SnapshotOptions {
+	opts := NewSnapshotOptions(interval, keepRecent)
+	opts.DifferentialInterval = differentialInterval
+	return opts
+}
@@ -26,6 +26,16 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DifferentialSnapshotter is a Snapshotter that can also snapshot only the changes between two
+// heights. Restore must accept the DifferentialFormat to apply them on top of the base height.
+type DifferentialSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDifferential writes the snapshot items of the changes made after baseHeight
+	// up to and including height into the protobuf writer.
+	SnapshotDifferential(baseHeight, height uint64, protoWriter protoio.Writer) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -0,0 +1,237 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+// versionSuffixLen is the length of the version suffix of the writes.
+const versionSuffixLen = 8
+
+// kvStore is a read-only KVStore of the state of a store at a version.
+type kvStore struct {
+	db      dbm.DB
+	version uint64
+}
+
+var _ types.KVStore = (*kvStore)(nil)
+
+// Get implements KVStore.
+func (s *kvStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	value, _ := s.get(encodeField(nil, key))
+	return value
+}
+
+// Has implements KVStore.
+func (s *kvStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore.
+func (s *kvStore) Set(_, _ []byte) {
+	panic("cannot write to the state storage")
+}
+
+// Delete implements KVStore.
+func (s *kvStore) Delete(_ []byte) {
+	panic("cannot write to the state storage")
+}
+
+// Iterator implements KVStore.
+func (s *kvStore) Iterator(start, end []byte) types.Iterator {
+	return newIterator(s, start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newIterator(s, start, end, false)
+}
+
+// GetStoreType implements Store.
+func (s *kvStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// get returns the value of the encoded key at the version of the store, and whether it
+// is set at that version.
+func (s *kvStore) get(encKey []byte) ([]byte, bool) {
+	start := append(bytes.Clone(encKey), versionSuffix(s.version)...)
+	iter, err := s.db.Iterator(start, afterWrites(encKey))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, false
+	}
+	value := iter.Value()
+	if len(value) == 0 || value[0] == flagDelete {
+		return nil, false
+	}
+	return bytes.Clone(value[1:]), true
+}
+
+// afterWrites returns the first key after the writes of the encoded key.
+func afterWrites(encKey []byte) []byte {
+	return append(bytes.Clone(encKey), bytes.Repeat([]byte{0xFF}, versionSuffixLen+1)...)
+}
+
+// iterator iterates over the keys set at the version of a store, reading the latest write
+// of each key up to that version out of a single iterator over the writes within the
+// bounds, which are grouped by key.
+type iterator struct {
+	store     *kvStore
+	start     []byte
+	end       []byte
+	ascending bool
+
+	// writes iterates over the writes, positioned at the first write of the next key.
+	writes dbm.Iterator
+
+	key   []byte
+	value []byte
+	valid bool
+	err   error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func newIterator(store *kvStore, start, end []byte, ascending bool) *iterator {
+	var lo, hi []byte
+	if start != nil {
+		lo = encodeField(nil, start)
+	}
+	if end != nil {
+		hi = encodeField(nil, end)
+	}
+
+	var (
+		writes dbm.Iterator
+		err    error
+	)
+	if ascending {
+		writes, err = store.db.Iterator(lo, hi)
+	} else {
+		writes, err = store.db.ReverseIterator(lo, hi)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	iter := &iterator{
+		store:     store,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		writes:    writes,
+		valid:     true,
+	}
+	iter.Next()
+	return iter
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator. It is called by newIterator to move to the first key.
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	for it.writes.Valid() {
+		encKey, value, ok := it.nextKey()
+		if !ok {
+			continue
+		}
+		key, _, err := decodeField(encKey)
+		if err != nil {
+			it.err = err
+			break
+		}
+		it.key, it.value = key, value
+		return
+	}
+	if it.err == nil {
+		it.err = it.writes.Error()
+	}
+	it.valid = false
+	it.key, it.value = nil, nil
+}
+
+// nextKey reads the writes of the next encoded key, returning the value of its latest
+// write up to the version of the store, and whether it is set at that version. The writes
+// of a key are sorted from the latest to the earliest when ascending, and the other way
+// around otherwise.
+func (it *iterator) nextKey() (encKey, value []byte, ok bool) {
+	rawKey := it.writes.Key()
+	encKey = bytes.Clone(rawKey[:len(rawKey)-versionSuffixLen])
+	found := false
+	for ; it.writes.Valid(); it.writes.Next() {
+		rawKey := it.writes.Key()
+		if !bytes.Equal(rawKey[:len(rawKey)-versionSuffixLen], encKey) {
+			break
+		}
+		if uint64(writeVersionOf(rawKey)) > it.store.version || (found && it.ascending) {
+			continue
+		}
+		found = true
+		value = it.writes.Value()
+		ok = len(value) > 0 && value[0] != flagDelete
+		if ok {
+			value = bytes.Clone(value[1:])
+		}
+	}
+	return encKey, value, ok
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	return it.err
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	it.valid = false
+	return it.writes.Close()
+}
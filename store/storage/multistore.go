@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+)
+
+// MultiStore is a multistore serving the historical versions of its committed stores from
+// the state storage, and the latest version, or the versions missing from the state
+// storage, from the root multistore. It is meant to serve the queries of an application.
+type MultiStore struct {
+	*rootmulti.Store
+
+	storage *Store
+}
+
+var _ types.MultiStore = (*MultiStore)(nil)
+
+// NewMultiStore returns the multistore reading the historical versions of the committed
+// stores of rs from the state storage.
+func NewMultiStore(rs *rootmulti.Store, storage *Store) *MultiStore {
+	return &MultiStore{
+		Store:   rs,
+		storage: storage,
+	}
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. The non-committed stores, such as the
+// transient and memory stores, are branched from the root multistore.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if version >= ms.Store.LatestVersion() || !ms.storage.VersionExists(version) {
+		return ms.Store.CacheMultiStoreWithVersion(version)
+	}
+
+	keysByName := ms.StoreKeysByName()
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(keysByName))
+	for name, key := range keysByName {
+		store := ms.GetCommitKVStore(key)
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL, types.StoreTypeSMT:
+			stores[key] = ms.storage.KVStore(name, version)
+		default:
+			stores[key] = store
+		}
+	}
+
+	return cachemulti.NewStore(ms.storage.db, stores, keysByName, nil, nil), nil
+}
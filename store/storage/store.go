@@ -0,0 +1,455 @@
+// Package storage implements the state storage of a multistore: a versioned flat key-value
+// database recording the state of the committed stores at each version, to serve the
+// queries of historical versions without reading them from the commitment stores, so that
+// these can prune their history aggressively.
+//
+// The state storage is written with the changeset of each block, as surfaced by the
+// listeners of the committed stores (see store/listenkv). Each write of a key is stored
+// at the version it is committed, so that the state at a version is the latest write of
+// each key up to that version.
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/pruning"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	// importBatchSize is the number of writes per batch when importing a state, and of
+	// deleted writes per batch when pruning or resetting the state storage.
+	importBatchSize = 10000
+
+	flagSet    byte = 0
+	flagDelete byte = 1
+)
+
+var (
+	dataPrefix        = []byte{'d'}
+	writesPrefix      = []byte{'v'}
+	latestVersionKey  = []byte("m/latest")
+	initialVersionKey = []byte("m/initial")
+)
+
+// Store is the state storage of the committed stores of a multistore.
+//
+// The writes are stored under 'd' | enc(store name) | enc(key) | ^version, enc being an
+// order preserving and prefix-free encoding of the names and keys, and the versions being
+// inverted so that the writes of a key are sorted from the latest to the earliest. The
+// value is prefixed by a flag telling whether the key is set or deleted. The writes of
+// the changesets are also indexed under 'v' | version | enc(store name) | enc(key), so
+// that pruning the versions only visits the writes of these versions.
+type Store struct {
+	db             dbm.DB
+	pruningManager *pruning.Manager
+}
+
+// NewStore returns the state storage persisted in db. It prunes nothing until its
+// pruning strategy is set by SetPruning.
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		db:             db,
+		pruningManager: pruning.NewManager(db, log.NewNopLogger()),
+	}
+}
+
+// SetPruning sets the pruning strategy of the state storage, pruning the versions it
+// no longer serves when it is committed.
+func (s *Store) SetPruning(opts pruningtypes.PruningOptions) {
+	s.pruningManager.SetOptions(opts)
+}
+
+// GetPruning returns the pruning strategy of the state storage.
+func (s *Store) GetPruning() pruningtypes.PruningOptions {
+	return s.pruningManager.GetOptions()
+}
+
+// Close closes the database of the state storage.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LatestVersion returns the latest version written into the state storage, or 0 if it is
+// empty.
+func (s *Store) LatestVersion() int64 {
+	return s.getVersion(latestVersionKey)
+}
+
+// InitialVersion returns the first version of the state storage, the one it was imported
+// at or first written at, or 0 if it is empty.
+func (s *Store) InitialVersion() int64 {
+	return s.getVersion(initialVersionKey)
+}
+
+// VersionExists returns whether the state of the version is stored.
+func (s *Store) VersionExists(version int64) bool {
+	initialVersion := s.InitialVersion()
+	return initialVersion > 0 && initialVersion <= version && version <= s.LatestVersion()
+}
+
+// Commit writes the changeset of the version into the state storage, and prunes the
+// versions it no longer serves according to its pruning strategy. The version cannot be
+// before the latest version, but it can be the latest one, to write again the changeset
+// of a block replayed after a crash. It cannot be after the version next to the latest
+// one either, as the state of the versions in between would be missing.
+func (s *Store) Commit(version int64, changeSet []*types.StoreKVPair) error {
+	if version <= 0 {
+		return fmt.Errorf("invalid version %d", version)
+	}
+	latestVersion := s.LatestVersion()
+	if version < latestVersion {
+		return fmt.Errorf("cannot commit version %d before the latest version %d", version, latestVersion)
+	}
+	if latestVersion > 0 && version > latestVersion+1 {
+		return fmt.Errorf("cannot commit version %d after the latest version %d, the versions in between are missing", version, latestVersion)
+	}
+
+	if err := func() error {
+		batch := s.db.NewBatch()
+		defer batch.Close()
+
+		for _, pair := range changeSet {
+			if err := batch.Set(dataKey(pair.StoreKey, pair.Key, version), dataValue(pair.Delete, pair.Value)); err != nil {
+				return err
+			}
+			if err := batch.Set(writeKey(version, pair.StoreKey, pair.Key), []byte{}); err != nil {
+				return err
+			}
+		}
+		if err := s.setVersions(batch, version); err != nil {
+			return err
+		}
+
+		return batch.WriteSync()
+	}(); err != nil {
+		return err
+	}
+
+	if pruneVersion := s.pruningManager.GetPruningHeight(version); pruneVersion > 0 {
+		return s.Prune(pruneVersion)
+	}
+	return nil
+}
+
+// Prune deletes the writes only needed by the states of the versions up to the given one,
+// which are no longer served: for each key, only its latest write up to the version is
+// kept, unless it is a deletion, along with its writes after the version.
+func (s *Store) Prune(version int64) error {
+	if latestVersion := s.LatestVersion(); version >= latestVersion {
+		return fmt.Errorf("cannot prune version %d, not before the latest version %d", version, latestVersion)
+	}
+	if version < s.InitialVersion() {
+		return nil
+	}
+
+	// the versions are no longer served before any of their writes is deleted
+	if err := s.db.SetSync(initialVersionKey, binary.BigEndian.AppendUint64(nil, uint64(version+1))); err != nil {
+		return err
+	}
+
+	end := binary.BigEndian.AppendUint64(bytes.Clone(writesPrefix), uint64(version+1))
+	for {
+		// the writes are deleted once the iterators are closed, as some databases do not
+		// allow to write while iterating
+		deletes, err := s.collectPrunedWrites(end, version)
+		if err != nil {
+			return err
+		}
+		if len(deletes) == 0 {
+			return nil
+		}
+		if err := s.deleteKeys(deletes); err != nil {
+			return err
+		}
+	}
+}
+
+// collectPrunedWrites returns the keys to delete to prune the versions up to the given one,
+// for a batch of the indexed writes before end, including their index entries.
+func (s *Store) collectPrunedWrites(end []byte, version int64) ([][]byte, error) {
+	iter, err := s.db.Iterator(writesPrefix, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var deletes [][]byte
+	for visited := 0; iter.Valid() && visited < importBatchSize; iter.Next() {
+		indexKey := bytes.Clone(iter.Key())
+		writeVersion := int64(binary.BigEndian.Uint64(indexKey[len(writesPrefix):]))
+		encKey := append(bytes.Clone(dataPrefix), indexKey[len(writesPrefix)+versionSuffixLen:]...)
+
+		prunedWrites, err := s.prunedWritesOf(encKey, writeVersion, version)
+		if err != nil {
+			return nil, err
+		}
+		deletes = append(append(deletes, prunedWrites...), indexKey)
+		visited++
+	}
+
+	return deletes, iter.Error()
+}
+
+// prunedWritesOf returns the writes of the encoded key to delete to prune the versions up
+// to the given one, if its write at writeVersion is its latest write up to that version:
+// the writes before it, and the latter if it is a deletion. Otherwise, the write at
+// writeVersion is deleted along with the writes before the latest write.
+func (s *Store) prunedWritesOf(encKey []byte, writeVersion, version int64) ([][]byte, error) {
+	start := append(bytes.Clone(encKey), versionSuffix(uint64(version))...)
+	iter, err := s.db.Iterator(start, afterWrites(encKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, iter.Error()
+	}
+	if latestVersion := writeVersionOf(iter.Key()); latestVersion != writeVersion {
+		return nil, nil
+	}
+
+	var deletes [][]byte
+	if value := iter.Value(); len(value) == 0 || value[0] == flagDelete {
+		deletes = append(deletes, bytes.Clone(iter.Key()))
+	}
+	for iter.Next(); iter.Valid(); iter.Next() {
+		deletes = append(deletes, bytes.Clone(iter.Key()))
+	}
+
+	return deletes, iter.Error()
+}
+
+// deleteKeys deletes the keys from the database in a single batch.
+func (s *Store) deleteKeys(keys [][]byte) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// reset deletes the whole content of the state storage.
+func (s *Store) reset() error {
+	for {
+		keys, err := func() ([][]byte, error) {
+			iter, err := s.db.Iterator(nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer iter.Close()
+
+			var keys [][]byte
+			for ; iter.Valid() && len(keys) < importBatchSize; iter.Next() {
+				keys = append(keys, bytes.Clone(iter.Key()))
+			}
+			return keys, iter.Error()
+		}()
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := s.deleteKeys(keys); err != nil {
+			return err
+		}
+	}
+}
+
+// Import writes the state of the committed stores of the multistore at its latest version
+// into the state storage, which must be empty.
+func (s *Store) Import(rs *rootmulti.Store) error {
+	if latestVersion := s.LatestVersion(); latestVersion != 0 {
+		return fmt.Errorf("cannot import into a state storage at version %d", latestVersion)
+	}
+	version := rs.LatestVersion()
+	if version == 0 {
+		return errors.New("cannot import the state of an empty multistore")
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	writes := 0
+	for _, key := range CommittedStoreKeys(rs) {
+		if err := func() error {
+			iter := rs.GetCommitKVStore(key).Iterator(nil, nil)
+			defer iter.Close()
+
+			for ; iter.Valid(); iter.Next() {
+				if err := batch.Set(dataKey(key.Name(), iter.Key(), version), dataValue(false, iter.Value())); err != nil {
+					return err
+				}
+
+				writes++
+				if writes%importBatchSize == 0 {
+					if err := batch.Write(); err != nil {
+						return err
+					}
+					batch.Close()
+					batch = s.db.NewBatch()
+				}
+			}
+			return iter.Error()
+		}(); err != nil {
+			return err
+		}
+	}
+	if err := s.setVersions(batch, version); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Sync prepares the state storage to be written with the changesets of the multistore,
+// importing its state if the state storage is empty. If the state storage is behind the
+// multistore, e.g. once the multistore is restored from a snapshot, the changesets of the
+// missing versions are lost, so the state storage is reset and the state of the multistore
+// imported, the versions stored before being dropped. It returns an error if the state
+// storage is ahead of more than a version, the one that is written before the multistore
+// commits it.
+func (s *Store) Sync(rs *rootmulti.Store) error {
+	latestVersion, rsVersion := s.LatestVersion(), rs.LatestVersion()
+	switch {
+	case latestVersion == 0 && rsVersion > 0:
+		return s.Import(rs)
+	case latestVersion < rsVersion:
+		if err := s.reset(); err != nil {
+			return fmt.Errorf("failed to reset the state storage: %w", err)
+		}
+		return s.Import(rs)
+	case latestVersion > rsVersion+1:
+		return fmt.Errorf("state storage at version %d is ahead of the multistore at version %d; it must be rebuilt", latestVersion, rsVersion)
+	}
+	return nil
+}
+
+// KVStore returns a read-only store of the state of the store at the version.
+func (s *Store) KVStore(storeName string, version int64) types.KVStore {
+	return &kvStore{
+		db:      dbm.NewPrefixDB(s.db, storePrefix(storeName)),
+		version: uint64(version),
+	}
+}
+
+func (s *Store) getVersion(key []byte) int64 {
+	bz, err := s.db.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setVersions sets the latest version, and the initial version of an empty state storage.
+func (s *Store) setVersions(batch dbm.Batch, version int64) error {
+	bz := binary.BigEndian.AppendUint64(nil, uint64(version))
+	if s.InitialVersion() == 0 {
+		if err := batch.Set(initialVersionKey, bz); err != nil {
+			return err
+		}
+	}
+	return batch.Set(latestVersionKey, bz)
+}
+
+// CommittedStoreKeys returns the keys of the committed stores of the multistore, which
+// are the ones written into the state storage, sorted by name.
+func CommittedStoreKeys(rs *rootmulti.Store) []types.StoreKey {
+	var keys []types.StoreKey
+	for _, key := range rs.StoreKeysByName() {
+		switch rs.GetCommitKVStore(key).GetStoreType() {
+		case types.StoreTypeIAVL, types.StoreTypeSMT:
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+	return keys
+}
+
+// encodeField appends the order preserving and prefix-free encoding of the field to bz,
+// escaping its 0x00 bytes as 0x00 0xFF and terminating it with 0x00 0x00.
+func encodeField(bz, field []byte) []byte {
+	for _, b := range field {
+		bz = append(bz, b)
+		if b == 0x00 {
+			bz = append(bz, 0xFF)
+		}
+	}
+	return append(bz, 0x00, 0x00)
+}
+
+// decodeField decodes the field encoded at the start of bz, returning the rest of bz.
+func decodeField(bz []byte) (field, rest []byte, err error) {
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			field = append(field, bz[i])
+			continue
+		}
+		if i+1 == len(bz) {
+			break
+		}
+		switch bz[i+1] {
+		case 0x00:
+			return field, bz[i+2:], nil
+		case 0xFF:
+			field = append(field, 0x00)
+			i++
+		default:
+			return nil, nil, fmt.Errorf("invalid escape sequence in %X", bz)
+		}
+	}
+	return nil, nil, fmt.Errorf("unterminated field in %X", bz)
+}
+
+func storePrefix(storeName string) []byte {
+	return encodeField(bytes.Clone(dataPrefix), []byte(storeName))
+}
+
+// versionSuffix returns the suffix of the writes at the version, sorting the latest
+// versions first.
+func versionSuffix(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, ^version)
+}
+
+func dataKey(storeName string, key []byte, version int64) []byte {
+	bz := encodeField(storePrefix(storeName), key)
+	return append(bz, versionSuffix(uint64(version))...)
+}
+
+// writeKey returns the key indexing the write of the key of the store at the version.
+func writeKey(version int64, storeName string, key []byte) []byte {
+	bz := binary.BigEndian.AppendUint64(bytes.Clone(writesPrefix), uint64(version))
+	return encodeField(encodeField(bz, []byte(storeName)), key)
+}
+
+// writeVersionOf returns the version of the write stored under the data key.
+func writeVersionOf(dataKey []byte) int64 {
+	return int64(^binary.BigEndian.Uint64(dataKey[len(dataKey)-versionSuffixLen:]))
+}
+
+func dataValue(deleted bool, value []byte) []byte {
+	if deleted {
+		return []byte{flagDelete}
+	}
+	return append([]byte{flagSet}, value...)
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+)
+
+func set(storeName, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeName, Key: []byte(key), Value: []byte(value)}
+}
+
+func del(storeName, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeName, Key: []byte(key), Delete: true}
+}
+
+func iterate(t *testing.T, iter types.Iterator) []string {
+	t.Helper()
+	defer iter.Close()
+
+	var pairs []string
+	for ; iter.Valid(); iter.Next() {
+		require.NoError(t, iter.Error())
+		pairs = append(pairs, string(iter.Key())+"="+string(iter.Value()))
+	}
+	return pairs
+}
+
+func TestEncodeField(t *testing.T) {
+	fields := [][]byte{{}, {0x00}, {0x00, 0x00}, {0x00, 0xFF}, {0x01}, {0x01, 0x00}, {0xFF}}
+	for i, field := range fields {
+		bz := encodeField(nil, field)
+		decoded, rest, err := decodeField(append(bz, 0x42))
+		require.NoError(t, err)
+		require.Equal(t, field, append([]byte{}, decoded...))
+		require.Equal(t, []byte{0x42}, rest)
+
+		// the encoding preserves the order of the fields
+		for _, next := range fields[i+1:] {
+			require.Less(t, string(bz), string(encodeField(nil, next)))
+		}
+	}
+
+	_, _, err := decodeField([]byte{0x01, 0x00})
+	require.Error(t, err)
+	_, _, err = decodeField([]byte{0x01, 0x00, 0x01})
+	require.Error(t, err)
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	require.Zero(t, store.LatestVersion())
+	require.False(t, store.VersionExists(1))
+
+	require.NoError(t, store.Commit(1, []*types.StoreKVPair{set("a", "k1", "v1"), set("a", "k2", "v2"), set("b", "k1", "b1")}))
+	require.NoError(t, store.Commit(2, []*types.StoreKVPair{del("a", "k1"), set("a", "k3", "v3")}))
+	require.NoError(t, store.Commit(3, nil))
+	require.NoError(t, store.Commit(4, []*types.StoreKVPair{set("a", "k1", "v1'"), set("a", "k\x00", "v0")}))
+	require.Error(t, store.Commit(3, nil))
+	// the latest version can be written again
+	require.NoError(t, store.Commit(4, []*types.StoreKVPair{set("a", "k1", "v1''"), set("a", "k\x00", "v0")}))
+
+	require.Equal(t, int64(1), store.InitialVersion())
+	require.Equal(t, int64(4), store.LatestVersion())
+	require.False(t, store.VersionExists(0))
+	require.True(t, store.VersionExists(3))
+	require.False(t, store.VersionExists(5))
+
+	testCases := []struct {
+		version int64
+		pairs   []string
+	}{
+		{1, []string{"k1=v1", "k2=v2"}},
+		{2, []string{"k2=v2", "k3=v3"}},
+		{3, []string{"k2=v2", "k3=v3"}},
+		{4, []string{"k\x00=v0", "k1=v1''", "k2=v2", "k3=v3"}},
+	}
+	for _, tc := range testCases {
+		kvStore := store.KVStore("a", tc.version)
+		require.Equal(t, tc.pairs, iterate(t, kvStore.Iterator(nil, nil)), tc.version)
+
+		reversed := make([]string, 0, len(tc.pairs))
+		for i := len(tc.pairs) - 1; i >= 0; i-- {
+			reversed = append(reversed, tc.pairs[i])
+		}
+		require.Equal(t, reversed, iterate(t, kvStore.ReverseIterator(nil, nil)), tc.version)
+	}
+
+	kvStore := store.KVStore("a", 2)
+	require.Nil(t, kvStore.Get([]byte("k1")))
+	require.Equal(t, []byte("v2"), kvStore.Get([]byte("k2")))
+	require.True(t, kvStore.Has([]byte("k3")))
+	require.Nil(t, store.KVStore("a", 1).Get([]byte("k3")))
+	require.Equal(t, []byte("b1"), store.KVStore("b", 4).Get([]byte("k1")))
+	require.Nil(t, store.KVStore("c", 4).Get([]byte("k1")))
+	require.Panics(t, func() { kvStore.Set([]byte("k1"), []byte("v1")) })
+
+	kvStore = store.KVStore("a", 4)
+	require.Equal(t, []string{"k1=v1''", "k2=v2"}, iterate(t, kvStore.Iterator([]byte("k1"), []byte("k3"))))
+	require.Equal(t, []string{"k2=v2", "k1=v1''"}, iterate(t, kvStore.ReverseIterator([]byte("k1"), []byte("k3"))))
+	require.Equal(t, []string{"k\x00=v0"}, iterate(t, kvStore.Iterator(nil, []byte("k1"))))
+
+	// the cache wrapped store reads through the state storage
+	cache := kvStore.CacheWrap().(types.KVStore)
+	cache.Set([]byte("k4"), []byte("v4"))
+	require.Equal(t, []string{"k3=v3", "k4=v4"}, iterate(t, cache.Iterator([]byte("k3"), nil)))
+	require.Nil(t, store.KVStore("a", 4).Get([]byte("k4")))
+}
+
+func countKeys(t *testing.T, db dbm.DB, prefix []byte) int {
+	t.Helper()
+	iter, err := dbm.NewPrefixDB(db, prefix).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+func TestStorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	store.SetPruning(pruningtypes.NewCustomPruningOptions(1, 2))
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(1, 2), store.GetPruning())
+
+	require.NoError(t, store.Commit(1, []*types.StoreKVPair{set("a", "k1", "v1"), set("a", "k2", "v2")}))
+	require.NoError(t, store.Commit(2, []*types.StoreKVPair{del("a", "k1"), set("a", "k3", "v3")}))
+	require.NoError(t, store.Commit(3, []*types.StoreKVPair{set("a", "k2", "v2'")}))
+	require.Equal(t, 5, countKeys(t, db, dataPrefix))
+
+	// the versions up to 2 are pruned, the latest writes up to 2 being kept
+	require.NoError(t, store.Commit(4, []*types.StoreKVPair{set("a", "k4", "v4")}))
+	require.Equal(t, int64(3), store.InitialVersion())
+	require.False(t, store.VersionExists(2))
+	require.Equal(t, []string{"k2=v2'", "k3=v3"}, iterate(t, store.KVStore("a", 3).Iterator(nil, nil)))
+	require.Equal(t, []string{"k2=v2'", "k3=v3", "k4=v4"}, iterate(t, store.KVStore("a", 4).Iterator(nil, nil)))
+	require.Equal(t, 4, countKeys(t, db, dataPrefix))
+	require.Equal(t, 2, countKeys(t, db, writesPrefix))
+
+	// the writes kept by a previous pruning are deleted once overwritten
+	require.NoError(t, store.Commit(5, nil))
+	require.NoError(t, store.Commit(6, nil))
+	require.Equal(t, int64(5), store.InitialVersion())
+	require.Equal(t, []string{"k2=v2'", "k3=v3", "k4=v4"}, iterate(t, store.KVStore("a", 6).Iterator(nil, nil)))
+	require.Equal(t, 3, countKeys(t, db, dataPrefix))
+	require.Zero(t, countKeys(t, db, writesPrefix))
+
+	require.Error(t, store.Prune(6))
+	require.NoError(t, store.Prune(3))
+	require.Equal(t, int64(5), store.InitialVersion())
+}
+
+func newMultiStore(t *testing.T, db dbm.DB) (*rootmulti.Store, types.StoreKey, types.StoreKey) {
+	t.Helper()
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.SetPruning(pruningtypes.NewCustomPruningOptions(1, 1))
+
+	key, tkey := types.NewKVStoreKey("store"), types.NewTransientStoreKey("transient")
+	rs.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	return rs, key, tkey
+}
+
+func TestMultiStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	rs, key, tkey := newMultiStore(t, db)
+	require.Equal(t, []types.StoreKey{key}, CommittedStoreKeys(rs))
+
+	// the state of the multistore is imported by the first sync after its first commit
+	rs.GetKVStore(key).Set([]byte("k"), []byte("v1"))
+	rs.Commit()
+
+	storage := NewStore(dbm.NewMemDB())
+	require.NoError(t, storage.Sync(rs))
+	require.Equal(t, int64(1), storage.InitialVersion())
+	require.Equal(t, int64(1), storage.LatestVersion())
+
+	rs.AddListeners(CommittedStoreKeys(rs))
+	for version := int64(2); version <= 5; version++ {
+		cms := rs.CacheMultiStore()
+		cms.GetKVStore(key).Set([]byte("k"), []byte{'v', byte('0' + version)})
+		cms.GetKVStore(tkey).Set([]byte("t"), []byte("t"))
+		cms.Write()
+
+		require.NoError(t, storage.Commit(version, rs.PopStateCache()))
+		rs.Commit()
+	}
+
+	ms := NewMultiStore(rs, storage)
+	for version := int64(1); version <= 5; version++ {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, []byte{'v', byte('0' + version)}, cms.GetKVStore(key).Get([]byte("k")))
+		require.NotNil(t, cms.GetKVStore(tkey))
+	}
+
+	// the pruned versions are only served from the state storage
+	_, err := rs.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	// the state storage is rebuilt once behind the multistore, e.g. restored from a snapshot
+	rs.Commit()
+	require.NoError(t, storage.Sync(rs))
+	require.Equal(t, int64(6), storage.InitialVersion())
+	require.Equal(t, int64(6), storage.LatestVersion())
+	require.False(t, storage.VersionExists(5))
+	require.Equal(t, []byte("v5"), storage.KVStore(key.Name(), 6).Get([]byte("k")))
+	require.Nil(t, storage.KVStore(key.Name(), 5).Get([]byte("k")))
+	require.Error(t, NewStore(storage.db).Import(rs))
+
+	// the versions in between the latest one and the committed one would be missing
+	require.Error(t, storage.Commit(8, nil))
+}
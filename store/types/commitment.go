@@ -0,0 +1,38 @@
+package types
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// CommitmentBackend loads the stores committing the state of the stores mounted in a
+// CommitMultiStore, such as IAVL trees. It allows a CommitMultiStore to commit the
+// state of its stores into other commitment structures than IAVL.
+type CommitmentBackend interface {
+	// LoadStore loads the store of the given key at the version of the commit ID from
+	// db, the database dedicated to the store. If the store is empty, it commits its
+	// first version at initialVersion, unless it is 0.
+	LoadStore(db dbm.DB, key StoreKey, id CommitID, initialVersion uint64) (CommitmentStore, error)
+}
+
+// CommitmentStore is a store of a CommitmentBackend, committing its state into a root
+// hash at each version. The hash of the state of a key is proven by the CommitmentOp
+// proofs returned by Query, which can be created by ProveCommitment.
+type CommitmentStore interface {
+	CommitKVStore
+	Queryable
+	StoreWithInitialVersion
+
+	// VersionExists returns whether the state of the version is retained.
+	VersionExists(version int64) bool
+
+	// GetImmutableStore returns a read-only store of the state of the version, or an
+	// error if it is not retained.
+	GetImmutableStore(version int64) (KVStore, error)
+
+	// DeleteVersionsTo deletes the states of the versions up to the given one.
+	DeleteVersionsTo(version int64) error
+
+	// LoadVersionForOverwriting loads the state of the version, deleting the states
+	// of the versions after it.
+	LoadVersionForOverwriting(targetVersion int64) error
+}
@@ -25,4 +25,8 @@ var (
 	// ErrInvalidRequest defines an ABCI typed error where the request contains
 	// invalid data.
 	ErrInvalidRequest = errors.Register(StoreCodespace, 7, "invalid request")
+
+	// ErrVersionPruned is returned when reading a version of a store which it
+	// has pruned.
+	ErrVersionPruned = errors.Register(StoreCodespace, 8, "version pruned")
 )
@@ -64,20 +64,13 @@ func NewSmtCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
 // The proofOp.Data is just a marshaled CommitmentProof. The Key of the CommitmentOp is extracted
 // from the unmarshalled proof.
 func CommitmentOpDecoder(pop cmtprotocrypto.ProofOp) (merkle.ProofOperator, error) {
-	var spec *ics23.ProofSpec
-	switch pop.Type {
-	case ProofOpIAVLCommitment:
-		spec = ics23.IavlSpec
-	case ProofOpSimpleMerkleCommitment:
-		spec = ics23.TendermintSpec
-	case ProofOpSMTCommitment:
-		spec = ics23.SmtSpec
-	default:
-		return nil, errorsmod.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpSimpleMerkleCommitment', 'ProofOpIAVLCommitment', or 'ProofOpSMTCommitment'", pop.Type)
+	spec, err := commitmentOpSpec(pop.Type)
+	if err != nil {
+		return nil, err
 	}
 
 	proof := &ics23.CommitmentProof{}
-	err := proof.Unmarshal(pop.Data)
+	err = proof.Unmarshal(pop.Data)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +84,46 @@ func CommitmentOpDecoder(pop cmtprotocrypto.ProofOp) (merkle.ProofOperator, erro
 	return op, nil
 }
 
+// commitmentOpSpec returns the proof spec of the CommitmentOps of the given type.
+func commitmentOpSpec(opType string) (*ics23.ProofSpec, error) {
+	switch opType {
+	case ProofOpIAVLCommitment:
+		return ics23.IavlSpec, nil
+	case ProofOpSimpleMerkleCommitment:
+		return ics23.TendermintSpec, nil
+	case ProofOpSMTCommitment:
+		return ics23.SmtSpec, nil
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpSimpleMerkleCommitment', 'ProofOpIAVLCommitment', or 'ProofOpSMTCommitment'", opType)
+	}
+}
+
+// CommitmentProver creates the ICS-23 commitment proofs of the keys of the state of
+// a store, e.g. of a CommitmentStore.
+type CommitmentProver = sdkproofs.Prover
+
+// ProveCommitment creates with the prover the proof that the key exists, or doesn't
+// exist, and returns it as the ProofOps of a CommitmentOp of the given type.
+func ProveCommitment(opType string, prover CommitmentProver, key []byte, exists bool) (*cmtprotocrypto.ProofOps, error) {
+	spec, err := commitmentOpSpec(opType)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := sdkproofs.CreateCommitmentProof(prover, key, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	op := CommitmentOp{
+		Type:  opType,
+		Spec:  spec,
+		Key:   key,
+		Proof: proof,
+	}
+	return &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op.ProofOp()}}, nil
+}
+
 func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
@@ -70,6 +70,9 @@ type StoreUpgrades struct {
 	Added   []string      `json:"added"`
 	Renamed []StoreRename `json:"renamed"`
 	Deleted []string      `json:"deleted"`
+	// Migrated are the IAVL stores converted into stores of their commitment backend,
+	// set by CommitMultiStore.SetCommitmentBackend.
+	Migrated []string `json:"migrated"`
 }
 
 // StoreRename defines a name change of a sub-store.
@@ -120,6 +123,19 @@ func (s *StoreUpgrades) RenamedFrom(key string) string {
 	return ""
 }
 
+// IsMigrated returns true if the given key should be migrated to its commitment backend
+func (s *StoreUpgrades) IsMigrated(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, m := range s.Migrated {
+		if m == key {
+			return true
+		}
+	}
+	return false
+}
+
 type MultiStore interface {
 	Store
 
@@ -207,6 +223,14 @@ type CommitMultiStore interface {
 	// SetIAVLCacheSize sets the cache size of the IAVL tree.
 	SetIAVLCacheSize(size int)
 
+	// SetStorePruning sets the pruning strategy of the store with the given name,
+	// overriding the pruning strategy of the multistore.
+	SetStorePruning(storeName string, opts pruningtypes.PruningOptions)
+
+	// SetCommitmentBackend sets the commitment backend loading the store with the
+	// given name in place of an IAVL store.
+	SetCommitmentBackend(storeName string, backend CommitmentBackend)
+
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
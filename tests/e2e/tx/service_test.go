@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -17,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -198,6 +201,93 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s *E2ETestSuite) TestSimulateTx_GRPC_WithOptions() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.SimulateRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"negative height", &tx.SimulateRequest{TxBytes: txBytes, Height: -1}, true, "height cannot be negative"},
+		{"unknown override store key", &tx.SimulateRequest{TxBytes: txBytes, StateOverrides: []tx.StateOverride{{StoreKey: "unknown", Key: []byte("key")}}}, true, "unknown store key"},
+		{"request at a height preceding the account sequence", &tx.SimulateRequest{TxBytes: txBytes, Height: 1}, true, "account sequence mismatch"},
+		{"valid request at height", &tx.SimulateRequest{TxBytes: txBytes, Height: height}, false, ""},
+		{"valid request with trace", &tx.SimulateRequest{TxBytes: txBytes, Trace: true}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.Simulate(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(res.GetGasInfo().GetGasUsed() > 0)
+			if !tc.req.Trace {
+				s.Require().Nil(res.Trace)
+				return
+			}
+
+			// the ante handler deducts the fees and the msg sends the coins,
+			// both through the bank store
+			hasBankOperation := func(ops []tx.StoreOperation) bool {
+				for _, op := range ops {
+					if op.StoreKey == banktypes.StoreKey && op.Operation == "write" {
+						return true
+					}
+				}
+				return false
+			}
+			s.Require().True(hasBankOperation(res.Trace.Ante.Operations))
+			s.Require().Len(res.Trace.Msgs, 1)
+			s.Require().True(hasBankOperation(res.Trace.Msgs[0].Operations))
+			s.Require().Equal(len(res.GetResult().GetEvents()), len(res.Trace.Ante.Events)+len(res.Trace.Msgs[0].Events)+len(res.Trace.Post.Events))
+		})
+	}
+}
+
+func (s *E2ETestSuite) TestSimulateTx_GRPC_TraceOnFailure() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// the error details are only carried by gRPC, not by ABCI queries
+	conn, err := grpc.Dial(
+		val.AppConfig.GRPC.Address,
+		grpc.WithInsecure(), //nolint:staticcheck // ignore SA1019, we don't need to use a secure connection for tests
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(s.cfg.InterfaceRegistry).GRPCCodec())),
+	)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	// the account sequence does not match at height 1, the ante handler fails
+	_, err = tx.NewServiceClient(conn).Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes, Height: 1, Trace: true})
+	s.Require().ErrorContains(err, "account sequence mismatch")
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	details := st.Proto().GetDetails()
+	s.Require().Len(details, 1)
+
+	var res tx.SimulateResponse
+	s.Require().NoError(res.Unmarshal(details[0].GetValue()))
+	s.Require().NotNil(res.Trace)
+	s.Require().NotEmpty(res.Trace.Ante.Operations)
+	s.Require().Empty(res.Trace.Msgs)
+}
+
 func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -0,0 +1,202 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var PKS = simtestutil.CreateTestPubKeys(3)
+
+type fixture struct {
+	ctx sdk.Context
+
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
+	msgServer     vestingtypes.MsgServer
+
+	funder    sdk.AccAddress
+	validator stakingtypes.Validator
+	bondDenom string
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	keys := storetypes.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
+	)
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{}).Codec
+
+	logger := log.NewTestLogger(t)
+	cms := integration.CreateMultiStore(keys, logger)
+
+	newCtx := sdk.NewContext(cms, types.Header{}, true, logger)
+
+	authority := authtypes.NewModuleAddress("gov")
+
+	maccPerms := map[string][]string{
+		minttypes.ModuleName:           {authtypes.Minter},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		addresscodec.NewBech32Codec(sdk.Bech32MainPrefix),
+		sdk.Bech32MainPrefix,
+		authority.String(),
+	)
+
+	blockedAddresses := map[string]bool{
+		accountKeeper.GetAuthority(): false,
+	}
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		blockedAddresses,
+		authority.String(),
+		log.NewNopLogger(),
+	)
+
+	stakingKeeper := stakingkeeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]), accountKeeper, bankKeeper, authority.String(), addresscodec.NewBech32Codec(sdk.Bech32PrefixValAddr), addresscodec.NewBech32Codec(sdk.Bech32PrefixConsAddr))
+
+	authModule := auth.NewAppModule(cdc, accountKeeper, authsims.RandomGenesisAccounts, nil)
+	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper, nil)
+	stakingModule := staking.NewAppModule(cdc, stakingKeeper, accountKeeper, bankKeeper, nil)
+
+	integrationApp := integration.NewIntegrationApp(newCtx, logger, keys, cdc, map[string]appmodule.AppModule{
+		authtypes.ModuleName:    authModule,
+		banktypes.ModuleName:    bankModule,
+		stakingtypes.ModuleName: stakingModule,
+	})
+
+	ctx := sdk.UnwrapSDKContext(integrationApp.Context()).WithBlockTime(time.Unix(1_000_000, 0).UTC())
+	assert.NilError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	assert.NilError(t, stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams()))
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	// the funder is funded and delegates to an unbonded validator
+	funder := sdk.AccAddress(PKS[0].Address())
+	assert.NilError(t, banktestutil.FundAccount(ctx, bankKeeper, funder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+
+	validator := stakingtestutil.NewValidator(t, sdk.ValAddress(PKS[2].Address()), PKS[2])
+	assert.NilError(t, stakingKeeper.SetValidator(ctx, validator))
+	_, err = stakingKeeper.Delegate(ctx, funder, math.NewInt(100), stakingtypes.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	return &fixture{
+		ctx:           ctx,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		msgServer:     vesting.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper),
+		funder:        funder,
+		validator:     validator,
+		bondDenom:     bondDenom,
+	}
+}
+
+// createAccount creates a clawback vesting account with 20 vested and 100
+// unvested tokens, and delegates delegated of its tokens.
+func (f *fixture) createAccount(t *testing.T, addr sdk.AccAddress, delegated int64) {
+	t.Helper()
+
+	_, err := f.msgServer.CreateClawbackVestingAccount(f.ctx, vestingtypes.NewMsgCreateClawbackVestingAccount(
+		f.funder, addr, f.ctx.BlockTime().Unix()-15, nil, []vestingtypes.Period{
+			{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(f.bondDenom, 20))},
+			{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(f.bondDenom, 100))},
+		},
+	))
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(f.ctx, f.validator.GetOperator())
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.Delegate(f.ctx, addr, math.NewInt(delegated), stakingtypes.Unbonded, validator, true)
+	assert.NilError(t, err)
+}
+
+func TestClawbackDelegatedCoins(t *testing.T) {
+	f := initFixture(t)
+	addr := sdk.AccAddress(PKS[1].Address())
+	f.createAccount(t, addr, 60)
+
+	res, err := f.msgServer.Clawback(f.ctx, vestingtypes.NewMsgClawback(f.funder, addr, nil))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin(f.bondDenom, 100)), res.Amount)
+
+	// the vested tokens are left to the account and are spendable
+	assert.DeepEqual(t, sdk.NewInt64Coin(f.bondDenom, 20), f.bankKeeper.GetBalance(f.ctx, addr, f.bondDenom))
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin(f.bondDenom, 20)), f.bankKeeper.SpendableCoins(f.ctx, addr))
+	delegations, err := f.stakingKeeper.GetDelegatorDelegations(f.ctx, addr, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(delegations))
+
+	acc, ok := f.accountKeeper.GetAccount(f.ctx, addr).(*vestingtypes.ClawbackVestingAccount)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin(f.bondDenom, 20)), acc.OriginalVesting)
+	assert.Assert(t, acc.DelegatedVesting.IsZero())
+
+	// the unvested tokens are sent to the funder, partly as a delegation
+	assert.DeepEqual(t, sdk.NewInt64Coin(f.bondDenom, 820), f.bankKeeper.GetBalance(f.ctx, f.funder, f.bondDenom))
+	delegation, err := f.stakingKeeper.GetDelegation(f.ctx, f.funder, f.validator.GetOperator())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDec(160), delegation.Shares)
+
+	// the transferred tokens stay in the pool of the validator
+	validator, err := f.stakingKeeper.GetValidator(f.ctx, f.validator.GetOperator())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.NewInt(160), validator.Tokens)
+	notBondedPool := f.stakingKeeper.GetNotBondedPool(f.ctx)
+	assert.DeepEqual(t, sdk.NewInt64Coin(f.bondDenom, 160), f.bankKeeper.GetBalance(f.ctx, notBondedPool.GetAddress(), f.bondDenom))
+
+	// nothing is left to claw back
+	_, err = f.msgServer.Clawback(f.ctx, vestingtypes.NewMsgClawback(f.funder, addr, nil))
+	assert.ErrorContains(t, err, "has no unvested coins")
+}
+
+func TestClawbackUnbondingCoins(t *testing.T) {
+	f := initFixture(t)
+	addr := sdk.AccAddress(PKS[1].Address())
+	f.createAccount(t, addr, 60)
+
+	_, _, err := f.stakingKeeper.Undelegate(f.ctx, addr, f.validator.GetOperator(), math.LegacyNewDec(60))
+	assert.NilError(t, err)
+
+	_, err = f.msgServer.Clawback(f.ctx, vestingtypes.NewMsgClawback(f.funder, addr, nil))
+	assert.ErrorContains(t, err, "must wait for the unbonding to complete")
+}
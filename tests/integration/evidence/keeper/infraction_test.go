@@ -14,10 +14,12 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
+	evidencetestutil "cosmossdk.io/x/evidence/testutil"
 	evidencetypes "cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -85,6 +87,7 @@ func initFixture(tb testing.TB) *fixture {
 		authtypes.StoreKey, banktypes.StoreKey, paramtypes.StoreKey, consensusparamtypes.StoreKey, evidencetypes.StoreKey, stakingtypes.StoreKey, slashingtypes.StoreKey,
 	)
 	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, evidence.AppModuleBasic{}).Codec
+	evidencetestutil.RegisterInterfaces(cdc.InterfaceRegistry())
 
 	logger := log.NewTestLogger(tb)
 	cms := integration.CreateMultiStore(keys, logger)
@@ -128,6 +131,7 @@ func initFixture(tb testing.TB) *fixture {
 	evidenceKeeper := keeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), stakingKeeper, slashingKeeper, addresscodec.NewBech32Codec("cosmos"), runtime.ProvideCometInfoService())
 	router := evidencetypes.NewRouter()
 	router = router.AddRoute(evidencetypes.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
+	router = router.AddRoute(evidencetestutil.RouteInvalidVoteExtension, evidencetestutil.NewInvalidVoteExtensionHandler(stakingKeeper, slashingKeeper))
 	evidenceKeeper.SetRouter(router)
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, authsims.RandomGenesisAccounts, nil)
@@ -310,6 +314,118 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())) == false)
 }
 
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	stakingParams, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	// execute end-blocker and verify validator attributes
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t,
+		f.bankKeeper.GetAllBalances(ctx, sdk.AccAddress(operatorAddr)).String(),
+		sdk.NewCoins(sdk.NewCoin(stakingParams.BondDenom, initAmt.Sub(selfDelegation))).String(),
+	)
+
+	assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, valpubkey))
+	info := slashingtypes.NewValidatorSigningInfo(sdk.ConsAddress(valpubkey.Address()), ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(valpubkey.Address()), info))
+
+	val, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	nci := NewCometInfo(abci.RequestFinalizeBlock{
+		Misbehavior: []abci.Misbehavior{{
+			Validator:        abci.Validator{Address: valpubkey.Address(), Power: power},
+			Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+			Time:             time.Now().UTC(),
+			Height:           1,
+			TotalVotingPower: 3 * power,
+		}},
+	})
+	ctx = ctx.WithCometInfo(nci)
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx))
+
+	// should be slashed, jailed and tombstoned
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.IsJailed())
+	assert.Assert(t, val.GetTokens().LT(oldTokens))
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())))
+
+	// the evidence is persisted as a light client attack
+	iter, err := f.evidenceKeeper.Evidences.Iterate(ctx, nil)
+	assert.NilError(t, err)
+	values, err := iter.Values()
+	assert.NilError(t, err)
+	assert.Equal(t, len(values), 1)
+	attack, ok := values[0].(*evidencetypes.LightClientAttack)
+	assert.Assert(t, ok)
+	assert.Equal(t, attack.GetConsensusAddress().String(), sdk.ConsAddress(valpubkey.Address()).String())
+	assert.Equal(t, attack.GetTotalPower(), 3*power)
+}
+
+func TestSubmitCustomEvidence(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, valpubkey := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, valpubkey))
+
+	val, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	e := &evidencetestutil.InvalidVoteExtension{
+		Height:           1,
+		Time:             time.Now().UTC(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(valpubkey.Address()).String(),
+	}
+	msg, err := evidencetypes.NewMsgSubmitEvidence(sdk.AccAddress(valAddresses[1]), e)
+	assert.NilError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(*f.evidenceKeeper)
+	res, err := msgServer.SubmitEvidence(ctx, msg)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.Hash, e.Hash())
+
+	// should be slashed by the custom fraction and jailed, but not tombstoned
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.IsJailed())
+	slashed := math.LegacyNewDecFromInt(oldTokens).Mul(evidencetestutil.SlashFractionInvalidVoteExtension).TruncateInt()
+	assert.Assert(t, val.GetTokens().Equal(oldTokens.Sub(slashed)))
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())))
+
+	stored, err := f.evidenceKeeper.Evidences.Get(ctx, e.Hash())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, stored, exported.Evidence(e))
+
+	// the same evidence cannot be submitted twice
+	_, err = msgServer.SubmitEvidence(ctx, msg)
+	assert.ErrorIs(t, err, evidencetypes.ErrEvidenceExists)
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
@@ -102,6 +102,11 @@ var (
 	SignableTypes = []GeneratedType{
 		// auth
 		GenType(&authtypes.MsgUpdateParams{}, &authapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&authtypes.MsgRotatePubKey{}, &authapi.MsgRotatePubKey{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(&ed25519.PubKey{}).
+				WithInterfaceHint("cosmos.crypto.PubKey", &ed25519.PubKey{}),
+		),
 
 		// authz
 		GenType(&authztypes.MsgGrant{}, &authzapi.MsgGrant{},
@@ -227,11 +232,14 @@ var (
 		GenType(&vestingtypes.MsgCreateVestingAccount{}, &vestingapi.MsgCreateVestingAccount{}, GenOpts),
 		GenType(&vestingtypes.MsgCreatePermanentLockedAccount{}, &vestingapi.MsgCreatePermanentLockedAccount{}, GenOpts),
 		GenType(&vestingtypes.MsgCreatePeriodicVestingAccount{}, &vestingapi.MsgCreatePeriodicVestingAccount{}, GenOpts),
+		GenType(&vestingtypes.MsgCreateClawbackVestingAccount{}, &vestingapi.MsgCreateClawbackVestingAccount{}, GenOpts),
+		GenType(&vestingtypes.MsgClawback{}, &vestingapi.MsgClawback{}, GenOpts),
 	}
 	NonsignableTypes = []GeneratedType{
-		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts),
+		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts.WithDisallowNil()),
 		GenType(&authtypes.BaseAccount{}, &authapi.BaseAccount{}, GenOpts.WithAnyTypes(&ed25519.PubKey{})),
 		GenType(&authtypes.ModuleAccount{}, &authapi.ModuleAccount{}, GenOpts.WithAnyTypes(&ed25519.PubKey{})),
+		GenType(&authtypes.AbstractAccount{}, &authapi.AbstractAccount{}, GenOpts.WithDisallowNil()),
 		GenType(&authtypes.ModuleCredential{}, &authapi.ModuleCredential{}, GenOpts),
 
 		GenType(&authztypes.GenericAuthorization{}, &authzapi.GenericAuthorization{}, GenOpts),
@@ -283,6 +291,7 @@ var (
 		GenType(&vestingtypes.DelayedVestingAccount{}, &vestingapi.DelayedVestingAccount{}, GenOpts.WithDisallowNil()),
 		GenType(&vestingtypes.PermanentLockedAccount{}, &vestingapi.PermanentLockedAccount{}, GenOpts.WithDisallowNil()),
 		GenType(&vestingtypes.PeriodicVestingAccount{}, &vestingapi.PeriodicVestingAccount{}, GenOpts.WithDisallowNil()),
+		GenType(&vestingtypes.ClawbackVestingAccount{}, &vestingapi.ClawbackVestingAccount{}, GenOpts.WithDisallowNil()),
 	}
 	DefaultGeneratedTypes = append(SignableTypes, NonsignableTypes...)
 )
@@ -233,7 +233,7 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		// a pulsar encoded Math.dec (the string representation of a Decimal) into a gogo Math.dec (expecting an int64).
 		protoUnmarshalFails bool
 	}{
-		"auth/params": {gogo: &authtypes.Params{TxSigLimit: 10}, pulsar: &authapi.Params{TxSigLimit: 10}},
+		"auth/params": {gogo: &authtypes.Params{TxSigLimit: 10}, pulsar: &authapi.Params{TxSigLimit: 10, PubKeyRotationCooldown: &durationpb.Duration{}}},
 		"auth/module_account": {
 			gogo: &authtypes.ModuleAccount{
 				BaseAccount: authtypes.NewBaseAccountWithAddress(addr1), Permissions: []string{},
@@ -257,7 +257,7 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		},
 		"authz/msg_update_params": {
 			gogo:   &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10}},
-			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{TxSigLimit: 10}},
+			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{TxSigLimit: 10, PubKeyRotationCooldown: &durationpb.Duration{}}},
 		},
 		"authz/msg_exec/empty_msgs": {
 			gogo:   &authztypes.MsgExec{Msgs: []*codectypes.Any{}},
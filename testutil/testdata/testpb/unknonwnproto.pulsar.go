@@ -23036,7 +23036,7 @@ func (x *fastReflection_TestUpdatedTxBody) ProtoMethods() *protoiface.Methods {
 		if x.SomeNewField != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SomeNewField))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x30
 		}
 		if x.TimeoutHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutHeight))
@@ -23200,7 +23200,7 @@ func (x *fastReflection_TestUpdatedTxBody) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 4:
+			case 6:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 				}
@@ -25234,7 +25234,6 @@ type Customer3 struct {
 	Surcharge   float32 `protobuf:"fixed32,4,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
 	Destination string  `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
 	// Types that are assignable to Payment:
-	//
 	//	*Customer3_CreditCardNo
 	//	*Customer3_ChequeNo
 	Payment  isCustomer3_Payment `protobuf_oneof:"payment"`
@@ -25351,7 +25350,6 @@ type TestVersion1 struct {
 	C []*TestVersion1 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion1 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion1_E
 	//	*TestVersion1_F
 	Sum isTestVersion1_Sum `protobuf_oneof:"sum"`
@@ -25486,7 +25484,6 @@ type TestVersion2 struct {
 	C []*TestVersion2 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion2 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"` // [(gogoproto.nullable) = false];
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion2_E
 	//	*TestVersion2_F
 	Sum isTestVersion2_Sum `protobuf_oneof:"sum"`
@@ -25629,7 +25626,6 @@ type TestVersion3 struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"` // [(gogoproto.nullable) = false];
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion3_E
 	//	*TestVersion3_F
 	Sum isTestVersion3_Sum `protobuf_oneof:"sum"`
@@ -25772,7 +25768,6 @@ type TestVersion3LoneOneOfValue struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"` // [(gogoproto.nullable) = false];
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion3LoneOneOfValue_E
 	Sum isTestVersion3LoneOneOfValue_Sum `protobuf_oneof:"sum"`
 	G   *anypb.Any                       `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -25901,7 +25896,6 @@ type TestVersion3LoneNesting struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"` // [(gogoproto.nullable) = false];
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion3LoneNesting_F
 	Sum isTestVersion3LoneNesting_Sum `protobuf_oneof:"sum"`
 	G   *anypb.Any                    `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -26046,7 +26040,6 @@ type TestVersion4LoneNesting struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"` // [(gogoproto.nullable) = false];
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersion4LoneNesting_F
 	Sum isTestVersion4LoneNesting_Sum `protobuf_oneof:"sum"`
 	G   *anypb.Any                    `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -26188,7 +26181,6 @@ type TestVersionFD1 struct {
 	X int64         `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	A *TestVersion1 `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersionFD1_E
 	//	*TestVersionFD1_F
 	Sum isTestVersionFD1_Sum `protobuf_oneof:"sum"`
@@ -26289,7 +26281,6 @@ type TestVersionFD1WithExtraAny struct {
 	X int64         `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	A *TestVersion1 `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
 	// Types that are assignable to Sum:
-	//
 	//	*TestVersionFD1WithExtraAny_E
 	//	*TestVersionFD1WithExtraAny_F
 	Sum isTestVersionFD1WithExtraAny_Sum `protobuf_oneof:"sum"`
@@ -26508,7 +26499,7 @@ type TestUpdatedTxBody struct {
 	Messages                     []*anypb.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,6,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*anypb.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*anypb.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -27410,7 +27401,7 @@ var file_testpb_unknonwnproto_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
 	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6f, 0x6d, 0x65, 0x4e,
+	0x65, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6f, 0x6d, 0x65, 0x4e,
 	0x65, 0x77, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x48, 0x0a, 0x21, 0x73, 0x6f, 0x6d, 0x65, 0x5f,
 	0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x72,
 	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x9a, 0x08, 0x20,
@@ -715,7 +715,6 @@ type Customer3 struct {
 	Surcharge   float32 `protobuf:"fixed32,4,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
 	Destination string  `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
 	// Types that are valid to be assigned to Payment:
-	//
 	//	*Customer3_CreditCardNo
 	//	*Customer3_ChequeNo
 	Payment  isCustomer3_Payment `protobuf_oneof:"payment"`
@@ -849,7 +848,6 @@ type TestVersion1 struct {
 	C []*TestVersion1 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []TestVersion1  `protobuf:"bytes,5,rep,name=d,proto3" json:"d"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion1_E
 	//	*TestVersion1_F
 	Sum isTestVersion1_Sum `protobuf_oneof:"sum"`
@@ -994,7 +992,6 @@ type TestVersion2 struct {
 	C []*TestVersion2 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion2 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion2_E
 	//	*TestVersion2_F
 	Sum isTestVersion2_Sum `protobuf_oneof:"sum"`
@@ -1147,7 +1144,6 @@ type TestVersion3 struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion3_E
 	//	*TestVersion3_F
 	Sum isTestVersion3_Sum `protobuf_oneof:"sum"`
@@ -1300,7 +1296,6 @@ type TestVersion3LoneOneOfValue struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion3LoneOneOfValue_E
 	Sum isTestVersion3LoneOneOfValue_Sum `protobuf_oneof:"sum"`
 	G   *types.Any                       `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -1440,7 +1435,6 @@ type TestVersion3LoneNesting struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion3LoneNesting_F
 	Sum isTestVersion3LoneNesting_Sum `protobuf_oneof:"sum"`
 	G   *types.Any                    `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -1828,7 +1822,6 @@ type TestVersion4LoneNesting struct {
 	C []*TestVersion3 `protobuf:"bytes,4,rep,name=c,proto3" json:"c,omitempty"`
 	D []*TestVersion3 `protobuf:"bytes,5,rep,name=d,proto3" json:"d,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersion4LoneNesting_F
 	Sum isTestVersion4LoneNesting_Sum `protobuf_oneof:"sum"`
 	G   *types.Any                    `protobuf:"bytes,8,opt,name=g,proto3" json:"g,omitempty"`
@@ -2213,7 +2206,6 @@ type TestVersionFD1 struct {
 	X int64         `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	A *TestVersion1 `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersionFD1_E
 	//	*TestVersionFD1_F
 	Sum isTestVersionFD1_Sum `protobuf_oneof:"sum"`
@@ -2331,7 +2323,6 @@ type TestVersionFD1WithExtraAny struct {
 	X int64         `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	A *TestVersion1 `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*TestVersionFD1WithExtraAny_E
 	//	*TestVersionFD1WithExtraAny_F
 	Sum isTestVersionFD1WithExtraAny_Sum `protobuf_oneof:"sum"`
@@ -2578,7 +2569,7 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,6,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2818,110 +2809,110 @@ func init() {
 func init() { proto.RegisterFile("testpb/unknonwnproto.proto", fileDescriptor_fe4560133be9209a) }
 
 var fileDescriptor_fe4560133be9209a = []byte{
-	// 1641 bytes of a gzipped FileDescriptorProto
+	// 1634 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8f, 0x1a, 0xc9,
 	0x15, 0x9f, 0xa2, 0x81, 0x81, 0x37, 0x18, 0xe3, 0xca, 0x68, 0xd3, 0x8b, 0xd7, 0x98, 0xb4, 0x76,
 	0x1d, 0x12, 0xc9, 0x60, 0x1a, 0x56, 0x8a, 0xf6, 0x10, 0x2d, 0xd8, 0x9e, 0x1d, 0x47, 0xce, 0x38,
-	0xea, 0x78, 0x9d, 0x68, 0x2f, 0xa8, 0xe9, 0x2e, 0xa0, 0x35, 0x50, 0x35, 0xe9, 0xaa, 0xf6, 0xc0,
-	0x6d, 0x6f, 0x7b, 0xdd, 0x5b, 0xa4, 0x7c, 0x81, 0x9c, 0xa2, 0xfd, 0x0a, 0xb9, 0xc5, 0xb7, 0x58,
-	0xca, 0x25, 0x27, 0x2b, 0xb2, 0x0f, 0x51, 0x4e, 0x39, 0xe5, 0x9c, 0xa8, 0xaa, 0xff, 0xd0, 0x78,
-	0x60, 0x96, 0x99, 0x4d, 0xe2, 0xb5, 0xb4, 0x17, 0xa8, 0x7a, 0xf5, 0xab, 0x57, 0xef, 0xfd, 0xde,
-	0x9f, 0xee, 0x6a, 0xa8, 0x0a, 0xc2, 0xc5, 0xc9, 0xb0, 0x15, 0xd0, 0x63, 0xca, 0xe8, 0x29, 0x3d,
-	0xf1, 0x99, 0x60, 0x4d, 0xf5, 0x8b, 0xf3, 0xe1, 0x5a, 0x75, 0x7f, 0xcc, 0xc6, 0x4c, 0x89, 0x5a,
-	0x72, 0x14, 0xae, 0x56, 0xdf, 0x1d, 0x33, 0x36, 0x9e, 0x92, 0x96, 0x9a, 0x0d, 0x83, 0x51, 0xcb,
-	0xa6, 0x8b, 0x68, 0xa9, 0xea, 0x30, 0x3e, 0x63, 0xbc, 0x25, 0xe6, 0xad, 0xa7, 0xed, 0x21, 0x11,
-	0x76, 0xbb, 0x25, 0xe6, 0xe1, 0x9a, 0x21, 0xa0, 0x78, 0x37, 0xe0, 0x82, 0xcd, 0x88, 0xdf, 0xc6,
-	0x65, 0xc8, 0x78, 0xae, 0x8e, 0xea, 0xa8, 0x91, 0xb3, 0x32, 0x9e, 0x8b, 0x31, 0x64, 0xa9, 0x3d,
-	0x23, 0x7a, 0xa6, 0x8e, 0x1a, 0x45, 0x4b, 0x8d, 0xf1, 0x8f, 0xa0, 0xc2, 0x83, 0x21, 0x77, 0x7c,
-	0xef, 0x44, 0x78, 0x8c, 0x0e, 0x46, 0x84, 0xe8, 0x5a, 0x1d, 0x35, 0x32, 0xd6, 0xd5, 0xb4, 0xfc,
-	0x80, 0x10, 0xac, 0xc3, 0xee, 0x89, 0xbd, 0x98, 0x11, 0x2a, 0xf4, 0x5d, 0xa5, 0x21, 0x9e, 0x1a,
-	0x5f, 0x65, 0x96, 0xc7, 0x9a, 0x67, 0x8e, 0xad, 0x42, 0xc1, 0xa3, 0x6e, 0xc0, 0x85, 0xbf, 0x50,
-	0x47, 0xe7, 0xac, 0x64, 0x9e, 0x98, 0xa4, 0xa5, 0x4c, 0xda, 0x87, 0xdc, 0x88, 0x9c, 0x12, 0x5f,
-	0xcf, 0x2a, 0x3b, 0xc2, 0x09, 0xbe, 0x0e, 0x05, 0x9f, 0x70, 0xe2, 0x3f, 0x25, 0xae, 0xfe, 0xdb,
-	0x42, 0x1d, 0x35, 0x34, 0x2b, 0x11, 0xe0, 0x1f, 0x43, 0xd6, 0xf1, 0xc4, 0x42, 0xcf, 0xd7, 0x51,
-	0xa3, 0x6c, 0xbe, 0xd3, 0x0c, 0xa9, 0x6d, 0x26, 0x36, 0x35, 0xef, 0x7a, 0x62, 0x61, 0x29, 0x0c,
-	0xfe, 0x08, 0xae, 0xcc, 0x3c, 0xee, 0x90, 0xe9, 0xd4, 0xa6, 0x84, 0x05, 0x5c, 0x87, 0x3a, 0x6a,
-	0xec, 0x99, 0xfb, 0xcd, 0x90, 0xf1, 0x66, 0xcc, 0x78, 0xb3, 0x47, 0x17, 0xd6, 0x2a, 0xd4, 0xf8,
-	0x04, 0xb2, 0x52, 0x13, 0x2e, 0x40, 0xf6, 0xa1, 0xcd, 0x78, 0x65, 0x07, 0x97, 0x01, 0x1e, 0x32,
-	0xde, 0xa3, 0x63, 0x32, 0x25, 0xbc, 0x82, 0x70, 0x09, 0x0a, 0xbf, 0xb0, 0xa7, 0xac, 0x37, 0x15,
-	0xac, 0x92, 0xc1, 0x00, 0xf9, 0x9f, 0x33, 0xee, 0xb0, 0xd3, 0x8a, 0x86, 0xf7, 0x60, 0xf7, 0xc8,
-	0xf6, 0x7c, 0x36, 0xf4, 0x2a, 0x59, 0xa3, 0x09, 0x85, 0x23, 0xc2, 0x05, 0x71, 0xbb, 0xbd, 0x6d,
-	0xc2, 0x64, 0xfc, 0x19, 0xc5, 0x1b, 0x3a, 0x5b, 0x6d, 0xc0, 0x75, 0xc8, 0xd8, 0x5d, 0x3d, 0x5b,
-	0xd7, 0x1a, 0x7b, 0x66, 0x25, 0xe6, 0x23, 0x3e, 0xd2, 0xca, 0xd8, 0x5d, 0xdc, 0x86, 0x9c, 0x47,
-	0x5d, 0x32, 0xd7, 0x73, 0x0a, 0x74, 0x7d, 0x15, 0xd4, 0xe9, 0x35, 0x1f, 0xc8, 0xd5, 0xfb, 0x54,
-	0xf8, 0x0b, 0x2b, 0x44, 0x56, 0x7f, 0x06, 0xb0, 0x14, 0xe2, 0x0a, 0x68, 0xc7, 0x64, 0xa1, 0xec,
-	0xd0, 0x2c, 0x39, 0xc4, 0xb7, 0x20, 0xf7, 0xd4, 0x9e, 0x06, 0xa1, 0x25, 0xeb, 0xce, 0x0d, 0x97,
-	0x3f, 0xca, 0xfc, 0x04, 0x19, 0xbf, 0x8e, 0x1d, 0x32, 0xb7, 0x73, 0xa8, 0x01, 0x79, 0xaa, 0xf0,
-	0x2a, 0x57, 0xce, 0x28, 0xef, 0xf4, 0xac, 0x68, 0xdd, 0xb8, 0x17, 0x6b, 0x6e, 0x9f, 0xd5, 0xbc,
-	0xd4, 0xb2, 0xd6, 0x44, 0x73, 0xa9, 0xe5, 0xe3, 0x24, 0x42, 0xfd, 0x33, 0x5a, 0x2a, 0xa0, 0xd9,
-	0x63, 0x12, 0x25, 0xb3, 0x1c, 0xae, 0xcb, 0x63, 0x63, 0x98, 0x84, 0xec, 0x92, 0x1a, 0x64, 0x10,
-	0x87, 0x9b, 0x82, 0xd8, 0xb7, 0x32, 0xc3, 0xae, 0x31, 0x4d, 0x58, 0x5c, 0x7b, 0x86, 0xac, 0x66,
-	0x79, 0x06, 0xb2, 0xe4, 0xf0, 0x6b, 0x39, 0xec, 0xc7, 0xde, 0xcb, 0x1a, 0xf4, 0x59, 0x20, 0x88,
-	0xaa, 0xc1, 0xa2, 0x15, 0x4e, 0x8c, 0x27, 0x09, 0xb3, 0xfd, 0x0b, 0x33, 0xbb, 0xd4, 0x1d, 0xf9,
-	0xae, 0x25, 0xbe, 0x1b, 0x9f, 0xa7, 0xfa, 0x47, 0x67, 0xab, 0x6c, 0x28, 0x43, 0x86, 0x8f, 0xa2,
-	0x46, 0x95, 0xe1, 0x23, 0xfc, 0x1e, 0x14, 0x79, 0xe0, 0x3b, 0x13, 0xdb, 0x1f, 0x93, 0xa8, 0x6f,
-	0x2c, 0x05, 0xb8, 0x0e, 0x7b, 0x2e, 0xe1, 0xc2, 0xa3, 0xb6, 0xec, 0x65, 0x7a, 0x4e, 0x29, 0x4a,
-	0x8b, 0xf0, 0x2d, 0x28, 0x3b, 0x3e, 0x71, 0x3d, 0x31, 0x70, 0x6c, 0xdf, 0x1d, 0x50, 0x16, 0xb6,
-	0xb8, 0xc3, 0x1d, 0xab, 0x14, 0xca, 0xef, 0xda, 0xbe, 0x7b, 0xc4, 0xf0, 0x0d, 0x28, 0x3a, 0x13,
-	0xf2, 0x9b, 0x80, 0x48, 0x48, 0x21, 0x82, 0x14, 0x42, 0xd1, 0x11, 0xc3, 0xb7, 0xa1, 0xc0, 0x7c,
-	0x6f, 0xec, 0x51, 0x7b, 0xaa, 0x17, 0x15, 0x0d, 0xd7, 0x5e, 0xef, 0x45, 0x6d, 0x2b, 0x81, 0xf4,
-	0x8b, 0x49, 0x47, 0x35, 0x5e, 0x64, 0xa0, 0xf4, 0x98, 0x70, 0xf1, 0x84, 0xf8, 0xdc, 0x63, 0xb4,
-	0x8d, 0x4b, 0x80, 0xe6, 0x51, 0x6d, 0xa1, 0x39, 0x36, 0x00, 0xd9, 0x11, 0xb1, 0xfb, 0xb1, 0xc6,
-	0x34, 0xdc, 0x42, 0xb6, 0xc4, 0x0c, 0xa3, 0xc0, 0x6e, 0xc0, 0x0c, 0x25, 0xc6, 0x89, 0x12, 0x6a,
-	0x03, 0xc6, 0xc1, 0x0d, 0x40, 0x6e, 0xd4, 0x14, 0xd6, 0x62, 0xfa, 0xd9, 0x67, 0x2f, 0x6e, 0xee,
-	0x58, 0xc8, 0xc5, 0x65, 0x40, 0x44, 0xf5, 0xdc, 0xdc, 0xe1, 0x8e, 0x85, 0x08, 0x7e, 0x1f, 0xd0,
-	0x48, 0x11, 0xb7, 0x61, 0xa7, 0x44, 0x8d, 0xa4, 0x0d, 0x63, 0xc5, 0xdd, 0xa6, 0xa6, 0x8b, 0xc6,
-	0x12, 0x33, 0xd1, 0x8b, 0xe7, 0xd9, 0x39, 0xc1, 0x1f, 0x00, 0x3a, 0xd6, 0x4b, 0x1b, 0x58, 0xee,
-	0x67, 0x9f, 0xbf, 0xb8, 0x89, 0x2c, 0x74, 0xdc, 0xcf, 0x81, 0xc6, 0x83, 0x99, 0xf1, 0xaf, 0x55,
-	0x82, 0xcd, 0x8b, 0x11, 0x6c, 0x6e, 0x41, 0xb0, 0xb9, 0x05, 0xc1, 0xa6, 0x24, 0xd8, 0x38, 0x9f,
-	0x60, 0xf3, 0x12, 0xd4, 0x9a, 0x6f, 0x82, 0x5a, 0x7c, 0x1d, 0x8a, 0x94, 0x9c, 0x0e, 0x46, 0x1e,
-	0x99, 0xba, 0xfa, 0xbb, 0x75, 0xd4, 0xc8, 0x5a, 0x05, 0x4a, 0x4e, 0x0f, 0xe4, 0x3c, 0xe6, 0xfd,
-	0x0b, 0x6d, 0x85, 0xf7, 0xce, 0xc5, 0x78, 0xef, 0x6c, 0xc1, 0x7b, 0x67, 0x0b, 0xde, 0x3b, 0x5b,
-	0xf0, 0xde, 0xb9, 0x04, 0xef, 0x9d, 0x37, 0xc2, 0xfb, 0x6d, 0xc0, 0x94, 0xd1, 0x81, 0xe3, 0x7b,
-	0xc2, 0x73, 0xec, 0x69, 0x14, 0x80, 0x2f, 0x54, 0x3f, 0xb2, 0x2a, 0x94, 0xd1, 0xbb, 0xd1, 0xca,
-	0x4a, 0x24, 0xfe, 0x99, 0x81, 0x6a, 0xda, 0xf4, 0x87, 0x8c, 0x92, 0x47, 0x94, 0x3c, 0x1a, 0x3d,
-	0x91, 0x0f, 0xe5, 0xb7, 0x2c, 0x2e, 0x6f, 0x05, 0xe3, 0x7f, 0xcf, 0xc3, 0xf7, 0x5f, 0x67, 0xfc,
-	0x48, 0x3d, 0x75, 0xc6, 0xdf, 0x72, 0xba, 0x5b, 0xcb, 0xb4, 0xbf, 0xb9, 0x0e, 0x93, 0xf2, 0xe4,
-	0x2d, 0xa8, 0x00, 0xfc, 0x53, 0xc8, 0x7b, 0x94, 0x12, 0xbf, 0xad, 0x97, 0x95, 0xea, 0x5b, 0x5f,
-	0xe3, 0x53, 0xf3, 0x81, 0x42, 0x5b, 0xd1, 0xae, 0x64, 0xbf, 0xa9, 0x5f, 0xbd, 0xc0, 0x7e, 0x33,
-	0xda, 0x6f, 0x56, 0x7f, 0x8f, 0x20, 0x1f, 0xaa, 0x4c, 0xbd, 0xdd, 0x68, 0x1b, 0xdf, 0x6e, 0x3e,
-	0x91, 0xaf, 0xe6, 0x94, 0xf8, 0x51, 0xb4, 0xdb, 0xdb, 0x59, 0x1b, 0xfe, 0xa9, 0x1f, 0x2b, 0xdc,
-	0x5f, 0xbd, 0x23, 0x5f, 0xd8, 0x63, 0x61, 0xea, 0xe8, 0x62, 0x7c, 0xb4, 0xba, 0x35, 0x45, 0x47,
-	0xcb, 0x71, 0xf5, 0x0f, 0xb1, 0xa5, 0xe6, 0x19, 0xb8, 0x0e, 0xbb, 0x0e, 0x0b, 0x68, 0x7c, 0x8d,
-	0x2b, 0x5a, 0xf1, 0xf4, 0x72, 0xf6, 0x9a, 0xff, 0x0d, 0x7b, 0xe3, 0x4a, 0xfb, 0xc7, 0x6a, 0xa5,
-	0x75, 0xbf, 0xab, 0xb4, 0x6f, 0x71, 0xa5, 0x75, 0xbf, 0x61, 0xa5, 0x75, 0xff, 0xaf, 0x95, 0xd6,
-	0xfd, 0x46, 0x95, 0xa6, 0x6d, 0xac, 0xb4, 0xaf, 0xfe, 0x47, 0x95, 0xd6, 0xdd, 0xaa, 0xd2, 0xcc,
-	0x73, 0x2b, 0x6d, 0x3f, 0x7d, 0x91, 0xd7, 0xa2, 0x6b, 0x7b, 0x5c, 0x6b, 0x7f, 0x42, 0x50, 0x4e,
-	0x9d, 0x77, 0x70, 0xef, 0x32, 0x97, 0x95, 0x37, 0x7a, 0x75, 0x88, 0x3d, 0xf9, 0x0b, 0x5a, 0x79,
-	0x23, 0x3a, 0xb8, 0xd7, 0xfe, 0x95, 0x27, 0x26, 0xf7, 0xe7, 0xc2, 0xb7, 0x7b, 0x74, 0xf1, 0x66,
-	0xbc, 0x8a, 0x50, 0x3d, 0xba, 0x48, 0x6c, 0xb9, 0xa0, 0x57, 0x8f, 0xa1, 0x94, 0xde, 0x2d, 0xef,
-	0x73, 0xb6, 0x72, 0x63, 0x03, 0x69, 0x71, 0xad, 0xdb, 0xd2, 0xe1, 0xb0, 0xef, 0x69, 0xb2, 0xc3,
-	0x95, 0xc2, 0x0e, 0xa7, 0x66, 0x8e, 0xf1, 0x47, 0x04, 0x15, 0x79, 0xe0, 0xa7, 0x27, 0xae, 0x2d,
-	0x88, 0xfb, 0x78, 0x6e, 0xd9, 0xa7, 0xf8, 0x06, 0xc0, 0x90, 0xb9, 0x8b, 0xc1, 0x70, 0x21, 0x08,
-	0x57, 0x67, 0x94, 0xac, 0xa2, 0x94, 0xf4, 0xa5, 0x00, 0xdf, 0x82, 0xab, 0x76, 0x20, 0x26, 0x03,
-	0x8f, 0x8e, 0x58, 0x84, 0xc9, 0x28, 0xcc, 0x15, 0x29, 0x7e, 0x40, 0x47, 0x2c, 0xc4, 0xd5, 0x00,
-	0xb8, 0x37, 0xa6, 0xb6, 0x08, 0x7c, 0xc2, 0x75, 0xad, 0xae, 0x35, 0x4a, 0x56, 0x4a, 0x82, 0x6b,
-	0xb0, 0x97, 0xdc, 0x33, 0x06, 0x1f, 0xaa, 0xfb, 0x7b, 0xc9, 0x2a, 0xc6, 0x37, 0x8d, 0x0f, 0xf1,
-	0x07, 0x50, 0x5e, 0xae, 0xb7, 0xef, 0x98, 0x5d, 0xfd, 0xf3, 0x82, 0xc2, 0x94, 0x62, 0x8c, 0x14,
-	0x1a, 0x5f, 0x6a, 0x70, 0x6d, 0xc5, 0x85, 0x3e, 0x73, 0x17, 0xf8, 0x0e, 0x14, 0x66, 0x84, 0x73,
-	0x7b, 0xac, 0x3c, 0xd0, 0x36, 0xa6, 0x56, 0x82, 0x92, 0xd5, 0x3c, 0x23, 0x33, 0x16, 0x57, 0xb3,
-	0x1c, 0x4b, 0x13, 0x84, 0x37, 0x23, 0x2c, 0x10, 0x83, 0x09, 0xf1, 0xc6, 0x13, 0x11, 0xf1, 0x78,
-	0x25, 0x92, 0x1e, 0x2a, 0x21, 0x7e, 0x1f, 0xca, 0x9c, 0xcd, 0xc8, 0x60, 0x79, 0x6d, 0xca, 0xaa,
-	0x6b, 0x53, 0x49, 0x4a, 0x8f, 0x22, 0x63, 0xf1, 0x21, 0xfc, 0x60, 0x15, 0x35, 0x58, 0xd3, 0x82,
-	0x7f, 0x17, 0xb6, 0xe0, 0xf7, 0xd2, 0x3b, 0x8f, 0x5e, 0x6f, 0xc7, 0x7d, 0xb8, 0x46, 0xe6, 0x82,
-	0x50, 0x99, 0x23, 0x03, 0xa6, 0x3e, 0xe5, 0x72, 0xfd, 0xdf, 0xbb, 0xe7, 0xb8, 0x59, 0x49, 0xf0,
-	0x8f, 0x42, 0x38, 0xfe, 0x0c, 0x6a, 0x2b, 0xc7, 0xaf, 0x51, 0x78, 0xf5, 0x1c, 0x85, 0xd7, 0x53,
-	0xcf, 0x88, 0xfb, 0xaf, 0xe9, 0x36, 0x9e, 0x21, 0xf8, 0x5e, 0x2a, 0x24, 0xbd, 0x28, 0x2d, 0xf0,
-	0xc7, 0x50, 0x92, 0xf1, 0x27, 0xbe, 0xca, 0x9d, 0x38, 0x30, 0x37, 0x9a, 0xe1, 0xa7, 0xef, 0xa6,
-	0x98, 0x37, 0xa3, 0x4f, 0xdf, 0xcd, 0x5f, 0x2a, 0x98, 0xdc, 0x64, 0xed, 0xf1, 0x64, 0xcc, 0x71,
-	0x63, 0xf9, 0xf5, 0x6b, 0xcf, 0x7c, 0x67, 0xcd, 0xc6, 0x03, 0x42, 0xc2, 0xaf, 0x62, 0x2b, 0xd9,
-	0xd5, 0x51, 0x71, 0x4b, 0x65, 0x57, 0x67, 0xdb, 0xec, 0xfa, 0x61, 0x98, 0x5c, 0x16, 0x39, 0x21,
-	0xd2, 0x95, 0x4f, 0x3d, 0x2a, 0x54, 0xaa, 0xd0, 0x60, 0x16, 0xda, 0x9f, 0xb5, 0xd4, 0xb8, 0x7f,
-	0xf8, 0xec, 0x65, 0x0d, 0x3d, 0x7f, 0x59, 0x43, 0x7f, 0x7b, 0x59, 0x43, 0x5f, 0xbe, 0xaa, 0xed,
-	0x3c, 0x7f, 0x55, 0xdb, 0xf9, 0xeb, 0xab, 0xda, 0xce, 0x67, 0xcd, 0xb1, 0x27, 0x26, 0xc1, 0xb0,
-	0xe9, 0xb0, 0x59, 0x2b, 0xfa, 0xc8, 0x1f, 0xfe, 0xdd, 0xe6, 0xee, 0x71, 0x4b, 0x56, 0x7d, 0x20,
-	0xbc, 0xa9, 0x1a, 0xb8, 0xb6, 0xb0, 0x87, 0x79, 0x45, 0x74, 0xe7, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xea, 0x0d, 0xa7, 0x67, 0x18, 0x00, 0x00,
+	0xaa, 0x78, 0x9d, 0x68, 0x2f, 0xa8, 0xa1, 0x0b, 0x68, 0x0d, 0x54, 0x4d, 0xba, 0xaa, 0x3d, 0x70,
+	0xdb, 0xdb, 0x5e, 0xf7, 0x16, 0x29, 0x5f, 0x20, 0xa7, 0x68, 0xbf, 0x42, 0x6e, 0xf1, 0x2d, 0x96,
+	0x72, 0xc9, 0xc9, 0x8a, 0xec, 0x43, 0x94, 0x53, 0x4e, 0x39, 0x27, 0xaa, 0xea, 0x3f, 0x80, 0x0d,
+	0xb3, 0xcc, 0x6c, 0x92, 0x59, 0x4b, 0x7b, 0x81, 0xaa, 0x57, 0xbf, 0x7a, 0x7f, 0x7e, 0xf5, 0xde,
+	0xeb, 0xae, 0x86, 0xb2, 0xa4, 0x42, 0x9e, 0xf4, 0x1b, 0x01, 0x3b, 0x66, 0x9c, 0x9d, 0xb2, 0x13,
+	0x9f, 0x4b, 0x5e, 0xd7, 0xbf, 0x38, 0x1b, 0xae, 0x95, 0xf7, 0x47, 0x7c, 0xc4, 0xb5, 0xa8, 0xa1,
+	0x46, 0xe1, 0x6a, 0xf9, 0xdd, 0x11, 0xe7, 0xa3, 0x09, 0x6d, 0xe8, 0x59, 0x3f, 0x18, 0x36, 0x1c,
+	0x36, 0x8f, 0x96, 0xca, 0x03, 0x2e, 0xa6, 0x5c, 0x34, 0xe4, 0xac, 0xf1, 0xb4, 0xd9, 0xa7, 0xd2,
+	0x69, 0x36, 0xe4, 0x2c, 0x5c, 0xb3, 0x24, 0xe4, 0xef, 0x06, 0x42, 0xf2, 0x29, 0xf5, 0x9b, 0xb8,
+	0x08, 0x29, 0xcf, 0x35, 0x51, 0x15, 0xd5, 0x32, 0x24, 0xe5, 0xb9, 0x18, 0x43, 0x9a, 0x39, 0x53,
+	0x6a, 0xa6, 0xaa, 0xa8, 0x96, 0x27, 0x7a, 0x8c, 0x7f, 0x04, 0x25, 0x11, 0xf4, 0xc5, 0xc0, 0xf7,
+	0x4e, 0xa4, 0xc7, 0x59, 0x6f, 0x48, 0xa9, 0x69, 0x54, 0x51, 0x2d, 0x45, 0xae, 0x2e, 0xcb, 0x0f,
+	0x28, 0xc5, 0x26, 0xec, 0x9e, 0x38, 0xf3, 0x29, 0x65, 0xd2, 0xdc, 0xd5, 0x1a, 0xe2, 0xa9, 0xf5,
+	0x55, 0x6a, 0x61, 0xd6, 0x7e, 0xc3, 0x6c, 0x19, 0x72, 0x1e, 0x73, 0x03, 0x21, 0xfd, 0xb9, 0x36,
+	0x9d, 0x21, 0xc9, 0x3c, 0x71, 0xc9, 0x58, 0x72, 0x69, 0x1f, 0x32, 0x43, 0x7a, 0x4a, 0x7d, 0x33,
+	0xad, 0xfd, 0x08, 0x27, 0xf8, 0x3a, 0xe4, 0x7c, 0x2a, 0xa8, 0xff, 0x94, 0xba, 0xe6, 0x6f, 0x73,
+	0x55, 0x54, 0x33, 0x48, 0x22, 0xc0, 0x3f, 0x86, 0xf4, 0xc0, 0x93, 0x73, 0x33, 0x5b, 0x45, 0xb5,
+	0xa2, 0xfd, 0x4e, 0x3d, 0xa4, 0xb6, 0x9e, 0xf8, 0x54, 0xbf, 0xeb, 0xc9, 0x39, 0xd1, 0x18, 0xfc,
+	0x11, 0x5c, 0x99, 0x7a, 0x62, 0x40, 0x27, 0x13, 0x87, 0x51, 0x1e, 0x08, 0x13, 0xaa, 0xa8, 0xb6,
+	0x67, 0xef, 0xd7, 0x43, 0xc6, 0xeb, 0x31, 0xe3, 0xf5, 0x0e, 0x9b, 0x93, 0x55, 0xa8, 0xf5, 0x09,
+	0xa4, 0x95, 0x26, 0x9c, 0x83, 0xf4, 0x43, 0x87, 0x8b, 0xd2, 0x0e, 0x2e, 0x02, 0x3c, 0xe4, 0xa2,
+	0xc3, 0x46, 0x74, 0x42, 0x45, 0x09, 0xe1, 0x02, 0xe4, 0x7e, 0xe1, 0x4c, 0x78, 0x67, 0x22, 0x79,
+	0x29, 0x85, 0x01, 0xb2, 0x3f, 0xe7, 0x62, 0xc0, 0x4f, 0x4b, 0x06, 0xde, 0x83, 0xdd, 0x23, 0xc7,
+	0xf3, 0x79, 0xdf, 0x2b, 0xa5, 0xad, 0x3a, 0xe4, 0x8e, 0xa8, 0x90, 0xd4, 0x6d, 0x77, 0xb6, 0x39,
+	0x26, 0xeb, 0xcf, 0x28, 0xde, 0xd0, 0xda, 0x6a, 0x03, 0xae, 0x42, 0xca, 0x69, 0x9b, 0xe9, 0xaa,
+	0x51, 0xdb, 0xb3, 0x4b, 0x31, 0x1f, 0xb1, 0x49, 0x92, 0x72, 0xda, 0xb8, 0x09, 0x19, 0x8f, 0xb9,
+	0x74, 0x66, 0x66, 0x34, 0xe8, 0xfa, 0x2a, 0xa8, 0xd5, 0xa9, 0x3f, 0x50, 0xab, 0xf7, 0x99, 0xf4,
+	0xe7, 0x24, 0x44, 0x96, 0x7f, 0x06, 0xb0, 0x10, 0xe2, 0x12, 0x18, 0xc7, 0x74, 0xae, 0xfd, 0x30,
+	0x88, 0x1a, 0xe2, 0x5b, 0x90, 0x79, 0xea, 0x4c, 0x82, 0xd0, 0x93, 0x75, 0x76, 0xc3, 0xe5, 0x8f,
+	0x52, 0x3f, 0x41, 0xd6, 0xaf, 0xe3, 0x80, 0xec, 0xed, 0x02, 0xaa, 0x41, 0x96, 0x69, 0xbc, 0x69,
+	0xac, 0x53, 0xde, 0xea, 0x90, 0x68, 0xdd, 0xba, 0x17, 0x6b, 0x6e, 0xbe, 0xa9, 0x79, 0xa1, 0x65,
+	0xad, 0x8b, 0xf6, 0x42, 0xcb, 0xc7, 0xc9, 0x09, 0x75, 0xdf, 0xd0, 0x52, 0x02, 0xc3, 0x19, 0xd1,
+	0x28, 0x99, 0xd5, 0x70, 0x5d, 0x1e, 0x5b, 0xfd, 0xe4, 0xc8, 0x2e, 0xa8, 0x41, 0x1d, 0x62, 0x7f,
+	0xd3, 0x21, 0x76, 0x49, 0xaa, 0xdf, 0xb6, 0x26, 0x09, 0x8b, 0x6b, 0x6d, 0x0c, 0x69, 0x68, 0x03,
+	0x11, 0x35, 0xfc, 0x5a, 0x0e, 0xbb, 0x71, 0xf4, 0xaa, 0x06, 0x7d, 0x1e, 0x48, 0xaa, 0x6b, 0x30,
+	0x4f, 0xc2, 0x89, 0xf5, 0x24, 0x61, 0xb6, 0x7b, 0x6e, 0x66, 0x17, 0xba, 0xa3, 0xd8, 0x8d, 0x24,
+	0x76, 0xeb, 0xf3, 0xa5, 0xfe, 0xd1, 0xda, 0x2a, 0x1b, 0x8a, 0x90, 0x12, 0xc3, 0xa8, 0x51, 0xa5,
+	0xc4, 0x10, 0xbf, 0x07, 0x79, 0x11, 0xf8, 0x83, 0xb1, 0xe3, 0x8f, 0x68, 0xd4, 0x37, 0x16, 0x02,
+	0x5c, 0x85, 0x3d, 0x97, 0x0a, 0xe9, 0x31, 0x47, 0xf5, 0x32, 0x33, 0xa3, 0x15, 0x2d, 0x8b, 0xf0,
+	0x2d, 0x28, 0x0e, 0x7c, 0xea, 0x7a, 0xb2, 0x37, 0x70, 0x7c, 0xb7, 0xc7, 0x78, 0xd8, 0xe2, 0x0e,
+	0x77, 0x48, 0x21, 0x94, 0xdf, 0x75, 0x7c, 0xf7, 0x88, 0xe3, 0x1b, 0x90, 0x1f, 0x8c, 0xe9, 0x6f,
+	0x02, 0xaa, 0x20, 0xb9, 0x08, 0x92, 0x0b, 0x45, 0x47, 0x1c, 0xdf, 0x86, 0x1c, 0xf7, 0xbd, 0x91,
+	0xc7, 0x9c, 0x89, 0x99, 0xd7, 0x34, 0x5c, 0x7b, 0xbd, 0x17, 0x35, 0x49, 0x02, 0xe9, 0xe6, 0x93,
+	0x8e, 0x6a, 0xbd, 0x48, 0x41, 0xe1, 0x31, 0x15, 0xf2, 0x09, 0xf5, 0x85, 0xc7, 0x59, 0x13, 0x17,
+	0x00, 0xcd, 0xa2, 0xda, 0x42, 0x33, 0x6c, 0x01, 0x72, 0x22, 0x62, 0xf7, 0x63, 0x8d, 0xcb, 0x70,
+	0x82, 0x1c, 0x85, 0xe9, 0x9b, 0xc6, 0x59, 0x98, 0xbe, 0xc2, 0x0c, 0xa2, 0x84, 0xda, 0x80, 0x19,
+	0xe0, 0x1a, 0x20, 0xd7, 0xcc, 0x6c, 0xc6, 0x74, 0xd3, 0xcf, 0x5e, 0xdc, 0xdc, 0x21, 0xc8, 0xc5,
+	0x45, 0x40, 0x54, 0xf7, 0xdc, 0xcc, 0xe1, 0x0e, 0x41, 0x14, 0xbf, 0x0f, 0x68, 0xa8, 0x89, 0xdb,
+	0xb0, 0x53, 0xa1, 0x86, 0xca, 0x87, 0x91, 0x99, 0x8b, 0x50, 0xeb, 0x9a, 0x2e, 0x1a, 0x29, 0xcc,
+	0xd8, 0xcc, 0x9f, 0xe5, 0xe7, 0x18, 0x7f, 0x00, 0xe8, 0xd8, 0x2c, 0x6c, 0x60, 0xb9, 0x9b, 0x7e,
+	0xfe, 0xe2, 0x26, 0x22, 0xe8, 0xb8, 0x9b, 0x01, 0x43, 0x04, 0x53, 0xeb, 0x5f, 0xab, 0x04, 0xdb,
+	0xe7, 0x23, 0xd8, 0xde, 0x82, 0x60, 0x7b, 0x0b, 0x82, 0x6d, 0x45, 0xb0, 0x75, 0x36, 0xc1, 0xf6,
+	0x05, 0xa8, 0xb5, 0x2f, 0x83, 0x5a, 0x7c, 0x1d, 0xf2, 0x8c, 0x9e, 0xf6, 0x86, 0x1e, 0x9d, 0xb8,
+	0xe6, 0xbb, 0x55, 0x54, 0x4b, 0x93, 0x1c, 0xa3, 0xa7, 0x07, 0x6a, 0x1e, 0xf3, 0xfe, 0x85, 0xb1,
+	0xc2, 0x7b, 0xeb, 0x7c, 0xbc, 0xb7, 0xb6, 0xe0, 0xbd, 0xb5, 0x05, 0xef, 0xad, 0x2d, 0x78, 0x6f,
+	0x5d, 0x80, 0xf7, 0xd6, 0xa5, 0xf0, 0x7e, 0x1b, 0x30, 0xe3, 0xac, 0x37, 0xf0, 0x3d, 0xe9, 0x0d,
+	0x9c, 0x49, 0x74, 0x00, 0x5f, 0xe8, 0x7e, 0x44, 0x4a, 0x8c, 0xb3, 0xbb, 0xd1, 0xca, 0xca, 0x49,
+	0xfc, 0x33, 0x05, 0xe5, 0x65, 0xd7, 0x1f, 0x72, 0x46, 0x1f, 0x31, 0xfa, 0x68, 0xf8, 0x44, 0x3d,
+	0x94, 0xdf, 0xb2, 0x73, 0x79, 0x2b, 0x18, 0xff, 0x7b, 0x16, 0xbe, 0xff, 0x3a, 0xe3, 0x47, 0xfa,
+	0xa9, 0x33, 0xfa, 0x96, 0xd3, 0xdd, 0x58, 0xa4, 0xfd, 0xcd, 0x75, 0x98, 0xa5, 0x48, 0xde, 0x82,
+	0x0a, 0xc0, 0x3f, 0x85, 0xac, 0xc7, 0x18, 0xf5, 0x9b, 0x66, 0x51, 0xab, 0xbe, 0xf5, 0x35, 0x31,
+	0xd5, 0x1f, 0x68, 0x34, 0x89, 0x76, 0x25, 0xfb, 0x6d, 0xf3, 0xea, 0x39, 0xf6, 0xdb, 0xd1, 0x7e,
+	0xbb, 0xfc, 0x7b, 0x04, 0xd9, 0x50, 0xe5, 0xd2, 0xdb, 0x8d, 0xb1, 0xf1, 0xed, 0xe6, 0x13, 0xf5,
+	0x6a, 0xce, 0xa8, 0x1f, 0x9d, 0x76, 0x73, 0x3b, 0x6f, 0xc3, 0x3f, 0xfd, 0x43, 0xc2, 0xfd, 0xe5,
+	0x3b, 0x00, 0x0b, 0xe1, 0x92, 0xe9, 0x7c, 0x6c, 0x5a, 0xdf, 0x9a, 0x22, 0xd3, 0x6a, 0x5c, 0xfe,
+	0x43, 0xec, 0xa9, 0xfd, 0x06, 0xdc, 0x84, 0xdd, 0x01, 0x0f, 0x58, 0x7c, 0x8d, 0xcb, 0x93, 0x78,
+	0x7a, 0x31, 0x7f, 0xed, 0xff, 0x86, 0xbf, 0x71, 0xa5, 0xfd, 0x63, 0xb5, 0xd2, 0xda, 0xdf, 0x55,
+	0xda, 0xb7, 0xb8, 0xd2, 0xda, 0xdf, 0xb0, 0xd2, 0xda, 0xff, 0xd7, 0x4a, 0x6b, 0x7f, 0xa3, 0x4a,
+	0x33, 0x36, 0x56, 0xda, 0x57, 0xff, 0xa3, 0x4a, 0x6b, 0x6f, 0x55, 0x69, 0xf6, 0x99, 0x95, 0xb6,
+	0xbf, 0x7c, 0x91, 0x37, 0xa2, 0x6b, 0x7b, 0x5c, 0x6b, 0x7f, 0x42, 0x50, 0x5c, 0xb2, 0x77, 0x70,
+	0xef, 0x22, 0x97, 0x95, 0x4b, 0xbd, 0x3a, 0xc4, 0x91, 0xfc, 0x05, 0xad, 0xbc, 0x11, 0x1d, 0xdc,
+	0x6b, 0xfe, 0xca, 0x93, 0xe3, 0xfb, 0x33, 0xe9, 0x3b, 0x1d, 0x36, 0xbf, 0x9c, 0xa8, 0x22, 0x54,
+	0x87, 0xcd, 0x13, 0x5f, 0xce, 0x19, 0xd5, 0x63, 0x28, 0x2c, 0xef, 0x56, 0xf7, 0x39, 0x47, 0x87,
+	0xb1, 0x81, 0xb4, 0xb8, 0xd6, 0x1d, 0x5c, 0x88, 0xfb, 0x9e, 0xa1, 0x3a, 0x5c, 0x21, 0xec, 0x70,
+	0x7a, 0x36, 0xb0, 0xfe, 0x88, 0xa0, 0xa4, 0x0c, 0x7e, 0x7a, 0xe2, 0x3a, 0x92, 0xba, 0x8f, 0x67,
+	0xc4, 0x39, 0xc5, 0x37, 0x00, 0xfa, 0xdc, 0x9d, 0xf7, 0xfa, 0x73, 0x49, 0x85, 0xb6, 0x51, 0x20,
+	0x79, 0x25, 0xe9, 0x2a, 0x01, 0xbe, 0x05, 0x57, 0x9d, 0x40, 0x8e, 0x7b, 0x1e, 0x1b, 0xf2, 0x08,
+	0x93, 0xd2, 0x98, 0x2b, 0x4a, 0xfc, 0x80, 0x0d, 0x79, 0x88, 0xab, 0x00, 0x08, 0x6f, 0xc4, 0x1c,
+	0x19, 0xf8, 0x54, 0x98, 0x46, 0xd5, 0xa8, 0x15, 0xc8, 0x92, 0x04, 0x57, 0x60, 0x2f, 0xb9, 0x67,
+	0xf4, 0x3e, 0xd4, 0xf7, 0xf7, 0x02, 0xc9, 0xc7, 0x37, 0x8d, 0x0f, 0xf1, 0x07, 0x50, 0x5c, 0xac,
+	0x37, 0xef, 0xd8, 0x6d, 0xf3, 0xf3, 0x9c, 0xc6, 0x14, 0x62, 0x8c, 0x12, 0x5a, 0x5f, 0x1a, 0x70,
+	0x6d, 0x25, 0x84, 0x2e, 0x77, 0xe7, 0xf8, 0x0e, 0xe4, 0xa6, 0x54, 0x08, 0x67, 0xa4, 0x23, 0x30,
+	0x36, 0xa6, 0x56, 0x82, 0x52, 0xd5, 0x3c, 0xa5, 0x53, 0x1e, 0x57, 0xb3, 0x1a, 0x2b, 0x17, 0xa4,
+	0x37, 0xa5, 0x3c, 0x90, 0xbd, 0x31, 0xf5, 0x46, 0x63, 0x19, 0xf1, 0x78, 0x25, 0x92, 0x1e, 0x6a,
+	0x21, 0x7e, 0x1f, 0x8a, 0x82, 0x4f, 0x69, 0x6f, 0x71, 0x6d, 0xca, 0xea, 0x6b, 0x53, 0x41, 0x49,
+	0x8f, 0x22, 0x67, 0xf1, 0x21, 0xfc, 0x60, 0x15, 0xd5, 0x5b, 0xd3, 0x82, 0x7f, 0x17, 0xb6, 0xe0,
+	0xf7, 0x96, 0x77, 0x1e, 0xbd, 0xde, 0x8e, 0xbb, 0x70, 0x8d, 0xce, 0x24, 0x65, 0x2a, 0x47, 0x7a,
+	0x5c, 0x7f, 0xca, 0x15, 0xe6, 0xbf, 0x77, 0xcf, 0x08, 0xb3, 0x94, 0xe0, 0x1f, 0x85, 0x70, 0xfc,
+	0x19, 0x54, 0x56, 0xcc, 0xaf, 0x51, 0x78, 0xf5, 0x0c, 0x85, 0xd7, 0x97, 0x9e, 0x11, 0xf7, 0x5f,
+	0xd3, 0x6d, 0x3d, 0x43, 0xf0, 0xbd, 0xa5, 0x23, 0xe9, 0x44, 0x69, 0x81, 0x3f, 0x86, 0x82, 0x3a,
+	0x7f, 0xea, 0xeb, 0xdc, 0x89, 0x0f, 0xe6, 0x46, 0x3d, 0xfc, 0xf4, 0x5d, 0x97, 0xb3, 0x7a, 0xf4,
+	0xe9, 0xbb, 0xfe, 0x4b, 0x0d, 0x53, 0x9b, 0xc8, 0x9e, 0x48, 0xc6, 0x02, 0xd7, 0x16, 0x5f, 0xbf,
+	0xf6, 0xec, 0x77, 0xd6, 0x6c, 0x3c, 0xa0, 0x34, 0xfc, 0x2a, 0xb6, 0x92, 0x5d, 0x2d, 0xd3, 0x58,
+	0xcd, 0xae, 0xd6, 0xb6, 0xd9, 0xf5, 0xc3, 0x30, 0xb9, 0x08, 0x3d, 0xa1, 0x2a, 0x94, 0x4f, 0x3d,
+	0x26, 0x75, 0xaa, 0xb0, 0x60, 0x1a, 0xfa, 0x9f, 0x26, 0x7a, 0xdc, 0x3d, 0x7c, 0xf6, 0xb2, 0x82,
+	0x9e, 0xbf, 0xac, 0xa0, 0xbf, 0xbd, 0xac, 0xa0, 0x2f, 0x5f, 0x55, 0x76, 0x9e, 0xbf, 0xaa, 0xec,
+	0xfc, 0xf5, 0x55, 0x65, 0xe7, 0xb3, 0xfa, 0xc8, 0x93, 0xe3, 0xa0, 0x5f, 0x1f, 0xf0, 0x69, 0x23,
+	0xfa, 0xc8, 0x1f, 0xfe, 0xdd, 0x16, 0xee, 0x71, 0x43, 0x55, 0x7d, 0x20, 0xbd, 0x89, 0x1e, 0xb8,
+	0x8e, 0x74, 0xfa, 0x59, 0x4d, 0x74, 0xeb, 0x3f, 0x03, 0x00, 0x69, 0x42, 0xf9, 0x47, 0x67, 0x18,
+	0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5261,7 +5252,7 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
 	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.TimeoutHeight))
@@ -12602,7 +12593,7 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
@@ -222,8 +222,8 @@ func (b *GenesisBuilder) InitialHeight(h int64) *GenesisBuilder {
 // AuthParams sets the auth params on the genesis.
 func (b *GenesisBuilder) AuthParams(params authtypes.Params) *GenesisBuilder {
 	var err error
-	b.appState[authtypes.ModuleName], err = json.Marshal(map[string]any{
-		"params": params,
+	b.appState[authtypes.ModuleName], err = b.codec.MarshalJSON(&authtypes.GenesisState{
+		Params: params,
 	})
 	if err != nil {
 		panic(err)
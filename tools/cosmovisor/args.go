@@ -42,6 +42,8 @@ const (
 	genesisDir  = "genesis"
 	upgradesDir = "upgrades"
 	currentLink = "current"
+	// previousLink links to the directory which was current before the current upgrade
+	previousLink = "previous"
 )
 
 // Config is the information passed in to control the daemon
@@ -98,6 +100,12 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeInfoFilename)
 }
 
+// UpgradeFailureInfoFilePath is the expected upgrade-failure-info filename created by `x/upgrade/keeper`
+// when the upgrade handler of a plan with a contingency plan fails.
+func (cfg *Config) UpgradeFailureInfoFilePath() string {
+	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeFailureInfoFilename)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -323,6 +331,13 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	safeName := url.PathEscape(u.Name)
 	upgrade := filepath.Join(cfg.Root(), upgradesDir, safeName)
 
+	// remember the current directory, to switch back to it if the upgrade fails
+	if current, err := os.Readlink(link); err == nil && current != upgrade {
+		if err := cfg.setLink(previousLink, current); err != nil {
+			return err
+		}
+	}
+
 	// remove link if it exists
 	if _, err := os.Stat(link); err == nil {
 		if err := os.Remove(link); err != nil {
@@ -355,6 +370,42 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	return err
 }
 
+// SwitchToPreviousUpgrade sets the current link back to the directory which was current before
+// the current upgrade, returns error if there is none.
+func (cfg *Config) SwitchToPreviousUpgrade() error {
+	previous := filepath.Join(cfg.Root(), previousLink)
+	target, err := os.Readlink(previous)
+	if err != nil {
+		return fmt.Errorf("no previous binary to switch back to: %w", err)
+	}
+
+	if err := cfg.setLink(currentLink, target); err != nil {
+		return err
+	}
+
+	if err := os.Remove(previous); err != nil {
+		return fmt.Errorf("failed to remove previous link: %w", err)
+	}
+
+	// the current upgrade is read again from the directory we switched back to
+	cfg.currentUpgrade = upgradetypes.Plan{}
+	return nil
+}
+
+// setLink points the named link in the root directory to target, replacing it if it exists.
+func (cfg *Config) setLink(name, target string) error {
+	link := filepath.Join(cfg.Root(), name)
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove existing %s link: %w", name, err)
+	}
+
+	if err := os.Symlink(target, link); err != nil {
+		return fmt.Errorf("creating %s symlink: %w", name, err)
+	}
+
+	return nil
+}
+
 func (cfg *Config) UpgradeInfo() (upgradetypes.Plan, error) {
 	if cfg.currentUpgrade.Name != "" {
 		return cfg.currentUpgrade, nil
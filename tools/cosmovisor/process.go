@@ -39,6 +39,12 @@ func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
 // exits (either when it dies, or *after* a successful upgrade.) and upgrade finished.
 // Returns true if the upgrade request was detected and the upgrade process started.
 func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
+	// the upgrade may have been recorded as failed while the app was not run by cosmovisor,
+	// e.g. by the rollback command of the app
+	if _, err := l.switchBackIfUpgradeFailed(); err != nil {
+		return false, err
+	}
+
 	bin, err := l.cfg.CurrentBin()
 	if err != nil {
 		return false, fmt.Errorf("error creating symlink to genesis: %w", err)
@@ -66,9 +72,17 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	if err != nil {
+		// the app halts at the upgrade height if the upgrade handler of a plan with a contingency plan fails
+		if switched, serr := l.switchBackIfUpgradeFailed(); serr != nil || switched {
+			return switched, serr
+		}
 		return false, err
 	}
+	if !needsUpdate {
+		return false, nil
+	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
@@ -160,6 +174,38 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
+// switchBackIfUpgradeFailed switches back to the previous binary if the upgrade handler of the current
+// upgrade failed, or its height was rolled back, according to the upgrade-failure-info.json file created
+// by the app. The previous binary then skips the failed upgrade.
+// It returns true if it switched back to the previous binary.
+func (l Launcher) switchBackIfUpgradeFailed() (bool, error) {
+	upgradeFailure, err := parseUpgradeFailureInfoFile(l.cfg.UpgradeFailureInfoFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to parse upgrade failure info file: %w", err)
+	}
+
+	currentUpgrade, err := l.cfg.UpgradeInfo()
+	if err != nil || !strings.EqualFold(currentUpgrade.Name, upgradeFailure.Plan.Name) {
+		// the failed upgrade is not the current one, we have already switched back
+		return false, nil
+	}
+
+	l.logger.Error("upgrade failed, switching back to the previous binary", "name", upgradeFailure.Plan.Name, "height", upgradeFailure.Plan.Height, "error", upgradeFailure.Error, "contingency", upgradeFailure.Plan.Contingency.Info)
+	if err := l.cfg.SwitchToPreviousUpgrade(); err != nil {
+		return false, err
+	}
+
+	// the previous binary must not be upgraded again
+	if err := os.Remove(l.cfg.UpgradeInfoFilePath()); err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("failed to remove upgrade info file: %w", err)
+	}
+
+	return true, nil
+}
+
 func (l Launcher) doBackup() error {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
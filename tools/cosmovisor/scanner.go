@@ -209,3 +209,23 @@ func parseUpgradeInfoFile(filename string, disableRecase bool) (upgradetypes.Pla
 
 	return upgradePlan, err
 }
+
+// parseUpgradeFailureInfoFile parses the upgrade-failure-info.json file written by
+// `x/upgrade/keeper` when the upgrade handler of a plan with a contingency plan fails.
+func parseUpgradeFailureInfoFile(filename string) (upgradetypes.UpgradeFailure, error) {
+	f, err := os.ReadFile(filename)
+	if err != nil {
+		return upgradetypes.UpgradeFailure{}, err
+	}
+
+	var upgradeFailure upgradetypes.UpgradeFailure
+	if err := json.Unmarshal(f, &upgradeFailure); err != nil {
+		return upgradetypes.UpgradeFailure{}, err
+	}
+
+	if upgradeFailure.Plan.Name == "" || upgradeFailure.Plan.Contingency == nil {
+		return upgradetypes.UpgradeFailure{}, fmt.Errorf("invalid upgrade-failure-info.json content, got: %v", upgradeFailure)
+	}
+
+	return upgradeFailure, nil
+}
@@ -39,9 +39,9 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("unhandled error: %w", err)
 	}
 
-	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	upgradeInfo, err := getUpgradeInfo(cfg, p)
 	if err != nil {
-		return fmt.Errorf("cannot parse upgrade info: %w", err)
+		return err
 	}
 
 	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
@@ -68,6 +68,27 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 	return cfg.SetCurrentUpgrade(p)
 }
 
+// getUpgradeInfo returns the binaries of the plan. The structured binaries of
+// the plan, which always have a checksum, take precedence over the binaries
+// encoded in the plan info.
+func getUpgradeInfo(cfg *Config, p upgradetypes.Plan) (*plan.Info, error) {
+	if len(p.Binaries) > 0 {
+		upgradeInfo, err := plan.NewInfoFromBinaries(p.Binaries)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse upgrade binaries: %w", err)
+		}
+
+		return upgradeInfo, nil
+	}
+
+	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse upgrade info: %w", err)
+	}
+
+	return upgradeInfo, nil
+}
+
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
 	url, ok := binaries[OSArch()]
 	if !ok {
@@ -76,6 +76,29 @@ func (s *upgradeTestSuite) TestCurrentAlwaysSymlinkToDirectory() {
 	s.assertCurrentLink(cfg, filepath.Join("upgrades", "chain2"))
 }
 
+func (s *upgradeTestSuite) TestSwitchToPreviousUpgrade() {
+	home := copyTestData(s.T(), "validate")
+	cfg := cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	_, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Error(cfg.SwitchToPreviousUpgrade())
+
+	s.Require().NoError(cfg.SetCurrentUpgrade(upgradetypes.Plan{Name: "chain2"}))
+	s.Require().NoError(cfg.SetCurrentUpgrade(upgradetypes.Plan{Name: "chain3"}))
+	s.assertCurrentLink(cfg, filepath.Join("upgrades", "chain3"))
+
+	s.Require().NoError(cfg.SwitchToPreviousUpgrade())
+	s.assertCurrentLink(cfg, filepath.Join("upgrades", "chain2"))
+	currentUpgrade, err := cfg.UpgradeInfo()
+	s.Require().NoError(err)
+	s.Require().Equal("chain2", currentUpgrade.Name)
+
+	// there is only one previous binary
+	s.Require().Error(cfg.SwitchToPreviousUpgrade())
+	s.assertCurrentLink(cfg, filepath.Join("upgrades", "chain2"))
+}
+
 func (s *upgradeTestSuite) assertCurrentLink(cfg cosmovisor.Config, target string) {
 	link := filepath.Join(cfg.Root(), "current")
 	// ensure this is a symlink
@@ -197,6 +220,69 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinaryFromPlanBinaries() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+
+	// sha256sum ./testdata/repo/chain3-zip_dir/autod.zip
+	validChecksum := "sha256:8951f52a0aea8617de0ae459a20daf704c29d259c425e60d520e363df0f166b4"
+	invalidChecksum := "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906"
+	zipPath, err := filepath.Abs("./testdata/repo/chain3-zip_dir/autod.zip")
+	s.Require().NoError(err)
+
+	cases := map[string]struct {
+		binaries    []upgradetypes.Binary
+		info        string
+		canDownload bool
+	}{
+		"binary for the platform": {
+			binaries:    []upgradetypes.Binary{{Platform: cosmovisor.OSArch(), Url: "file://" + zipPath, Checksum: validChecksum}},
+			canDownload: true,
+		},
+		"binary for any platform": {
+			binaries:    []upgradetypes.Binary{{Platform: "any", Url: "file://" + zipPath, Checksum: validChecksum}},
+			canDownload: true,
+		},
+		"binaries take precedence over info": {
+			binaries:    []upgradetypes.Binary{{Platform: cosmovisor.OSArch(), Url: "file://" + zipPath, Checksum: validChecksum}},
+			info:        "invalid info",
+			canDownload: true,
+		},
+		"no binary for the platform": {
+			binaries:    []upgradetypes.Binary{{Platform: "plan9/arm", Url: "file://" + zipPath, Checksum: validChecksum}},
+			canDownload: false,
+		},
+		"invalid checksum": {
+			binaries:    []upgradetypes.Binary{{Platform: cosmovisor.OSArch(), Url: "file://" + zipPath, Checksum: invalidChecksum}},
+			canDownload: false,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			home := copyTestData(s.T(), "download")
+
+			cfg := &cosmovisor.Config{
+				Home:                  home,
+				Name:                  "autod",
+				AllowDownloadBinaries: true,
+			}
+
+			plan := upgradetypes.Plan{
+				Name:     "amazonas",
+				Info:     tc.info,
+				Binaries: tc.binaries,
+			}
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, plan)
+			if !tc.canDownload {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
@@ -8,6 +8,7 @@ import (
 	"pgregory.net/rapid"
 
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -30,6 +31,10 @@ func TestCollectionsCorrectness(t *testing.T) {
 	t.Run("Time", func(t *testing.T) {
 		colltest.TestKeyCodec(t, TimeKey, time.Time{})
 	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(125, 3))
+	})
 }
 
 func TestLEUint64Key(t *testing.T) {
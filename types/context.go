@@ -60,6 +60,7 @@ type Context struct {
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
+	storeGasConfigs      map[string]storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.BlockInfo
 	headerInfo           header.Info
@@ -108,6 +109,15 @@ func (c Context) ConsensusParams() cmtproto.ConsensusParams {
 	return c.consParams
 }
 
+// GasSchedule returns the gas schedule of the context.
+func (c Context) GasSchedule() GasSchedule {
+	return GasSchedule{
+		KV:        c.kvGasConfig,
+		Transient: c.transientKVGasConfig,
+		Stores:    c.storeGasConfigs,
+	}
+}
+
 func (c Context) Deadline() (deadline time.Time, ok bool) {
 	return c.baseCtx.Deadline()
 }
@@ -241,6 +251,15 @@ func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Contex
 	return c
 }
 
+// WithGasSchedule returns a Context with an updated gas schedule, setting
+// the KVStore, transient KVStore and per store gas configurations.
+func (c Context) WithGasSchedule(schedule GasSchedule) Context {
+	c.kvGasConfig = schedule.KV
+	c.transientKVGasConfig = schedule.Transient
+	c.storeGasConfigs = schedule.Stores
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -333,12 +352,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.GasSchedule().StoreGasConfig(key, c.kvGasConfig))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.GasSchedule().StoreGasConfig(key, c.transientKVGasConfig))
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -59,6 +59,33 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Len(ctx.EventManager().Events(), 2)
 }
 
+func (s *contextTestSuite) TestGasSchedule() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_TestGasSchedule")
+	otherKey := storetypes.NewKVStoreKey(s.T().Name() + "_other")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{key.Name(): key, otherKey.Name(): otherKey},
+		map[string]*storetypes.TransientStoreKey{},
+		map[string]*storetypes.MemoryStoreKey{},
+	)
+	s.Require().Equal(types.DefaultGasSchedule(), ctx.GasSchedule())
+
+	storeGasCfg := storetypes.KVGasConfig()
+	storeGasCfg.WriteCostFlat *= 10
+	ctx = ctx.WithGasSchedule(types.GasSchedule{
+		KV:        storetypes.KVGasConfig(),
+		Transient: storetypes.TransientGasConfig(),
+		Stores:    map[string]storetypes.GasConfig{key.Name(): storeGasCfg},
+	})
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(otherKey).Set([]byte("key"), []byte("value"))
+	otherGas := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+	s.Require().Equal(otherGas+9*storetypes.KVGasConfig().WriteCostFlat, ctx.GasMeter().GasConsumed())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := storetypes.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
@@ -0,0 +1,34 @@
+package types
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// GasSchedule defines the gas costs of the operations on the stores. The
+// stores are charged with the gas config of their store key if any, otherwise
+// with the KV gas config, or the transient one for transient stores.
+type GasSchedule struct {
+	KV        storetypes.GasConfig
+	Transient storetypes.GasConfig
+	// Stores maps store key names to their gas config.
+	Stores map[string]storetypes.GasConfig
+}
+
+// DefaultGasSchedule returns the default gas schedule, which charges every
+// store with the default KV or transient gas config.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		KV:        storetypes.KVGasConfig(),
+		Transient: storetypes.TransientGasConfig(),
+	}
+}
+
+// StoreGasConfig returns the gas config of the store of the given key, or the
+// given fallback if the store has none of its own.
+func (s GasSchedule) StoreGasConfig(key storetypes.StoreKey, fallback storetypes.GasConfig) storetypes.GasConfig {
+	if gasConfig, ok := s.Stores[key.Name()]; ok {
+		return gasConfig
+	}
+
+	return fallback
+}
@@ -0,0 +1,241 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a tx matched by none of the lanes
+// of a LaneMempool.
+var ErrNoMatchingLane = errors.New("no lane matches tx")
+
+type (
+	// MatchFn returns whether a transaction belongs to a lane.
+	MatchFn func(tx sdk.Tx) bool
+
+	// Lane defines a class of transactions, stored in its own mempool, to which
+	// a share of the block space is reserved.
+	Lane struct {
+		// Name is the name of the lane, unique within a LaneMempool.
+		Name string
+
+		// Match returns whether a transaction belongs to the lane. A nil Match
+		// matches every transaction, which is only allowed for the last lane,
+		// useful as a default lane.
+		Match MatchFn
+
+		// MaxBlockSpace is the maximum ratio of the block bytes, and of the block
+		// gas when the block gas is limited, that the transactions of the lane may
+		// use. A zero ratio allows the lane to use all the remaining block space.
+		MaxBlockSpace math.LegacyDec
+
+		// Mempool stores and orders the transactions of the lane.
+		Mempool Mempool
+	}
+
+	// LaneMempool is a mempool composed of lanes. A transaction is stored in the
+	// first lane matching it, and the transactions are selected lane by lane, in
+	// the order of the lanes, each lane ordering its own transactions.
+	//
+	// The block space of each lane is enforced by the proposal handlers of
+	// baseapp.LaneProposalHandler, not by the mempool itself.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the transactions of the lanes of a LaneMempool,
+	// one lane after the other.
+	laneIterator struct {
+		ctx   context.Context
+		txs   [][]byte
+		lanes []Lane
+		iter  Iterator
+	}
+)
+
+// NewLaneMempool returns a mempool composed of the given lanes, in priority
+// order. It panics if the lanes are invalid.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if err := ValidateLanes(lanes); err != nil {
+		panic(err)
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// ValidateLanes checks that the lanes have unique names and a mempool, that only
+// the last lane matches every transaction, and that their block space ratios are
+// within [0, 1] and sum to at most 1.
+func ValidateLanes(lanes []Lane) error {
+	if len(lanes) == 0 {
+		return errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	total := math.LegacyZeroDec()
+	for i, l := range lanes {
+		if l.Name == "" {
+			return errors.New("lane name cannot be empty")
+		}
+		if names[l.Name] {
+			return fmt.Errorf("duplicate lane %s", l.Name)
+		}
+		names[l.Name] = true
+
+		if l.Mempool == nil {
+			return fmt.Errorf("lane %s has no mempool", l.Name)
+		}
+
+		// a lane matching every transaction would shadow all the lanes after it
+		if l.Match == nil && i != len(lanes)-1 {
+			return fmt.Errorf("lane %s matches every tx and must be the last lane", l.Name)
+		}
+
+		if l.MaxBlockSpace.IsNil() {
+			continue
+		}
+		if l.MaxBlockSpace.IsNegative() || l.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("lane %s max block space must be within [0, 1]: %s", l.Name, l.MaxBlockSpace)
+		}
+		total = total.Add(l.MaxBlockSpace)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("total max block space of the lanes cannot exceed 1: %s", total)
+	}
+
+	return nil
+}
+
+// MatchMsgTypes returns a MatchFn matching the transactions whose messages all
+// have one of the given type URLs.
+func MatchMsgTypes(msgTypeURLs ...string) MatchFn {
+	types := make(map[string]bool, len(msgTypeURLs))
+	for _, t := range msgTypeURLs {
+		types[t] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if !types[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Lanes returns the lanes of the mempool, in priority order.
+func (lm *LaneMempool) Lanes() []Lane {
+	return lm.lanes
+}
+
+// LaneIndex returns the index of the first lane matching the tx, or -1 if no
+// lane matches it.
+func (lm *LaneMempool) LaneIndex(tx sdk.Tx) int {
+	for i, l := range lm.lanes {
+		if l.Match == nil || l.Match(tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts the tx into the mempool of the first lane matching it. It
+// returns ErrNoMatchingLane if no lane matches it.
+func (lm *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := lm.LaneIndex(tx)
+	if i < 0 {
+		return ErrNoMatchingLane
+	}
+
+	return lm.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes, the
+// transactions of a lane being returned before those of the next lanes.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (lm *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{
+		ctx:   ctx,
+		txs:   txs,
+		lanes: lm.lanes,
+	}
+
+	return iter.nextLane()
+}
+
+// CountTx returns the total count of txs in the lanes.
+func (lm *LaneMempool) CountTx() int {
+	count := 0
+	for _, l := range lm.lanes {
+		count += l.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the tx from the mempool of the first lane matching it.
+func (lm *LaneMempool) Remove(tx sdk.Tx) error {
+	i := lm.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+
+	return lm.lanes[i].Mempool.Remove(tx)
+}
+
+// Next returns the next transaction of the current lane, or the first
+// transaction of the next non-empty lane.
+func (i *laneIterator) Next() Iterator {
+	if next := i.iter.Next(); next != nil {
+		return &laneIterator{
+			ctx:   i.ctx,
+			txs:   i.txs,
+			lanes: i.lanes,
+			iter:  next,
+		}
+	}
+
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
+
+// nextLane returns an iterator positioned on the first transaction of the next
+// non-empty lane, or nil if there is none.
+func (i *laneIterator) nextLane() Iterator {
+	for j, lane := range i.lanes {
+		if iter := lane.Mempool.Select(i.ctx, i.txs); iter != nil {
+			return &laneIterator{
+				ctx:   i.ctx,
+				txs:   i.txs,
+				lanes: i.lanes[j+1:],
+				iter:  iter,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	// txs of priority 100 and above go to the priority lane
+	priorityLane := mempool.Lane{
+		Name:          "priority",
+		Match:         func(tx sdk.Tx) bool { return tx.(testTx).priority >= 100 },
+		MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+		Mempool:       mempool.DefaultPriorityMempool(),
+	}
+	defaultLane := mempool.Lane{
+		Name:    "default",
+		Mempool: mempool.DefaultPriorityMempool(),
+	}
+	mp := mempool.NewLaneMempool(priorityLane, defaultLane)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 200, nonce: 0, address: sb},
+		{id: 2, priority: 20, nonce: 1, address: sa},
+		{id: 3, priority: 100, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, priorityLane.Mempool.CountTx())
+	require.Equal(t, 2, defaultLane.Mempool.CountTx())
+
+	// the txs of the priority lane are selected first
+	var order []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		order = append(order, iter.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{1, 3, 0, 2}, order)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 1, priorityLane.Mempool.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+
+	// a tx matched by no lane cannot be inserted
+	mp = mempool.NewLaneMempool(priorityLane)
+	require.ErrorIs(t, mp.Insert(ctx, txs[0]), mempool.ErrNoMatchingLane)
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+}
+
+func TestValidateLanes(t *testing.T) {
+	lane := func(name string, maxBlockSpace math.LegacyDec) mempool.Lane {
+		return mempool.Lane{Name: name, Match: mempool.MatchMsgTypes(), MaxBlockSpace: maxBlockSpace, Mempool: mempool.NewSenderNonceMempool()}
+	}
+	catchAll := mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool()}
+
+	testCases := []struct {
+		name   string
+		lanes  []mempool.Lane
+		expErr string
+	}{
+		{"valid", []mempool.Lane{lane("a", math.LegacyNewDecWithPrec(3, 1)), lane("b", math.LegacyDec{})}, ""},
+		{"catch-all last lane", []mempool.Lane{lane("a", math.LegacyDec{}), catchAll}, ""},
+		{"catch-all lane not last", []mempool.Lane{catchAll, lane("a", math.LegacyDec{})}, "lane default matches every tx and must be the last lane"},
+		{"no lanes", nil, "at least one lane is required"},
+		{"empty name", []mempool.Lane{lane("", math.LegacyDec{})}, "lane name cannot be empty"},
+		{"duplicate name", []mempool.Lane{lane("a", math.LegacyDec{}), lane("a", math.LegacyDec{})}, "duplicate lane a"},
+		{"no mempool", []mempool.Lane{{Name: "a"}}, "lane a has no mempool"},
+		{"negative block space", []mempool.Lane{lane("a", math.LegacyNewDec(-1))}, "lane a max block space must be within [0, 1]"},
+		{"total block space above 1", []mempool.Lane{lane("a", math.LegacyNewDecWithPrec(6, 1)), lane("b", math.LegacyNewDecWithPrec(6, 1))}, "total max block space of the lanes cannot exceed 1"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := mempool.ValidateLanes(tc.lanes)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestMatchMsgTypes(t *testing.T) {
+	delegatorTx, err := unmarshalTx(msgWithdrawDelegatorReward)
+	require.NoError(t, err)
+	proposalTx, err := unmarshalTx(msgMultiSigMsgSubmitProposal)
+	require.NoError(t, err)
+
+	match := mempool.MatchMsgTypes("/cosmos.gov.v1beta1.MsgSubmitProposal", "/cosmos.gov.v1beta1.MsgVote")
+	require.True(t, match(proposalTx))
+	require.False(t, match(delegatorTx))
+	require.False(t, match(testTx{}))
+}
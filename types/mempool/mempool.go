@@ -2,9 +2,16 @@ package mempool
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
+
+	"github.com/huandu/skiplist"
+
+	txsigning "cosmossdk.io/x/tx/signing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 type Mempool interface {
@@ -40,4 +47,51 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	// ErrTxReplacementFeeTooLow is returned when a tx does not pay enough fees
+	// to replace the tx of the same sender and nonce.
+	ErrTxReplacementFeeTooLow = errors.New("tx replacement fee too low")
 )
+
+// txNonce returns the nonce ordering tx among the txs of its sender, given the
+// signature of its first signer, along with the hash of its body telling apart
+// the txs of the sender with the same nonce. The nonce is the sequence of the
+// signature, except for unordered txs which do not consume sequences, for which
+// it is their timeout timestamp in nanoseconds. The body hash is only set for
+// unordered txs, which can share the same timeout.
+func txNonce(tx sdk.Tx, sig signing.SignatureV2) (uint64, string, error) {
+	utx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !utx.GetUnordered() {
+		return sig.Sequence, "", nil
+	}
+
+	timestamp := utx.GetTimeoutTimeStamp().UnixNano()
+	if timestamp < 0 {
+		return 0, "", errors.New("invalid timeout timestamp of unordered tx")
+	}
+
+	// the body of a tx can't be altered without invalidating its signatures, it
+	// identifies unordered txs like their replay protection does
+	bodyTx, ok := tx.(signingDataTx)
+	if !ok {
+		return 0, "", fmt.Errorf("expected unordered tx to provide its signing data, got %T", tx)
+	}
+	bodyHash := sha256.Sum256(bodyTx.GetSigningTxData().BodyBytes)
+
+	return uint64(timestamp), string(bodyHash[:]), nil
+}
+
+// signingDataTx is implemented by the txs providing their signing data, such as
+// the txs of x/auth/tx.
+type signingDataTx interface {
+	GetSigningTxData() txsigning.TxData
+}
+
+// compareNonces compares the nonces of two txs of the same sender, then their
+// body hashes.
+func compareNonces(nonceA uint64, bodyHashA string, nonceB uint64, bodyHashB string) int {
+	if res := skiplist.Uint64.Compare(nonceA, nonceB); res != 0 {
+		return res
+	}
+
+	return skiplist.String.Compare(bodyHashA, bodyHashB)
+}
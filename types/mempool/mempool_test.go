@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	"cosmossdk.io/log"
+	xtxsigning "cosmossdk.io/x/tx/signing"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +52,10 @@ type testTx struct {
 	priority int64
 	nonce    uint64
 	address  sdk.AccAddress
+	// unordered txs are ordered by their timeout instead of their nonce
+	unordered bool
+	timeout   time.Time
+	fee       sdk.Coins
 	// useful for debugging
 	strAddress string
 }
@@ -68,8 +74,27 @@ func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
 	return res, nil
 }
 
+func (tx testTx) GetTimeoutTimeStamp() time.Time { return tx.timeout }
+
+func (tx testTx) GetUnordered() bool { return tx.unordered }
+
+// GetSigningTxData returns a body told apart by the tx id, identifying unordered txs.
+func (tx testTx) GetSigningTxData() xtxsigning.TxData {
+	return xtxsigning.TxData{BodyBytes: []byte(fmt.Sprintf("body-%d", tx.id))}
+}
+
+func (tx testTx) GetGas() uint64 { return 0 }
+
+func (tx testTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testTx) FeePayer() []byte { return tx.address }
+
+func (tx testTx) FeeGranter() []byte { return nil }
+
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
+	_ sdk.TxWithUnordered     = (*testTx)(nil)
+	_ sdk.FeeTx               = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
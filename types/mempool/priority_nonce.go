@@ -7,6 +7,8 @@ import (
 
 	"github.com/huandu/skiplist"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -39,6 +41,21 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// MaxBytes sets the maximum total size in bytes of the transactions in the
+		// mempool, with the same eviction semantics as MaxTx. If MaxBytes <= 0,
+		// there is no cap on the size of the mempool. The size of a transaction is
+		// the length of the tx bytes of the sdk.Context it is inserted with, as set
+		// by CheckTx.
+		MaxBytes int64
+
+		// ReplacementFeeBump is the minimum fee increase, in percent, a transaction
+		// must pay to replace an existing transaction of the same sender and
+		// nonce. If ReplacementFeeBump > 0, the new transaction must be a FeeTx
+		// whose fee is, in every denom of the fee of the existing transaction, at
+		// least ReplacementFeeBump percent higher. It applies on top of
+		// TxReplacement.
+		ReplacementFeeBump uint64
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -53,6 +70,7 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
+		totalBytes     int64
 		cfg            PriorityNonceMempoolConfig[C]
 	}
 
@@ -86,6 +104,9 @@ type (
 	txMeta[C comparable] struct {
 		// nonce is the sender's sequence number
 		nonce uint64
+		// bodyHash tells apart the unordered txs of the sender with the same
+		// nonce, it is empty for ordered txs
+		bodyHash string
 		// priority is the transaction's priority
 		priority C
 		// sender is the transaction's sender
@@ -93,6 +114,8 @@ type (
 		// weight is the transaction's weight, used as a tiebreaker for transactions
 		// with the same priority
 		weight C
+		// size is the transaction's size in bytes
+		size int64
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
 	}
@@ -119,7 +142,8 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 }
 
 // skiplistComparable is a comparator for txKeys that first compares priority,
-// then weight, then sender, then nonce, uniquely identifying a transaction.
+// then weight, then sender, then nonce and body hash, uniquely identifying a
+// transaction.
 //
 // Note, skiplistComparable is used as the comparator in the priority index.
 func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Comparable {
@@ -148,10 +172,15 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 			return res
 		}
 
-		return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		return compareNonces(keyA.nonce, keyA.bodyHash, keyB.nonce, keyB.bodyHash)
 	})
 }
 
+// senderNonce returns the key of the tx of m in the scores, by sender and nonce.
+func (m txMeta[C]) senderNonce() txMeta[C] {
+	return txMeta[C]{nonce: m.nonce, bodyHash: m.bodyHash, sender: m.sender}
+}
+
 // NewPriorityMempool returns the SDK's default mempool implementation which
 // returns txs in a partial order by 2 dimensions; priority, and sender-nonce.
 func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *PriorityNonceMempool[C] {
@@ -192,11 +221,15 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // O(log n) no-op.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, provided it satisfies TxReplacement
+// and ReplacementFeeBump.
+//
+// When the mempool is full, the lowest priority tx of another sender, along with
+// the txs of higher nonces of that sender, is evicted to make room for the tx,
+// as long as its priority is lower than the priority of the tx. Otherwise
+// ErrMempoolTxMaxCapacity is returned.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
-	if mp.cfg.MaxTx > 0 && mp.CountTx() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -211,18 +244,11 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
-
-	senderIndex, ok := mp.senderIndices[sender]
-	if !ok {
-		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
-			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
-		}))
-
-		// initialize sender index if not found
-		mp.senderIndices[sender] = senderIndex
+	nonce, bodyHash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
 	}
+	key := txMeta[C]{nonce: nonce, bodyHash: bodyHash, priority: priority, sender: sender, size: txSize(ctx)}
 
 	// Since mp.priorityIndex is scored by priority, then sender, then nonce, a
 	// changed priority will create a new key, so we must remove the old key and
@@ -231,39 +257,176 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+	sk := key.senderNonce()
+	oldScore, txExists := mp.scores[sk]
+	if txExists {
+		oldTx := mp.senderIndices[sender].Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
+				oldTx,
 				tx,
 			)
 		}
 
+		if err := mp.checkReplacementFee(oldTx, tx); err != nil {
+			return err
+		}
+
+		if err := mp.ensureCapacity(sender, priority, 0, key.size-oldScore.size); err != nil {
+			return err
+		}
+
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
+			bodyHash: bodyHash,
 			sender:   sender,
 			priority: oldScore.priority,
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.totalBytes -= oldScore.size
+	} else if err := mp.ensureCapacity(sender, priority, 1, key.size); err != nil {
+		return err
+	}
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
+			keyA, keyB := a.(txMeta[C]), b.(txMeta[C])
+			return compareNonces(keyB.nonce, keyB.bodyHash, keyA.nonce, keyA.bodyHash)
+		}))
+
+		// initialize sender index if not found
+		mp.senderIndices[sender] = senderIndex
 	}
 
 	mp.priorityCounts[priority]++
+	mp.totalBytes += key.size
 
 	// Since senderIndex is scored by nonce, a changed priority will overwrite the
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, size: key.size}
 	mp.priorityIndex.Set(key, tx)
 
 	return nil
 }
 
+// txSize returns the size in bytes of the tx being inserted, which is the length
+// of the tx bytes of the context, or 0 if the context is not an sdk.Context.
+func txSize(ctx context.Context) int64 {
+	sdkCtx, ok := ctx.(sdk.Context)
+	if !ok {
+		return 0
+	}
+
+	return int64(len(sdkCtx.TxBytes()))
+}
+
+// checkReplacementFee returns an error if the fee of the new tx is not
+// ReplacementFeeBump percent higher than the fee of the old tx it replaces.
+func (mp *PriorityNonceMempool[C]) checkReplacementFee(oldTx, newTx sdk.Tx) error {
+	if mp.cfg.ReplacementFeeBump == 0 {
+		return nil
+	}
+
+	oldFeeTx, ok := oldTx.(sdk.FeeTx)
+	if !ok {
+		return nil
+	}
+	newFeeTx, ok := newTx.(sdk.FeeTx)
+	if !ok {
+		return fmt.Errorf("%w: tx must be a FeeTx", ErrTxReplacementFeeTooLow)
+	}
+
+	bump := sdkmath.NewIntFromUint64(100 + mp.cfg.ReplacementFeeBump)
+	requiredFee := sdk.NewCoins()
+	for _, c := range oldFeeTx.GetFee() {
+		amount := c.Amount.Mul(bump).Add(sdkmath.NewInt(99)).Quo(sdkmath.NewInt(100))
+		requiredFee = requiredFee.Add(sdk.NewCoin(c.Denom, amount))
+	}
+
+	if fee := newFeeTx.GetFee(); !fee.IsAllGTE(requiredFee) {
+		return fmt.Errorf("%w: got: %s required: %s", ErrTxReplacementFeeTooLow, fee, requiredFee)
+	}
+
+	return nil
+}
+
+// isFull returns whether the mempool cannot hold count more txs of the given
+// total size.
+func (mp *PriorityNonceMempool[C]) isFull(count int, size int64) bool {
+	return (mp.cfg.MaxTx > 0 && mp.CountTx()+count > mp.cfg.MaxTx) ||
+		(mp.cfg.MaxBytes > 0 && mp.totalBytes+size > mp.cfg.MaxBytes)
+}
+
+// ensureCapacity makes room in the mempool for count more txs of the given
+// total size, inserted by sender with the given priority, by evicting the
+// lowest priority txs of other senders along with their txs of higher nonces.
+// It returns ErrMempoolTxMaxCapacity, without evicting any tx, if room can only
+// be made by evicting txs of sender or txs with a priority not lower than the
+// given priority.
+func (mp *PriorityNonceMempool[C]) ensureCapacity(sender string, priority C, count int, size int64) error {
+	var (
+		evicted      = make(map[txMeta[C]]bool)
+		chains       []txMeta[C]
+		evictedCount int
+		evictedBytes int64
+	)
+
+	for e := mp.priorityIndex.Back(); mp.isFull(count-evictedCount, size-evictedBytes); e = e.Prev() {
+		if e == nil {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		lowest := e.Key().(txMeta[C])
+		if evicted[lowest.senderNonce()] {
+			continue
+		}
+		if lowest.sender == sender || mp.cfg.TxPriority.Compare(lowest.priority, priority) >= 0 {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		chains = append(chains, lowest)
+		for se := mp.senderIndices[lowest.sender].Get(lowest.senderNonce()); se != nil; se = se.Next() {
+			sk := se.Key().(txMeta[C]).senderNonce()
+			if evicted[sk] {
+				break
+			}
+
+			evicted[sk] = true
+			evictedCount++
+			evictedBytes += mp.scores[sk].size
+		}
+	}
+
+	for _, c := range chains {
+		mp.evictSenderTxs(c.senderNonce())
+	}
+
+	return nil
+}
+
+// evictSenderTxs removes the tx of the given sender and nonce from the mempool,
+// along with the txs of that sender with a higher nonce, which cannot be
+// executed without it.
+func (mp *PriorityNonceMempool[C]) evictSenderTxs(sk txMeta[C]) {
+	var keys []txMeta[C]
+	for e := mp.senderIndices[sk.sender].Get(sk); e != nil; e = e.Next() {
+		keys = append(keys, e.Key().(txMeta[C]).senderNonce())
+	}
+
+	for _, k := range keys {
+		if err := mp.remove(k); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -317,7 +480,7 @@ func (i *PriorityNonceIterator[C]) Next() Iterator {
 	} else if i.mempool.cfg.TxPriority.Compare(key.priority, i.nextPriority) == 0 {
 		// Weight is incorporated into the priority index key only (not sender index)
 		// so we must fetch it here from the scores map.
-		weight := i.mempool.scores[txMeta[C]{nonce: key.nonce, sender: key.sender}].weight
+		weight := i.mempool.scores[key.senderNonce()].weight
 		if i.mempool.cfg.TxPriority.Compare(weight, i.priorityNode.Next().Key().(txMeta[C]).weight) < 0 {
 			return i.iteratePriority()
 		}
@@ -378,9 +541,9 @@ func (mp *PriorityNonceMempool[C]) reorderPriorityTies() {
 
 	for _, k := range reordering {
 		mp.priorityIndex.Remove(k.deleteKey)
-		delete(mp.scores, txMeta[C]{nonce: k.deleteKey.nonce, sender: k.deleteKey.sender})
+		delete(mp.scores, k.deleteKey.senderNonce())
 		mp.priorityIndex.Set(k.insertKey, k.tx)
-		mp.scores[txMeta[C]{nonce: k.insertKey.nonce, sender: k.insertKey.sender}] = k.insertKey
+		mp.scores[k.insertKey.senderNonce()] = k.insertKey
 	}
 }
 
@@ -425,24 +588,33 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, bodyHash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
+
+	return mp.remove(txMeta[C]{nonce: nonce, bodyHash: bodyHash, sender: sender})
+}
 
-	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
+// remove removes the tx of the given sender and nonce from the mempool.
+func (mp *PriorityNonceMempool[C]) remove(scoreKey txMeta[C]) error {
 	score, ok := mp.scores[scoreKey]
 	if !ok {
 		return ErrTxNotFound
 	}
-	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
+	tk := scoreKey
+	tk.priority, tk.weight = score.priority, score.weight
 
-	senderTxs, ok := mp.senderIndices[sender]
+	senderTxs, ok := mp.senderIndices[scoreKey.sender]
 	if !ok {
-		return fmt.Errorf("sender %s not found", sender)
+		return fmt.Errorf("sender %s not found", scoreKey.sender)
 	}
 
 	mp.priorityIndex.Remove(tk)
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.totalBytes -= score.size
 
 	return nil
 }
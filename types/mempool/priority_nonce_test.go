@@ -659,25 +659,34 @@ func TestNextSenderTx_TxLimit(t *testing.T) {
 		require.Equal(t, i+1, mp.CountTx())
 	}
 
-	// limit: 3
+	// limit: 3, the lowest priority tx of another sender and its sender's txs
+	// of higher nonces are evicted for a tx of higher priority
 	mp = mempool.NewPriorityMempool(
 		mempool.PriorityNonceMempoolConfig[int64]{
 			TxPriority: mempool.NewDefaultTxPriority(),
 			MaxTx:      3,
 		},
 	)
+	expCounts := []int{1, 2, 3, 3, 3, 3, 3, 3, 1, 2}
+	expFull := map[int]bool{
+		4: true, // the lowest priority tx is of the same sender
+		5: true, // the lowest priority tx has a higher priority
+		6: true, // the lowest priority tx is of the same sender
+	}
 	for i, tx := range txs {
 		c := ctx.WithPriority(tx.priority)
 		err := mp.Insert(c, tx)
-		if i < 3 {
-			require.NoError(t, err)
-			require.Equal(t, i+1, mp.CountTx())
-		} else {
+		if expFull[i] {
 			require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
-			require.Equal(t, 3, mp.CountTx())
+		} else {
+			require.NoError(t, err)
 		}
+		require.Equal(t, expCounts[i], mp.CountTx(), "tx %d", i)
 	}
 
+	// only the txs inserted last remain
+	require.Equal(t, []sdk.Tx{txs[8], txs[9]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
 	// disabled
 	mp = mempool.NewPriorityMempool(
 		mempool.PriorityNonceMempoolConfig[int64]{
@@ -749,3 +758,145 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestNextSenderTx_MaxBytes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxBytes:   100,
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 1, address: sa},
+		{id: 1, priority: 30, nonce: 2, address: sa},
+		{id: 2, priority: 20, nonce: 1, address: sb},
+	}
+	insert := func(tx testTx, size int) error {
+		c := ctx.WithPriority(tx.priority).WithTxBytes(make([]byte, size))
+		return mp.Insert(c, tx)
+	}
+
+	require.NoError(t, insert(txs[0], 40))
+	require.NoError(t, insert(txs[1], 40))
+
+	// the 40 bytes of the lowest priority tx of sa and of its next tx are evicted
+	require.NoError(t, insert(txs[2], 30))
+	require.Equal(t, []sdk.Tx{txs[2]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	// a tx larger than the mempool cannot be inserted
+	require.ErrorIs(t, insert(testTx{id: 3, priority: 100, nonce: 1, address: sa}, 101), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 1, mp.CountTx())
+
+	// a replacement only needs room for its additional bytes
+	require.NoError(t, insert(testTx{id: 4, priority: 20, nonce: 1, address: sb}, 100))
+	require.ErrorIs(t, insert(testTx{id: 5, priority: 20, nonce: 2, address: sb}, 1), mempool.ErrMempoolTxMaxCapacity)
+
+	// removing a tx frees its bytes
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, insert(txs[0], 100))
+}
+
+func TestNextSenderTx_ReplacementFeeBump(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:         mempool.NewDefaultTxPriority(),
+			ReplacementFeeBump: 10,
+		},
+	)
+
+	fee := func(amounts ...int64) sdk.Coins {
+		coins := sdk.NewCoins()
+		for i, a := range amounts {
+			coins = coins.Add(sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), a))
+		}
+		return coins
+	}
+
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, nonce: 1, address: sa, fee: fee(100, 15)}))
+
+	// the fee must be 10% higher in every denom, rounded up
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 1, nonce: 1, address: sa, fee: fee(109, 17)}), mempool.ErrTxReplacementFeeTooLow)
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 2, nonce: 1, address: sa, fee: fee(110, 16)}), mempool.ErrTxReplacementFeeTooLow)
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 3, nonce: 1, address: sa, fee: fee(200)}), mempool.ErrTxReplacementFeeTooLow)
+
+	replacement := testTx{id: 4, nonce: 1, address: sa, fee: fee(110, 17)}
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, replacement, mp.NextSenderTx(sa.String()))
+
+	// other nonces are not replacements
+	require.NoError(t, mp.Insert(ctx, testTx{id: 5, nonce: 2, address: sa}))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	now := time.Unix(10000, 0)
+
+	// unordered txs all have a zero sequence, they are told apart and ordered
+	// by their timeout timestamp instead.
+	txs := []testTx{
+		{id: 0, priority: 20, address: sa, unordered: true, timeout: now.Add(2 * time.Second)},
+		{id: 1, priority: 20, address: sa, unordered: true, timeout: now.Add(time.Second)},
+		{id: 2, priority: 20, address: sa, unordered: true, timeout: now.Add(3 * time.Second)},
+	}
+
+	mp := mempool.DefaultPriorityMempool()
+	for i, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+		require.Equal(t, i+1, mp.CountTx())
+	}
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{txs[1], txs[0], txs[2]}, orderedTxs)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 2, mp.CountTx())
+
+	invalid := testTx{priority: 20, address: sa, unordered: true, timeout: time.Unix(-1, 0)}
+	require.Error(t, mp.Insert(ctx.WithPriority(invalid.priority), invalid))
+}
+
+func TestPriorityNonceMempool_UnorderedTxsSameTimeout(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	timeout := time.Unix(10000, 0)
+
+	// distinct unordered txs with the same timeout are told apart by their body,
+	// neither replacing the other
+	txs := []testTx{
+		{id: 0, priority: 20, address: sa, unordered: true, timeout: timeout},
+		{id: 1, priority: 10, address: sa, unordered: true, timeout: timeout},
+	}
+
+	mp := mempool.DefaultPriorityMempool()
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+		require.Equal(t, i+1, mp.CountTx())
+	}
+	require.ElementsMatch(t, []sdk.Tx{txs[0], txs[1]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	// inserting the same tx again is still a no-op
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]))
+	require.Equal(t, 2, mp.CountTx())
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, []sdk.Tx{txs[1]}, fetchTxs(mp.Select(ctx, nil), 1000))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
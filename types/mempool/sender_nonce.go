@@ -40,8 +40,9 @@ type SenderNonceMempool struct {
 type SenderNonceOptions func(*SenderNonceMempool)
 
 type txKey struct {
-	address string
-	nonce   uint64
+	address  string
+	nonce    uint64
+	bodyHash string
 }
 
 // NewSenderNonceMempool creates a new mempool that prioritizes transactions by
@@ -133,17 +134,22 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, bodyHash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
-		senderTxs = skiplist.New(skiplist.Uint64)
+		senderTxs = skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA, keyB := a.(txKey), b.(txKey)
+			return compareNonces(keyA.nonce, keyA.bodyHash, keyB.nonce, keyB.bodyHash)
+		}))
 		snm.senders[sender] = senderTxs
 	}
 
-	senderTxs.Set(nonce, tx)
-
-	key := txKey{nonce: nonce, address: sender}
+	key := txKey{nonce: nonce, address: sender, bodyHash: bodyHash}
+	senderTxs.Set(key, tx)
 	snm.existingTx[key] = true
 
 	return nil
@@ -200,14 +206,18 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, bodyHash, err := txNonce(tx, sig)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
 		return ErrTxNotFound
 	}
 
-	res := senderTxs.Remove(nonce)
+	key := txKey{nonce: nonce, address: sender, bodyHash: bodyHash}
+	res := senderTxs.Remove(key)
 	if res == nil {
 		return ErrTxNotFound
 	}
@@ -216,7 +226,6 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 		delete(snm.senders, sender)
 	}
 
-	key := txKey{nonce: nonce, address: sender}
 	delete(snm.existingTx, key)
 
 	return nil
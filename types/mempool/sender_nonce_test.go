@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
@@ -193,3 +194,44 @@ func (s *MempoolTestSuite) TestTxNotFoundOnSender() {
 	err = mp.Remove(tx)
 	require.Equal(t, mempool.ErrTxNotFound, err)
 }
+
+func (s *MempoolTestSuite) TestUnorderedTxsSameTimeout() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool()
+	timeout := time.Unix(10000, 0)
+
+	// distinct unordered txs with the same timeout are told apart by their body
+	txs := []testTx{
+		{id: 0, address: accounts[0].Address, unordered: true, timeout: timeout},
+		{id: 1, address: accounts[0].Address, unordered: true, timeout: timeout},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 2, mp.CountTx())
+	require.ElementsMatch(t, []sdk.Tx{txs[0], txs[1]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, []sdk.Tx{txs[0]}, fetchTxs(mp.Select(ctx, nil), 1000))
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(txs[1]))
+}
+
+func (s *MempoolTestSuite) TestOrderedTxsSameBody() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool()
+
+	// ordered txs are only keyed by their nonce, whatever their body
+	txs := []testTx{
+		{id: 0, nonce: 0, address: accounts[0].Address},
+		{id: 0, nonce: 1, address: accounts[0].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{txs[0], txs[1]}, fetchTxs(mp.Select(ctx, nil), 1000))
+}
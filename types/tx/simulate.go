@@ -0,0 +1,15 @@
+package tx
+
+// SimulateOptions defines the options of a tx simulation, beyond the tx
+// itself, as set by a SimulateRequest.
+type SimulateOptions struct {
+	// Height is the height of the state the tx is simulated on. If 0, the tx
+	// is simulated on the check state.
+	Height int64
+	// StateOverrides are applied, in order, to the state before simulating
+	// the tx.
+	StateOverrides []StateOverride
+	// Trace requests the trace of the store operations and events of the
+	// simulation.
+	Trace bool
+}
@@ -14,15 +14,19 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -365,6 +369,21 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction signers
+	// intend for the transaction to be executed without regard to their
+	// account sequences. The sequences are neither checked nor incremented,
+	// and the signer infos must set them to 0. Replays are instead prevented
+	// by recording the hash of the transaction body until timeout_timestamp,
+	// which must then be set.
+	//
+	// Since: cosmos-sdk 0.50
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain. It is required for unordered transactions, and
+	// must then be shortly after the current block time.
+	//
+	// Since: cosmos-sdk 0.50
+	TimeoutTimestamp *time.Time `protobuf:"bytes,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -429,6 +448,20 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -595,7 +628,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1021,73 +1053,76 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0xfd, 0xe5, 0x38, 0xaa, 0x9b, 0xbf,
-	0xab, 0x82, 0x55, 0x29, 0xbb, 0x6d, 0x7a, 0xa0, 0x20, 0x04, 0xd8, 0x0d, 0x51, 0xaa, 0x52, 0x90,
-	0x36, 0x39, 0xf5, 0xb2, 0x1a, 0xaf, 0x27, 0xeb, 0x51, 0xbd, 0x33, 0xcb, 0xce, 0x2c, 0x78, 0x8f,
-	0x7c, 0x00, 0xa4, 0x0a, 0x09, 0x21, 0x71, 0xe2, 0x88, 0x38, 0x55, 0x88, 0x0f, 0xd1, 0x13, 0xaa,
-	0x38, 0x71, 0x82, 0x2a, 0x39, 0xf4, 0xc6, 0x57, 0x00, 0xcd, 0xec, 0xec, 0x26, 0x2d, 0xa9, 0x0d,
-	0x02, 0x89, 0x8b, 0x3d, 0xf3, 0xf6, 0xf7, 0xde, 0xfc, 0xe6, 0xbd, 0xdf, 0xbc, 0x07, 0x9d, 0x80,
-	0x8b, 0x88, 0x0b, 0x57, 0xce, 0xdc, 0x4f, 0x6e, 0x8e, 0x88, 0xc4, 0x37, 0x5d, 0x39, 0x73, 0xe2,
-	0x84, 0x4b, 0x8e, 0xd6, 0xf2, 0x6f, 0x8e, 0x9c, 0x39, 0xe6, 0x5b, 0x67, 0x0d, 0x47, 0x94, 0x71,
-	0x57, 0xff, 0xe6, 0xa8, 0xce, 0x7a, 0xc8, 0x43, 0xae, 0x97, 0xae, 0x5a, 0x19, 0xeb, 0xb6, 0x89,
-	0x1b, 0x24, 0x59, 0x2c, 0xb9, 0x1b, 0xa5, 0x53, 0x49, 0x05, 0x0d, 0xcb, 0x43, 0x0a, 0x83, 0x81,
-	0x77, 0x0d, 0x7c, 0x84, 0x05, 0x29, 0x31, 0x01, 0xa7, 0xcc, 0x7c, 0x7f, 0xfd, 0x94, 0xa6, 0xa0,
-	0x21, 0xa3, 0xec, 0x34, 0x92, 0xd9, 0x1b, 0xe0, 0x46, 0xc8, 0x79, 0x38, 0x25, 0xae, 0xde, 0x8d,
-	0xd2, 0x23, 0x17, 0xb3, 0xac, 0xf8, 0x94, 0xc7, 0xf0, 0x73, 0xae, 0xe6, 0x6e, 0x7a, 0xd3, 0xfb,
-	0xdc, 0x82, 0xea, 0xe1, 0x0c, 0x6d, 0x43, 0x6d, 0xc4, 0xc7, 0x59, 0xdb, 0xda, 0xb2, 0xfa, 0x17,
-	0x76, 0x36, 0x9c, 0x3f, 0xdd, 0xdf, 0x39, 0x9c, 0x0d, 0xf9, 0x38, 0xf3, 0x34, 0x0c, 0xdd, 0x86,
-	0x16, 0x4e, 0xe5, 0xc4, 0xa7, 0xec, 0x88, 0xb7, 0xab, 0xda, 0x67, 0xf3, 0x1c, 0x9f, 0x41, 0x2a,
-	0x27, 0x77, 0xd9, 0x11, 0xf7, 0x9a, 0xd8, 0xac, 0x50, 0x17, 0x40, 0xd1, 0xc6, 0x32, 0x4d, 0x88,
-	0x68, 0xdb, 0x5b, 0x76, 0x7f, 0xd9, 0x3b, 0x63, 0xe9, 0x31, 0xa8, 0x1f, 0xce, 0x3c, 0xfc, 0x29,
-	0xba, 0x0c, 0xa0, 0x8e, 0xf2, 0x47, 0x99, 0x24, 0x42, 0xf3, 0x5a, 0xf6, 0x5a, 0xca, 0x32, 0x54,
-	0x06, 0xf4, 0x1a, 0x5c, 0x2a, 0x19, 0x18, 0x4c, 0x55, 0x63, 0x56, 0x8a, 0xa3, 0x72, 0xdc, 0xa2,
-	0xf3, 0xbe, 0xb0, 0x60, 0xe9, 0x80, 0x86, 0x6c, 0x97, 0x07, 0xff, 0xd6, 0x91, 0x1b, 0xd0, 0x0c,
-	0x26, 0x98, 0x32, 0x9f, 0x8e, 0xdb, 0xf6, 0x96, 0xd5, 0x6f, 0x79, 0x4b, 0x7a, 0x7f, 0x77, 0x8c,
-	0xae, 0xc1, 0x45, 0x1c, 0x04, 0x3c, 0x65, 0xd2, 0x67, 0x69, 0x34, 0x22, 0x49, 0xbb, 0xb6, 0x65,
-	0xf5, 0x6b, 0xde, 0x8a, 0xb1, 0x7e, 0xa8, 0x8d, 0xbd, 0xdf, 0x2c, 0x58, 0x35, 0xa4, 0x76, 0x69,
-	0x42, 0x02, 0x39, 0x48, 0x67, 0x8b, 0xd8, 0xdd, 0x02, 0x88, 0xd3, 0xd1, 0x94, 0x06, 0xfe, 0x43,
-	0x92, 0x99, 0x9a, 0xac, 0x3b, 0xb9, 0x26, 0x9c, 0x42, 0x13, 0xce, 0x80, 0x65, 0x5e, 0x2b, 0xc7,
-	0xdd, 0x23, 0xd9, 0x3f, 0xa7, 0x8a, 0x3a, 0xd0, 0x14, 0xe4, 0xe3, 0x94, 0xb0, 0x80, 0xb4, 0xeb,
-	0x1a, 0x50, 0xee, 0x51, 0x1f, 0x6c, 0x49, 0xe3, 0x76, 0x43, 0x73, 0xf9, 0xdf, 0x79, 0x9a, 0xa2,
-	0xb1, 0xa7, 0x20, 0xbd, 0x2f, 0xab, 0xd0, 0xc8, 0x05, 0x86, 0x6e, 0x40, 0x33, 0x22, 0x42, 0xe0,
-	0x50, 0x5f, 0xd2, 0x7e, 0xe5, 0x2d, 0x4a, 0x14, 0x42, 0x50, 0x8b, 0x48, 0x94, 0xeb, 0xb0, 0xe5,
-	0xe9, 0xb5, 0x62, 0x2f, 0x69, 0x44, 0x78, 0x2a, 0xfd, 0x09, 0xa1, 0xe1, 0x44, 0xea, 0xeb, 0xd5,
-	0xbc, 0x15, 0x63, 0xdd, 0xd7, 0x46, 0x34, 0x84, 0x35, 0x32, 0x93, 0x84, 0x09, 0xca, 0x99, 0xcf,
-	0x63, 0x49, 0x39, 0x13, 0xed, 0xdf, 0x97, 0xe6, 0x1c, 0xbb, 0x5a, 0xe2, 0x3f, 0xca, 0xe1, 0xe8,
-	0x01, 0x74, 0x19, 0x67, 0x7e, 0x90, 0x50, 0x49, 0x03, 0x3c, 0xf5, 0xcf, 0x09, 0x78, 0x69, 0x4e,
-	0xc0, 0x4d, 0xc6, 0xd9, 0x1d, 0xe3, 0xfb, 0xfe, 0x4b, 0xb1, 0x7b, 0xdf, 0x58, 0xd0, 0x2c, 0x1e,
-	0x11, 0x7a, 0x0f, 0x96, 0x95, 0x70, 0x49, 0xa2, 0x15, 0x58, 0x64, 0xe7, 0xf2, 0x39, 0x79, 0x3d,
-	0xd0, 0x30, 0xfd, 0xf2, 0x2e, 0x88, 0x72, 0x2d, 0x54, 0x41, 0x8e, 0x08, 0x31, 0xe2, 0x38, 0xaf,
-	0x20, 0x7b, 0x84, 0x78, 0x0a, 0x52, 0x94, 0xce, 0x5e, 0x5c, 0xba, 0xaf, 0x2c, 0x80, 0xd3, 0xf3,
-	0x5e, 0x92, 0xa1, 0xf5, 0xd7, 0x64, 0x78, 0x1b, 0x5a, 0x11, 0x1f, 0x93, 0x45, 0xed, 0xe4, 0x3e,
-	0x1f, 0x93, 0xbc, 0x9d, 0x44, 0x66, 0xf5, 0x82, 0xfc, 0xec, 0x17, 0xe5, 0xd7, 0x7b, 0x56, 0x85,
-	0x66, 0xe1, 0x82, 0xde, 0x86, 0x86, 0xa0, 0x2c, 0x9c, 0x12, 0xc3, 0xa9, 0x37, 0x27, 0xbe, 0x73,
-	0xa0, 0x91, 0xfb, 0x15, 0xcf, 0xf8, 0xa0, 0x37, 0xa1, 0xae, 0xdb, 0xb6, 0x21, 0xf7, 0xff, 0x79,
-	0xce, 0xf7, 0x15, 0x70, 0xbf, 0xe2, 0xe5, 0x1e, 0x9d, 0x01, 0x34, 0xf2, 0x70, 0xe8, 0x0d, 0xa8,
-	0x29, 0xde, 0x9a, 0xc0, 0xc5, 0x9d, 0xab, 0x67, 0x62, 0x14, 0x8d, 0xfc, 0x6c, 0xfd, 0x54, 0x3c,
-	0x4f, 0x3b, 0x74, 0x1e, 0x59, 0x50, 0xd7, 0x51, 0xd1, 0x3d, 0x68, 0x8e, 0xa8, 0xc4, 0x49, 0x82,
-	0x8b, 0xdc, 0xba, 0x45, 0x98, 0x7c, 0xdc, 0x38, 0xe5, 0x74, 0x29, 0x62, 0xdd, 0xe1, 0x51, 0x8c,
-	0x03, 0x39, 0xa4, 0x72, 0xa0, 0xdc, 0xbc, 0x32, 0x00, 0x7a, 0x0b, 0xa0, 0xcc, 0xba, 0x6a, 0x65,
-	0xf6, 0xa2, 0xb4, 0xb7, 0x8a, 0xb4, 0x8b, 0x61, 0x1d, 0x6c, 0x91, 0x46, 0xbd, 0xcf, 0xaa, 0x60,
-	0xef, 0x11, 0x82, 0x32, 0x68, 0xe0, 0x48, 0x75, 0x05, 0x23, 0xca, 0x72, 0x80, 0xa8, 0xa9, 0x76,
-	0x86, 0x0a, 0x65, 0xc3, 0xbd, 0x27, 0xbf, 0x5c, 0xa9, 0x7c, 0xf7, 0xeb, 0x95, 0x7e, 0x48, 0xe5,
-	0x24, 0x1d, 0x39, 0x01, 0x8f, 0xdc, 0x62, 0x62, 0xea, 0xbf, 0x6d, 0x31, 0x7e, 0xe8, 0xca, 0x2c,
-	0x26, 0x42, 0x3b, 0x88, 0xaf, 0x9f, 0x3f, 0xbe, 0xbe, 0x3c, 0x25, 0x21, 0x0e, 0x32, 0x5f, 0xcd,
-	0x45, 0xf1, 0xed, 0xf3, 0xc7, 0xd7, 0x2d, 0xcf, 0x1c, 0x88, 0x36, 0xa1, 0x15, 0x62, 0xe1, 0x4f,
-	0x69, 0x44, 0xa5, 0x2e, 0x4f, 0xcd, 0x6b, 0x86, 0x58, 0x7c, 0xa0, 0xf6, 0xc8, 0x81, 0x7a, 0x8c,
-	0x33, 0x92, 0xe4, 0xcd, 0x6d, 0xd8, 0xfe, 0xe9, 0x87, 0xed, 0x75, 0xc3, 0x6c, 0x30, 0x1e, 0x27,
-	0x44, 0x88, 0x03, 0x99, 0x50, 0x16, 0x7a, 0x39, 0x0c, 0xed, 0xc0, 0x52, 0x98, 0x60, 0x26, 0x4d,
-	0xb7, 0x9b, 0xe7, 0x51, 0x00, 0x7b, 0xdf, 0x5b, 0x60, 0x1f, 0xd2, 0xf8, 0xbf, 0xcc, 0xc1, 0x0d,
-	0x68, 0x48, 0x1a, 0xc7, 0x24, 0xc9, 0x7b, 0xe0, 0x1c, 0xd6, 0x06, 0xd7, 0xfb, 0xd1, 0x82, 0x95,
-	0x41, 0x3a, 0xcb, 0x1f, 0xee, 0x2e, 0x96, 0x58, 0x5d, 0x1d, 0xe7, 0x50, 0xad, 0xac, 0xb9, 0x57,
-	0x37, 0x40, 0xf4, 0x0e, 0x34, 0x95, 0x74, 0xfd, 0x31, 0x0f, 0xcc, 0xcb, 0xb8, 0xfa, 0x8a, 0x6e,
-	0x74, 0x76, 0x92, 0x79, 0x4b, 0xc2, 0x0c, 0xdc, 0xe2, 0x45, 0xd8, 0x7f, 0xf3, 0x45, 0xa0, 0x55,
-	0xb0, 0x05, 0x0d, 0x75, 0x8d, 0x96, 0x3d, 0xb5, 0x1c, 0xbe, 0xfb, 0xe4, 0xb8, 0x6b, 0x3d, 0x3d,
-	0xee, 0x5a, 0xcf, 0x8e, 0xbb, 0xd6, 0xa3, 0x93, 0x6e, 0xe5, 0xe9, 0x49, 0xb7, 0xf2, 0xf3, 0x49,
-	0xb7, 0xf2, 0xe0, 0xda, 0xe2, 0x24, 0xbb, 0x72, 0x36, 0x6a, 0xe8, 0xe6, 0x74, 0xeb, 0x8f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1f, 0x66, 0x4c, 0xda, 0x17, 0x0a, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x54, 0xa1, 0xad, 0x4b, 0x9d, 0xe0, 0xaa,
+	0x60, 0x55, 0xca, 0x6e, 0x9b, 0x1e, 0x28, 0x08, 0x01, 0x76, 0x4b, 0x95, 0xaa, 0x04, 0xa4, 0x4d,
+	0x4e, 0xbd, 0xac, 0xc6, 0xbb, 0x93, 0xf5, 0xa8, 0xde, 0x99, 0x65, 0x67, 0x16, 0xbc, 0x47, 0xb8,
+	0x23, 0x45, 0x5c, 0x90, 0x38, 0x71, 0x44, 0x9c, 0x2a, 0xc4, 0x8f, 0xe8, 0x09, 0x55, 0x9c, 0x38,
+	0xd1, 0x2a, 0x39, 0xf4, 0xc6, 0x5f, 0x00, 0xed, 0xec, 0xec, 0x26, 0x4d, 0x93, 0x18, 0x04, 0x12,
+	0x17, 0x7b, 0xf6, 0xcd, 0xf7, 0xbe, 0xf9, 0xde, 0xbc, 0x37, 0xef, 0x41, 0xd7, 0xe7, 0x22, 0xe2,
+	0xc2, 0x91, 0x33, 0xe7, 0xf3, 0x9b, 0x63, 0x22, 0xf1, 0x4d, 0x47, 0xce, 0xec, 0x38, 0xe1, 0x92,
+	0xa3, 0x95, 0x62, 0xcf, 0x96, 0x33, 0x5b, 0xef, 0x75, 0x57, 0x70, 0x44, 0x19, 0x77, 0xd4, 0x6f,
+	0x81, 0xea, 0x5e, 0x0c, 0x79, 0xc8, 0xd5, 0xd2, 0xc9, 0x57, 0xda, 0xba, 0xae, 0x79, 0xfd, 0x24,
+	0x8b, 0x25, 0x77, 0xa2, 0x74, 0x2a, 0xa9, 0xa0, 0x61, 0x75, 0x48, 0x69, 0xd0, 0xf0, 0x9e, 0x86,
+	0x8f, 0xb1, 0x20, 0x15, 0xc6, 0xe7, 0x94, 0xe9, 0xfd, 0xb7, 0x0e, 0x65, 0x0a, 0x1a, 0x32, 0xca,
+	0x0e, 0x99, 0xf4, 0xb7, 0x06, 0x5e, 0x0a, 0x39, 0x0f, 0xa7, 0xc4, 0x51, 0x5f, 0xe3, 0x74, 0xd7,
+	0xc1, 0x2c, 0x2b, 0xb7, 0x0a, 0x0e, 0xaf, 0xd0, 0xaa, 0x63, 0x2b, 0xb6, 0x56, 0x8f, 0x7b, 0x49,
+	0x1a, 0x11, 0x21, 0x71, 0x14, 0x17, 0x80, 0xfe, 0xd7, 0x06, 0xd4, 0x77, 0x66, 0x68, 0x1d, 0x1a,
+	0x63, 0x1e, 0x64, 0x96, 0xb1, 0x66, 0x0c, 0xce, 0x6d, 0x5c, 0xb2, 0x5f, 0xb9, 0x20, 0x7b, 0x67,
+	0x36, 0xe2, 0x41, 0xe6, 0x2a, 0x18, 0xba, 0x0d, 0x1d, 0x9c, 0xca, 0x89, 0x47, 0xd9, 0x2e, 0xb7,
+	0xea, 0xca, 0xe7, 0xf2, 0x09, 0x3e, 0xc3, 0x54, 0x4e, 0xee, 0xb3, 0x5d, 0xee, 0xb6, 0xb1, 0x5e,
+	0xa1, 0x1e, 0x40, 0x1e, 0x17, 0x96, 0x69, 0x42, 0x84, 0x65, 0xae, 0x99, 0x83, 0x45, 0xf7, 0x88,
+	0xa5, 0xcf, 0xa0, 0xb9, 0x33, 0x73, 0xf1, 0x17, 0xe8, 0x0a, 0x40, 0x7e, 0x94, 0x37, 0xce, 0x24,
+	0x11, 0x4a, 0xd7, 0xa2, 0xdb, 0xc9, 0x2d, 0xa3, 0xdc, 0x80, 0xde, 0x84, 0x0b, 0x95, 0x02, 0x8d,
+	0xa9, 0x2b, 0xcc, 0x52, 0x79, 0x54, 0x81, 0x9b, 0x77, 0xde, 0x37, 0x06, 0x2c, 0x6c, 0xd3, 0x90,
+	0xdd, 0xe5, 0xfe, 0x7f, 0x75, 0xe4, 0x25, 0x68, 0xfb, 0x13, 0x4c, 0x99, 0x47, 0x03, 0xcb, 0x5c,
+	0x33, 0x06, 0x1d, 0x77, 0x41, 0x7d, 0xdf, 0x0f, 0xd0, 0x35, 0x38, 0x8f, 0x7d, 0x9f, 0xa7, 0x4c,
+	0x7a, 0x2c, 0x8d, 0xc6, 0x24, 0xb1, 0x1a, 0x6b, 0xc6, 0xa0, 0xe1, 0x2e, 0x69, 0xeb, 0x27, 0xca,
+	0xd8, 0xff, 0xc3, 0x80, 0x65, 0x2d, 0xea, 0x2e, 0x4d, 0x88, 0x2f, 0x87, 0xe9, 0x6c, 0x9e, 0xba,
+	0x5b, 0x00, 0x71, 0x3a, 0x9e, 0x52, 0xdf, 0x7b, 0x44, 0x32, 0x9d, 0x93, 0x8b, 0x76, 0x91, 0x7e,
+	0xbb, 0x4c, 0xbf, 0x3d, 0x64, 0x99, 0xdb, 0x29, 0x70, 0x0f, 0x48, 0xf6, 0xef, 0xa5, 0xa2, 0x2e,
+	0xb4, 0x05, 0xf9, 0x2c, 0x25, 0xcc, 0x27, 0x56, 0x53, 0x01, 0xaa, 0x6f, 0x34, 0x00, 0x53, 0xd2,
+	0xd8, 0x6a, 0x29, 0x2d, 0xaf, 0x9d, 0x54, 0x53, 0x34, 0x76, 0x73, 0x48, 0xff, 0x2b, 0x13, 0x5a,
+	0x45, 0x81, 0xa1, 0x1b, 0xd0, 0x8e, 0x88, 0x10, 0x38, 0x54, 0x41, 0x9a, 0xa7, 0x46, 0x51, 0xa1,
+	0x10, 0x82, 0x46, 0x44, 0xa2, 0xa2, 0x0e, 0x3b, 0xae, 0x5a, 0xe7, 0xea, 0xf3, 0x4a, 0xe7, 0xa9,
+	0xf4, 0x26, 0x84, 0x86, 0x13, 0xa9, 0xc2, 0x6b, 0xb8, 0x4b, 0xda, 0xba, 0xa9, 0x8c, 0xe8, 0x75,
+	0xe8, 0xa4, 0x8c, 0x27, 0x01, 0x49, 0x48, 0xa0, 0xe2, 0x6b, 0xbb, 0x87, 0x06, 0xb4, 0x05, 0x2b,
+	0x25, 0x49, 0xf5, 0x6c, 0x54, 0x90, 0xe7, 0x36, 0xba, 0xaf, 0x68, 0xda, 0x29, 0x11, 0xa3, 0xc6,
+	0xde, 0xb3, 0x55, 0xc3, 0x5d, 0xd6, 0xae, 0x95, 0x1d, 0x8d, 0x60, 0x85, 0xcc, 0x24, 0x61, 0x82,
+	0x72, 0xe6, 0xf1, 0x58, 0x52, 0xce, 0x84, 0xf5, 0xe7, 0xc2, 0x19, 0x31, 0x2e, 0x57, 0xf8, 0x4f,
+	0x0b, 0x38, 0x7a, 0x08, 0x3d, 0xc6, 0x99, 0xe7, 0x27, 0x54, 0x52, 0x1f, 0x4f, 0xbd, 0x13, 0x08,
+	0x2f, 0x9c, 0x41, 0x78, 0x99, 0x71, 0x76, 0x47, 0xfb, 0x7e, 0x74, 0x8c, 0xbb, 0xff, 0xbd, 0x01,
+	0xed, 0xf2, 0xc5, 0xa2, 0x0f, 0x61, 0x31, 0x7f, 0x25, 0x24, 0x51, 0xe5, 0x5e, 0xa6, 0xe2, 0xca,
+	0x09, 0x49, 0xdc, 0x56, 0x30, 0xf5, 0xcc, 0xcf, 0x89, 0x6a, 0x2d, 0xf2, 0xec, 0xef, 0x12, 0x62,
+	0xd5, 0x4f, 0xcd, 0xfe, 0x3d, 0x42, 0xdc, 0x1c, 0x52, 0xd6, 0x89, 0x39, 0xbf, 0x4e, 0xbe, 0x35,
+	0x00, 0x0e, 0xcf, 0x3b, 0x56, 0xf3, 0xc6, 0xdf, 0xab, 0xf9, 0xdb, 0xd0, 0x89, 0x78, 0x40, 0xe6,
+	0xf5, 0xae, 0x2d, 0x1e, 0x90, 0xa2, 0x77, 0x45, 0x7a, 0xf5, 0x52, 0xad, 0x9b, 0x2f, 0xd7, 0x7a,
+	0xff, 0x79, 0x1d, 0xda, 0xa5, 0x0b, 0x7a, 0x0f, 0x5a, 0x82, 0xb2, 0x70, 0x4a, 0xb4, 0xa6, 0xfe,
+	0x19, 0xfc, 0xf6, 0xb6, 0x42, 0x6e, 0xd6, 0x5c, 0xed, 0x83, 0xde, 0x81, 0xa6, 0x1a, 0x22, 0x5a,
+	0xdc, 0x1b, 0x67, 0x39, 0x6f, 0xe5, 0xc0, 0xcd, 0x9a, 0x5b, 0x78, 0x74, 0x87, 0xd0, 0x2a, 0xe8,
+	0xd0, 0xdb, 0xd0, 0xc8, 0x75, 0x2b, 0x01, 0xe7, 0x37, 0xae, 0x1e, 0xe1, 0x28, 0xc7, 0xca, 0xd1,
+	0xfc, 0xe5, 0x7c, 0xae, 0x72, 0xe8, 0xee, 0x19, 0xd0, 0x54, 0xac, 0xe8, 0x01, 0xb4, 0xc7, 0x54,
+	0xe2, 0x24, 0xc1, 0xe5, 0xdd, 0x3a, 0x25, 0x4d, 0x31, 0xfc, 0xec, 0x6a, 0xd6, 0x95, 0x5c, 0x77,
+	0x78, 0x14, 0x63, 0x5f, 0x8e, 0xa8, 0x1c, 0xe6, 0x6e, 0x6e, 0x45, 0x80, 0xde, 0x05, 0xa8, 0x6e,
+	0x3d, 0xef, 0x9b, 0xe6, 0xbc, 0x6b, 0xef, 0x94, 0xd7, 0x2e, 0x46, 0x4d, 0x30, 0x45, 0x1a, 0xf5,
+	0xbf, 0xac, 0x83, 0x79, 0x8f, 0x10, 0x94, 0x41, 0x0b, 0x47, 0x79, 0x0b, 0xd2, 0x45, 0x59, 0x4d,
+	0xab, 0x7c, 0xc6, 0x1e, 0x91, 0x42, 0xd9, 0xe8, 0xde, 0x93, 0xdf, 0x57, 0x6b, 0x3f, 0x3e, 0x5b,
+	0x1d, 0x84, 0x54, 0x4e, 0xd2, 0xb1, 0xed, 0xf3, 0xc8, 0x29, 0xe7, 0xb7, 0xfa, 0x5b, 0x17, 0xc1,
+	0x23, 0x47, 0x66, 0x31, 0x11, 0xca, 0x41, 0x7c, 0xf7, 0xe2, 0xf1, 0xf5, 0xc5, 0x29, 0x09, 0xb1,
+	0x9f, 0x79, 0xf9, 0x94, 0x16, 0x3f, 0xbc, 0x78, 0x7c, 0xdd, 0x70, 0xf5, 0x81, 0xe8, 0x32, 0x74,
+	0x42, 0x2c, 0xbc, 0x29, 0x8d, 0xa8, 0x54, 0xe9, 0x69, 0xb8, 0xed, 0x10, 0x8b, 0x8f, 0xf3, 0x6f,
+	0x64, 0x43, 0x33, 0xc6, 0x19, 0x49, 0x8a, 0x4e, 0x3a, 0xb2, 0x7e, 0xfd, 0x79, 0xfd, 0xa2, 0x56,
+	0x36, 0x0c, 0x82, 0x84, 0x08, 0xb1, 0x2d, 0x13, 0xca, 0x42, 0xb7, 0x80, 0xa1, 0x0d, 0x58, 0x08,
+	0x13, 0xcc, 0xa4, 0x6e, 0xad, 0x67, 0x79, 0x94, 0xc0, 0xfe, 0x4f, 0x06, 0x98, 0x3b, 0x34, 0xfe,
+	0x3f, 0xef, 0xe0, 0x06, 0xb4, 0x24, 0x8d, 0x63, 0x92, 0x58, 0xf5, 0x39, 0xaa, 0x35, 0xae, 0xff,
+	0x8b, 0x01, 0x4b, 0xc3, 0x74, 0x56, 0x3c, 0xdc, 0xbb, 0x58, 0xe2, 0x3c, 0x74, 0x5c, 0x40, 0x2d,
+	0x63, 0x0e, 0x49, 0x09, 0x44, 0xef, 0x43, 0x3b, 0x2f, 0x5d, 0x2f, 0xe0, 0xbe, 0x7e, 0x19, 0x57,
+	0x4f, 0xe9, 0x46, 0x47, 0xc7, 0xa6, 0xbb, 0x20, 0x0a, 0x4b, 0xf5, 0x22, 0xcc, 0x7f, 0xf8, 0x22,
+	0xd0, 0x32, 0x98, 0x82, 0x86, 0x2a, 0x47, 0x8b, 0x6e, 0xbe, 0x1c, 0x7d, 0xf0, 0x64, 0xbf, 0x67,
+	0x3c, 0xdd, 0xef, 0x19, 0xcf, 0xf7, 0x7b, 0xc6, 0xde, 0x41, 0xaf, 0xf6, 0xf4, 0xa0, 0x57, 0xfb,
+	0xed, 0xa0, 0x57, 0x7b, 0x78, 0x6d, 0xfe, 0x25, 0x3b, 0x72, 0x36, 0x6e, 0xa9, 0xe6, 0x74, 0xeb,
+	0xaf, 0x01, 0x00, 0xb0, 0xd7, 0x34, 0xca, 0xa5, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1364,6 +1399,26 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.TimeoutTimestamp != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1942,6 +1997,13 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2955,6 +3017,62 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	strings "strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
@@ -78,6 +79,23 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithTimeoutTimeStamp extends the Tx interface by allowing a transaction
+	// to set a timestamp timeout.
+	TxWithTimeoutTimeStamp interface {
+		Tx
+
+		GetTimeoutTimeStamp() time.Time
+	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// unordered, i.e. to be executed without regard to its signers' sequences.
+	// Unordered transactions rely on their timestamp timeout instead.
+	TxWithUnordered interface {
+		TxWithTimeoutTimeStamp
+
+		GetUnordered() bool
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
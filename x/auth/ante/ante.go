@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -20,6 +22,10 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// MaxUnorderedTxTimeoutDuration defines the maximum duration between the
+	// block time and the timeout timestamp of unordered txs. It defaults to
+	// DefaultMaxUnorderedTxTimeoutDuration if zero.
+	MaxUnorderedTxTimeoutDuration time.Duration
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -38,6 +44,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	incrementSequenceDecorator := NewIncrementSequenceDecorator(options.AccountKeeper)
+	if options.MaxUnorderedTxTimeoutDuration != 0 {
+		incrementSequenceDecorator = incrementSequenceDecorator.WithMaxUnorderedTimeoutDuration(options.MaxUnorderedTxTimeoutDuration)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -50,7 +61,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		NewIncrementSequenceDecorator(options.AccountKeeper),
+		incrementSequenceDecorator,
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
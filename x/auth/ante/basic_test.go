@@ -3,6 +3,7 @@ package ante_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -226,3 +227,52 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestTxTimeoutTimestampDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	blockTime := time.Unix(10000, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		timeout     time.Time
+		expectedErr error
+	}{
+		{"default value", time.Time{}, nil},
+		{"no timeout (later time)", blockTime.Add(time.Second), nil},
+		{"no timeout (same time)", blockTime, nil},
+		{"timeout (earlier time)", blockTime.Add(-time.Second), sdkerrors.ErrTxTimeout},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msg))
+
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeout)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			ctx := suite.ctx.WithBlockTime(blockTime)
+			_, err = antehandler(ctx, tx, true)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
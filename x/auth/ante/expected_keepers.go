@@ -2,6 +2,7 @@ package ante
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 
@@ -19,6 +20,21 @@ type AccountKeeper interface {
 	AddressCodec() address.Codec
 }
 
+// UnorderedTxKeeper defines the contract needed by the IncrementSequenceDecorator
+// to protect unordered txs against replays. It is optionally implemented by the
+// AccountKeeper.
+type UnorderedTxKeeper interface {
+	HasUnorderedTx(ctx context.Context, txHash []byte, timeout time.Time) (bool, error)
+	AddUnorderedTx(ctx context.Context, txHash []byte, timeout time.Time) error
+}
+
+// AuthenticatorKeeper defines the contract needed by the SigVerificationDecorator
+// to authenticate abstract accounts. It is optionally implemented by the
+// AccountKeeper.
+type AuthenticatorKeeper interface {
+	GetAuthenticator(name string) (types.Authenticator, bool)
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -15,6 +15,66 @@ import (
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// DeductedFee is the fee of a tx deducted by the DeductFeeDecorator, which
+// records it in the context passed to the next decorators, and then to the
+// post handler, see DeductedFeeFromContext.
+type DeductedFee struct {
+	// Amount is the deducted fee.
+	Amount sdk.Coins
+	// Payer is the fee payer of the tx.
+	Payer sdk.AccAddress
+	// Granter is the fee granter of the tx if the fee was deducted from its
+	// account through a fee allowance granted to the payer, nil otherwise.
+	Granter sdk.AccAddress
+	// Burned is the part of the fee burned by the next decorators, e.g. the
+	// base fee burn of a fee market, which cannot be refunded, see
+	// WithBurnedFee.
+	Burned sdk.Coins
+}
+
+// DeductedFrom returns the address of the account the fee was deducted from.
+func (f DeductedFee) DeductedFrom() sdk.AccAddress {
+	if f.Granter != nil {
+		return f.Granter
+	}
+
+	return f.Payer
+}
+
+// Refundable returns the part of the fee which was not burned.
+func (f DeductedFee) Refundable() sdk.Coins {
+	refundable := sdk.NewCoins()
+	for _, c := range f.Amount {
+		if amount := c.Amount.Sub(f.Burned.AmountOf(c.Denom)); amount.IsPositive() {
+			refundable = refundable.Add(sdk.NewCoin(c.Denom, amount))
+		}
+	}
+
+	return refundable
+}
+
+type deductedFeeKey struct{}
+
+// DeductedFeeFromContext returns the fee deducted by the DeductFeeDecorator,
+// if it was run with the context or one of its parents.
+func DeductedFeeFromContext(ctx sdk.Context) (DeductedFee, bool) {
+	fee, ok := ctx.Value(deductedFeeKey{}).(DeductedFee)
+	return fee, ok
+}
+
+// WithBurnedFee records in the returned context that the given part of the fee
+// deducted by the DeductFeeDecorator was burned, so that it is not refunded.
+// The context is returned unchanged if no fee was deducted.
+func WithBurnedFee(ctx sdk.Context, burned sdk.Coins) sdk.Context {
+	fee, ok := DeductedFeeFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	fee.Burned = fee.Burned.Add(burned...)
+	return ctx.WithValue(deductedFeeKey{}, fee)
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -28,7 +88,7 @@ type DeductFeeDecorator struct {
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = NewFeeDenomRatesTxFeeChecker(ak)
 	}
 
 	return DeductFeeDecorator{
@@ -61,28 +121,30 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	deducted, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := ctx.WithPriority(priority).WithValue(deductedFeeKey{}, deducted)
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (DeductedFee, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return DeductedFee{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+		return DeductedFee{}, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
 	}
 
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 	deductFeesFrom := feePayer
+	deducted := DeductedFee{Amount: fee, Payer: feePayer}
 
 	// if feegranter set deduct fee from feegranter account.
 	// this works with only when feegrant enabled.
@@ -90,12 +152,13 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return DeductedFee{}, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return DeductedFee{}, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
+			deducted.Granter = feeGranterAddr
 		}
 
 		deductFeesFrom = feeGranterAddr
@@ -103,14 +166,14 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return DeductedFee{}, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !fee.IsZero() {
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
-			return err
+			return DeductedFee{}, err
 		}
 	}
 
@@ -123,7 +186,7 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return deducted, nil
 }
 
 // DeductFees deducts fees from the given account.
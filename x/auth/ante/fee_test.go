@@ -143,3 +143,46 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestEnsureMempoolFees_FeeDenomRates(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	params := authtypes.DefaultParams()
+	params.NativeFeeDenom = "atom"
+	params.FeeDenomRates = []authtypes.FeeDenomRate{{Denom: "usdc", Rate: math.LegacyNewDec(2)}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+
+	// 100usdc are worth 200atom
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("usdc", 100))
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(10)
+
+	// the fees are collected in the denom they are paid in
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(1)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	s.ctx = s.ctx.WithIsCheckTx(true)
+
+	// 200atom for 10 gas is below a gas price of 30atom
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(30))))
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// 200atom for 10 gas meets a gas price of 20atom
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(20))))
+	newCtx, err := antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	// the priority is computed from the converted fees
+	require.Equal(t, int64(20), newCtx.Priority())
+}
@@ -2,12 +2,15 @@ package ante
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -33,6 +36,12 @@ var (
 	simSecp256k1Sig    [64]byte
 )
 
+// DefaultMaxUnorderedTxTimeoutDuration defines the default maximum duration
+// between the block time and the timeout timestamp of unordered transactions
+// accepted by the IncrementSequenceDecorator. It bounds the number of unordered
+// transactions recorded in state to prevent their replay.
+const DefaultMaxUnorderedTxTimeoutDuration = 10 * time.Minute
+
 func init() {
 	// This decodes a valid hex string into a sepc256k1Pubkey for use in transaction simulation
 	bz, _ := hex.DecodeString("035AD6810A47F073553FF30D2FCC7E0D3B1C0B74B61A1AAA2582344037151E143A")
@@ -89,20 +98,31 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		// abstract accounts are not authenticated by public keys
+		if _, ok := acc.(types.AbstractAccountI); ok {
 			continue
 		}
+
+		// account already has pubkey set, no need to reset. The pubkey may not
+		// match the signer address if it has been rotated.
+		if accPk := acc.GetPubKey(); accPk != nil {
+			if !simulate && !accPk.Equals(pk) {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signerStrs[i], i)
+			}
+			continue
+		}
+
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -185,6 +205,11 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if err != nil {
 			return ctx, err
 		}
+		// the authenticators of abstract accounts consume the gas of their
+		// authentication instead
+		if _, ok := signerAcc.(types.AbstractAccountI); ok {
+			continue
+		}
 
 		pubKey := signerAcc.GetPubKey()
 
@@ -273,23 +298,40 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
 	}
 
+	unordered := isUnordered(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
 
-		// retrieve pubkey
+		// retrieve pubkey, abstract accounts are authenticated by their
+		// authenticator instead
+		abstractAcc, isAbstract := acc.(types.AbstractAccountI)
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && pubKey == nil && !isAbstract {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number. Unordered txs are signed over a
+		// sequence of 0 instead, and protected against replays by the
+		// IncrementSequenceDecorator.
+		accSeq := acc.GetSequence()
+		if unordered {
+			accSeq = 0
+
+			// SIGN_MODE_LEGACY_AMINO_JSON does not sign over the unordered
+			// and timeout timestamp fields, which could then be altered to
+			// replay the tx.
+			if anyLegacyAminoSigner(sig.Data) {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrNotSupported, "unordered txs cannot be signed with %s", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+		}
+		if sig.Sequence != accSeq {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
-				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+				"account sequence mismatch, expected %d, got %d", accSeq, sig.Sequence,
 			)
 		}
 
@@ -301,6 +343,23 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			accNum = acc.GetAccountNumber()
 		}
 
+		// authenticators are also run in simulation mode to consume the gas
+		// of the authentication
+		if isAbstract {
+			if !ctx.IsReCheckTx() {
+				signerData := txsigning.SignerData{
+					Address:       acc.GetAddress().String(),
+					ChainID:       chainID,
+					AccountNumber: accNum,
+					Sequence:      accSeq,
+				}
+				if err := svd.authenticate(ctx, tx, abstractAcc, signerData, sig, simulate); err != nil {
+					return ctx, err
+				}
+			}
+			continue
+		}
+
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
 			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
@@ -309,7 +368,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				Address:       acc.GetAddress().String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
+				Sequence:      accSeq,
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -326,7 +385,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, accSeq, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 				}
@@ -339,31 +398,105 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticate authenticates the signature of an abstract account with the
+// authenticator it delegates its authentication to. This requires the
+// AccountKeeper to implement AuthenticatorKeeper.
+func (svd SigVerificationDecorator) authenticate(
+	ctx sdk.Context, tx sdk.Tx, acc types.AbstractAccountI, signerData txsigning.SignerData, sig signing.SignatureV2, simulate bool,
+) error {
+	ak, ok := svd.ak.(AuthenticatorKeeper)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrNotSupported, "abstract accounts are not supported")
+	}
+
+	authenticator, ok := ak.GetAuthenticator(acc.GetAuthenticator())
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator %s of account %s is not registered", acc.GetAuthenticator(), signerData.Address)
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "abstract account %s can only sign with single signatures", signerData.Address)
+	}
+
+	// simulated txs are usually not signed with a valid sign mode
+	var signBytes []byte
+	if !simulate {
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+
+		var err error
+		signBytes, err = svd.signModeHandler.GetSignBytes(ctx, signingv1beta1.SignMode(data.SignMode), signerData, adaptableTx.GetSigningTxData())
+		if err != nil {
+			return err
+		}
+	}
+
+	err := authenticator.Authenticate(ctx, types.AuthenticationRequest{
+		Account:   acc,
+		Tx:        tx,
+		SignMode:  data.SignMode,
+		SignBytes: signBytes,
+		Signature: data.Signature,
+		Simulate:  simulate,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authentication of account %s failed: %s", signerData.Address, err)
+	}
+
+	return nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
 // BaseApp.Commit() will set the check state based on the latest header.
 //
+// Unordered txs do not increment sequences. Instead, the hash of their body is
+// recorded until their timeout timestamp, and txs whose body hash is already
+// recorded are rejected. This requires the AccountKeeper to implement
+// UnorderedTxKeeper, otherwise unordered txs are rejected.
+//
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
-// client. It is recommended to instead use multiple messages in a tx.
+// client. It is recommended to instead use multiple messages in a tx, or
+// unordered txs.
 type IncrementSequenceDecorator struct {
-	ak AccountKeeper
+	ak                          AccountKeeper
+	maxUnorderedTimeoutDuration time.Duration
 }
 
 func NewIncrementSequenceDecorator(ak AccountKeeper) IncrementSequenceDecorator {
 	return IncrementSequenceDecorator{
-		ak: ak,
+		ak:                          ak,
+		maxUnorderedTimeoutDuration: DefaultMaxUnorderedTxTimeoutDuration,
 	}
 }
 
+// WithMaxUnorderedTimeoutDuration returns a copy of the decorator accepting
+// unordered txs whose timeout timestamp is at most d after the block time.
+func (isd IncrementSequenceDecorator) WithMaxUnorderedTimeoutDuration(d time.Duration) IncrementSequenceDecorator {
+	isd.maxUnorderedTimeoutDuration = d
+	return isd
+}
+
 func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if isUnordered(tx) {
+		if err := isd.recordUnorderedTx(ctx, tx); err != nil {
+			return ctx, err
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	signers, err := sigTx.GetSigners()
 	if err != nil {
@@ -382,6 +515,71 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	return next(ctx, tx, simulate)
 }
 
+// recordUnorderedTx records the hash of the body of an unordered tx until its
+// timeout timestamp, failing if it is already recorded.
+func (isd IncrementSequenceDecorator) recordUnorderedTx(ctx sdk.Context, tx sdk.Tx) error {
+	utk, ok := isd.ak.(UnorderedTxKeeper)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not supported")
+	}
+
+	timeout := tx.(sdk.TxWithUnordered).GetTimeoutTimeStamp()
+	blockTime := ctx.BlockTime()
+	switch {
+	case timeout.IsZero():
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout timestamp")
+	case blockTime.After(timeout):
+		return errorsmod.Wrapf(sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeout)
+	case timeout.Sub(blockTime) > isd.maxUnorderedTimeoutDuration:
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered tx timeout timestamp %s is more than %s after block time %s", timeout, isd.maxUnorderedTimeoutDuration, blockTime,
+		)
+	}
+
+	// the body of a tx can't be altered without invalidating its signatures,
+	// unlike the whole tx which could be signed again
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	txHash := sha256.Sum256(adaptableTx.GetSigningTxData().BodyBytes)
+
+	has, err := utk.HasUnorderedTx(ctx, txHash[:], timeout)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx with body hash %X has already been executed", txHash)
+	}
+
+	return utk.AddUnorderedTx(ctx, txHash[:], timeout)
+}
+
+// isUnordered returns whether tx is an unordered tx.
+func isUnordered(tx sdk.Tx) bool {
+	utx, ok := tx.(sdk.TxWithUnordered)
+	return ok && utx.GetUnordered()
+}
+
+// anyLegacyAminoSigner checks SignatureData to see if any signer is using
+// SIGN_MODE_LEGACY_AMINO_JSON.
+func anyLegacyAminoSigner(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if anyLegacyAminoSigner(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
 // ValidateSigCountDecorator takes in Params and returns errors if there are too many signatures in the tx for the given params
 // otherwise it calls next AnteHandler
 // Use this decorator to set parameterized limit on number of signatures in tx
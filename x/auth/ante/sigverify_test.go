@@ -1,8 +1,10 @@
 package ante_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -359,3 +362,182 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func TestUnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetSequence(5))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	isd := ante.NewIncrementSequenceDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd, isd)
+
+	blockTime := time.Unix(10000, 0).UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	buildTx := func(timeout time.Time, seq uint64, signMode signing.SignMode) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		// the amino JSON sign mode handler cannot sign the unordered fields,
+		// so they are only set after signing for that sign mode.
+		if signMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			suite.txBuilder.SetTimeoutTimestamp(timeout)
+			suite.txBuilder.SetUnordered(true)
+		}
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}
+		_, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signMode)
+		require.NoError(t, err)
+		suite.txBuilder.SetTimeoutTimestamp(timeout)
+		suite.txBuilder.SetUnordered(true)
+		return suite.txBuilder.GetTx()
+	}
+
+	timeout := blockTime.Add(time.Minute)
+
+	testCases := []struct {
+		name        string
+		tx          sdk.Tx
+		expectedErr error
+	}{
+		{"valid unordered tx", buildTx(timeout, 0, signing.SignMode_SIGN_MODE_DIRECT), nil},
+		{"duplicate unordered tx", buildTx(timeout, 0, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrInvalidRequest},
+		{"same body with another timeout", buildTx(timeout.Add(time.Second), 0, signing.SignMode_SIGN_MODE_DIRECT), nil},
+		{"non-zero sequence", buildTx(timeout.Add(2*time.Second), 5, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrWrongSequence},
+		{"missing timeout", buildTx(time.Time{}, 0, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrInvalidRequest},
+		{"expired timeout", buildTx(blockTime.Add(-time.Second), 0, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrTxTimeout},
+		{"timeout too far in the future", buildTx(blockTime.Add(ante.DefaultMaxUnorderedTxTimeoutDuration+time.Second), 0, signing.SignMode_SIGN_MODE_DIRECT), sdkerrors.ErrInvalidRequest},
+		{"legacy amino json signature", buildTx(timeout.Add(3*time.Second), 0, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON), sdkerrors.ErrNotSupported},
+	}
+
+	for _, tc := range testCases {
+		_, err := antehandler(ctx, tc.tx, false)
+		require.ErrorIs(t, err, tc.expectedErr, tc.name)
+		// unordered txs never consume the account sequence
+		require.Equal(t, uint64(5), suite.accountKeeper.GetAccount(ctx, addr).GetSequence(), tc.name)
+	}
+}
+
+func TestAbstractAccount(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	// the test authenticator verifies the signatures of a key that is not the
+	// public key of the account
+	authPriv := secp256k1.GenPrivKey()
+	suite.accountKeeper.RegisterAuthenticator("secp256k1", types.AuthenticatorFunc(func(ctx context.Context, req types.AuthenticationRequest) error {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(1000, "authenticate")
+		if req.Simulate {
+			return nil
+		}
+		if !authPriv.PubKey().VerifySignature(req.SignBytes, req.Signature) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}))
+
+	newAcc := func(authenticator string) sdk.AccAddress {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		acc := types.NewAbstractAccount(types.NewBaseAccountWithAddress(addr), authenticator)
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccount(suite.ctx, acc))
+		return addr
+	}
+	addr := newAcc("secp256k1")
+	unknownAddr := newAcc("unknown")
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	sgcd := ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, sgcd, svd)
+
+	buildTx := func(signer sdk.AccAddress, priv cryptotypes.PrivKey, seq uint64) sdk.Tx {
+		acc := suite.accountKeeper.GetAccount(suite.ctx, signer)
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(signer)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		// abstract accounts sign without public keys
+		sig := signing.SignatureV2{
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: seq,
+		}
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+		signerData := authsign.SignerData{
+			Address:       signer.String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      seq,
+		}
+		signBytes, err := authsign.GetSignBytesAdapter(suite.ctx, suite.clientCtx.TxConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, suite.txBuilder.GetTx())
+		require.NoError(t, err)
+		sig.Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+		return suite.txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name        string
+		tx          sdk.Tx
+		simulate    bool
+		expectedErr error
+	}{
+		{"valid authentication", buildTx(addr, authPriv, 0), false, nil},
+		{"simulation", buildTx(addr, secp256k1.GenPrivKey(), 0), true, nil},
+		{"invalid signature", buildTx(addr, secp256k1.GenPrivKey(), 0), false, sdkerrors.ErrUnauthorized},
+		{"wrong sequence", buildTx(addr, authPriv, 1), false, sdkerrors.ErrWrongSequence},
+		{"unregistered authenticator", buildTx(unknownAddr, authPriv, 0), false, sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := antehandler(ctx, tc.tx, tc.simulate)
+		require.ErrorIs(t, err, tc.expectedErr, tc.name)
+		if tc.expectedErr == nil {
+			// the gas of the authenticator is charged to the tx
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), storetypes.Gas(1000), tc.name)
+			// abstract accounts never have a public key set
+			require.Nil(t, suite.accountKeeper.GetAccount(ctx, addr).GetPubKey(), tc.name)
+		}
+	}
+}
+
+func TestRotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	oldPriv, newPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(oldPriv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccount(suite.ctx, types.NewBaseAccount(addr, oldPriv.PubKey(), 0, 0))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(t, suite.accountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey()))
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	buildTx := func(priv cryptotypes.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the new key signs for the address of the old one
+	_, err := antehandler(suite.ctx, buildTx(newPriv), false)
+	require.NoError(t, err)
+
+	_, err = antehandler(suite.ctx, buildTx(oldPriv), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
@@ -5,20 +5,42 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
+// CheckTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
 // unit of gas is fixed and set by each validator, can the tx priority is computed from the gas price.
-func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+func CheckTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	return checkTxFeeWithValidatorMinGasPrices(ctx, tx, types.Params{})
+}
+
+// NewFeeDenomRatesTxFeeChecker returns a TxFeeChecker implementing the logic of
+// CheckTxFeeWithValidatorMinGasPrices, where fees paid in the fee denoms accepted
+// by the x/auth params are converted to the native fee denom before being checked
+// against the validator's minimum gas prices and used to compute the tx priority.
+// The fees themselves are deducted unconverted.
+// It is the TxFeeChecker used by DeductFeeDecorator when none is provided.
+func NewFeeDenomRatesTxFeeChecker(ak AccountKeeper) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		// the params are read without consuming gas, so that the gas consumption
+		// does not depend on the execution mode.
+		params := ak.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+		return checkTxFeeWithValidatorMinGasPrices(ctx, tx, params)
+	}
+}
+
+func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx, params types.Params) (sdk.Coins, int64, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	feeCoins := feeTx.GetFee()
+	convertedFeeCoins := params.ConvertFees(feeCoins)
 	gas := feeTx.GetGas()
 
 	// Ensure that the provided fees meet a minimum threshold for the validator,
@@ -37,13 +59,13 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
+			if !convertedFeeCoins.IsAnyGTE(requiredFees) {
 				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
 	}
 
-	priority := getTxPriority(feeCoins, int64(gas))
+	priority := getTxPriority(convertedFeeCoins, int64(gas))
 	return feeCoins, priority, nil
 }
 
@@ -74,8 +74,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "Authenticators",
+					Use:       "authenticators",
+					Short:     "Query the names of the authenticators of abstract accounts",
+				},
+				{
+					RpcMethod:      "RotatedPubKeys",
+					Use:            "rotated-pub-keys [pub-key-address]",
+					Short:          "Query the accounts which rotated away from a public key, by the address of the public key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pub_key_address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: authv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pub-key [address] [new-pub-key]",
+					Short:          "Replace the public key of an account, keeping its address",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pub-key cosmos1... '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "new_pub_key"}},
+				},
 			},
 		},
-		// Tx is purposely left empty, as the only tx is MsgUpdateParams which is gov gated.
 	}
 }
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterAuthenticator registers the authenticator of the abstract accounts
+// delegating their authentication to the given name. It panics if the name is
+// blank or if an authenticator is already registered under it.
+func (ak AccountKeeper) RegisterAuthenticator(name string, authenticator types.Authenticator) {
+	if strings.TrimSpace(name) == "" {
+		panic("authenticator name cannot be blank")
+	}
+
+	if _, ok := ak.authenticators[name]; ok {
+		panic(fmt.Sprintf("authenticator %s has already been registered", name))
+	}
+
+	ak.authenticators[name] = authenticator
+}
+
+// GetAuthenticator returns the authenticator registered under the given name.
+func (ak AccountKeeper) GetAuthenticator(name string) (types.Authenticator, bool) {
+	authenticator, ok := ak.authenticators[name]
+	return authenticator, ok
+}
+
+// AuthenticatorNames returns the names of the registered authenticators, in
+// lexical order.
+func (ak AccountKeeper) AuthenticatorNames() []string {
+	names := make([]string, 0, len(ak.authenticators))
+	for name := range ak.authenticators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NewAbstractAccount returns a new abstract account with the next account
+// number and the specified address, authenticated by the authenticator
+// registered under the given name. It does not save the new account to the
+// store.
+func (ak AccountKeeper) NewAbstractAccount(ctx context.Context, addr sdk.AccAddress, authenticator string) (*types.AbstractAccount, error) {
+	if _, ok := ak.authenticators[authenticator]; !ok {
+		return nil, fmt.Errorf("authenticator %s is not registered", authenticator)
+	}
+
+	if ak.HasAccount(ctx, addr) {
+		return nil, fmt.Errorf("account %s already exists", addr)
+	}
+
+	acc := types.NewAbstractAccount(types.NewBaseAccountWithAddress(addr), authenticator)
+	return ak.NewAccount(ctx, acc).(*types.AbstractAccount), nil
+}
+
+// MsgAuthenticator is a types.Authenticator delegating authentication to a
+// module Msg service: the request is authenticated if the Msg built from it is
+// executed successfully.
+type MsgAuthenticator struct {
+	router baseapp.MessageRouter
+	newMsg func(types.AuthenticationRequest) (sdk.Msg, error)
+}
+
+// NewMsgAuthenticator returns a MsgAuthenticator executing the Msg returned by
+// newMsg with the handler of the given router.
+func NewMsgAuthenticator(router baseapp.MessageRouter, newMsg func(types.AuthenticationRequest) (sdk.Msg, error)) MsgAuthenticator {
+	return MsgAuthenticator{
+		router: router,
+		newMsg: newMsg,
+	}
+}
+
+// Authenticate implements types.Authenticator. The state changes of the Msg
+// are only written if it is executed successfully.
+func (a MsgAuthenticator) Authenticate(ctx context.Context, req types.AuthenticationRequest) error {
+	msg, err := a.newMsg(req)
+	if err != nil {
+		return err
+	}
+
+	handler := a.router.Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
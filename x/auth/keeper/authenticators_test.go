@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestAuthenticators() {
+	noop := types.AuthenticatorFunc(func(context.Context, types.AuthenticationRequest) error { return nil })
+
+	suite.accountKeeper.RegisterAuthenticator("b", noop)
+	suite.accountKeeper.RegisterAuthenticator("a", noop)
+	suite.Require().PanicsWithValue("authenticator a has already been registered", func() {
+		suite.accountKeeper.RegisterAuthenticator("a", noop)
+	})
+	suite.Require().PanicsWithValue("authenticator name cannot be blank", func() {
+		suite.accountKeeper.RegisterAuthenticator(" ", noop)
+	})
+
+	_, ok := suite.accountKeeper.GetAuthenticator("a")
+	suite.Require().True(ok)
+	_, ok = suite.accountKeeper.GetAuthenticator("c")
+	suite.Require().False(ok)
+	suite.Require().Equal([]string{"a", "b"}, suite.accountKeeper.AuthenticatorNames())
+
+	res, err := suite.queryClient.Authenticators(suite.ctx, &types.QueryAuthenticatorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"a", "b"}, res.Names)
+
+	addr1, addr2 := sdk.AccAddress("addr1_______________"), sdk.AccAddress("addr2_______________")
+	acc, err := suite.accountKeeper.NewAbstractAccount(suite.ctx, addr1, "a")
+	suite.Require().NoError(err)
+	suite.Require().Equal("a", acc.GetAuthenticator())
+	suite.Require().NoError(acc.Validate())
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	_, err = suite.accountKeeper.NewAbstractAccount(suite.ctx, addr1, "a")
+	suite.Require().ErrorContains(err, "already exists")
+	_, err = suite.accountKeeper.NewAbstractAccount(suite.ctx, addr2, "c")
+	suite.Require().ErrorContains(err, "is not registered")
+}
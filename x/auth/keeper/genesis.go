@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -32,6 +37,12 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	}
 
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	for _, tx := range data.UnorderedTxs {
+		if err := ak.AddUnorderedTx(ctx, tx.TxHash, tx.TimeoutTimestamp); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -45,5 +56,14 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	err := ak.UnorderedTxs.Walk(ctx, nil, func(key collections.Pair[time.Time, []byte]) (bool, error) {
+		genState.UnorderedTxs = append(genState.UnorderedTxs, types.UnorderedTx{TxHash: key.K2(), TimeoutTimestamp: key.K1()})
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return genState
 }
@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -245,3 +247,44 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// Authenticators returns the names of the registered authenticators of abstract accounts.
+func (s queryServer) Authenticators(_ context.Context, req *types.QueryAuthenticatorsRequest) (*types.QueryAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryAuthenticatorsResponse{Names: s.k.AuthenticatorNames()}, nil
+}
+
+// RotatedPubKeys returns the accounts which replaced the public key with the
+// given address, with the time of the rotation.
+func (s queryServer) RotatedPubKeys(ctx context.Context, req *types.QueryRotatedPubKeysRequest) (*types.QueryRotatedPubKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PubKeyAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "public key address cannot be empty")
+	}
+
+	pkAddr, err := s.k.addressCodec.StringToBytes(req.PubKeyAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key address: %s", err)
+	}
+
+	rotatedPubKeys, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.RotatedPubKeys,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value types.RotatedPubKey) (types.RotatedPubKey, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](pkAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRotatedPubKeysResponse{RotatedPubKeys: rotatedPubKeys, Pagination: pageRes}, nil
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -90,6 +93,9 @@ type AccountKeeper struct {
 	permAddrs    map[string]types.PermissionsForAddress
 	bech32Prefix string
 
+	// authenticators are the authenticators of abstract accounts, by name.
+	authenticators map[string]types.Authenticator
+
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
@@ -102,6 +108,15 @@ type AccountKeeper struct {
 	Params        collections.Item[types.Params]
 	AccountNumber collections.Sequence
 	Accounts      *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// UnorderedTxs contains the body hashes of the unordered txs executed
+	// until their timeout timestamp, by timeout timestamp.
+	UnorderedTxs collections.KeySet[collections.Pair[time.Time, []byte]]
+	// PubKeyRotationTimes contains the block time of the last public key
+	// rotation of accounts, by account address.
+	PubKeyRotationTimes collections.Map[sdk.AccAddress, time.Time]
+	// RotatedPubKeys contains the public keys replaced by accounts, by public
+	// key address and account address.
+	RotatedPubKeys collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.RotatedPubKey]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -124,16 +139,20 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		storeService:  storeService,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:        ac,
+		bech32Prefix:        bech32Prefix,
+		storeService:        storeService,
+		proto:               proto,
+		cdc:                 cdc,
+		permAddrs:           permAddrs,
+		authority:           authority,
+		authenticators:      make(map[string]types.Authenticator),
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:       collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:            collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		UnorderedTxs:        collections.NewKeySet(sb, types.UnorderedTxsKeyPrefix, "unordered_txs", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey)),
+		PubKeyRotationTimes: collections.NewMap(sb, types.PubKeyRotationTimesKeyPrefix, "pub_key_rotation_times", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.TimeKey)),
+		RotatedPubKeys:      collections.NewMap(sb, types.RotatedPubKeysKeyPrefix, "rotated_pub_keys", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.RotatedPubKey](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -275,5 +294,10 @@ func (ak AccountKeeper) GetParams(ctx context.Context) (params types.Params) {
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		panic(err)
 	}
+	// the gas refund ratio is nil if unset, e.g. in the params stored before
+	// its introduction
+	if params.GasRefundRatio.IsNil() {
+		params.GasRefundRatio = math.LegacyZeroDec()
+	}
 	return params
 }
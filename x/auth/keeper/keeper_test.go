@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/core/header"
@@ -215,3 +216,31 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func TestAccountStateOverride(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	accountKeeper := keeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		types.ProtoBaseAccount,
+		nil,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		types.NewModuleAddress("gov").String(),
+	)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	acc := accountKeeper.NewAccountWithAddress(ctx, addr)
+	accountKeeper.SetAccount(ctx, acc)
+
+	// override the sequence of the account
+	require.NoError(t, acc.SetSequence(5))
+	override, err := types.NewAccountStateOverride(encCfg.Codec, acc)
+	require.NoError(t, err)
+	require.Equal(t, types.StoreKey, override.StoreKey)
+
+	ctx.KVStore(key).Set(override.Key, override.Value)
+	require.Equal(t, uint64(5), accountKeeper.GetAccount(ctx, addr).GetSequence())
+}
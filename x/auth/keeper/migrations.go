@@ -9,6 +9,7 @@ import (
 	v3 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v4"
 	v5 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v6"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -67,6 +68,13 @@ func (m Migrator) Migrate4To5(ctx sdk.Context) error {
 	return v5.Migrate(ctx, m.keeper.storeService, m.keeper.AccountNumber)
 }
 
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It sets the public key rotation cool-down and gas refund ratio params to
+// their default values.
+func (m Migrator) Migrate5To6(ctx sdk.Context) error {
+	return v6.Migrate(ctx, m.keeper.Params)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
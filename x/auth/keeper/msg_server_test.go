@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -109,3 +114,71 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	s.SetupTest() // reset
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	blockTime := time.Unix(10000, 0).UTC()
+	ctx := s.ctx.WithBlockTime(blockTime).WithBlockHeight(5)
+
+	oldPk, newPk := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPk.Address())
+	s.accountKeeper.SetAccount(ctx, s.accountKeeper.NewAccount(ctx, types.NewBaseAccount(addr, oldPk, 0, 0)))
+	noPkAddr := sdk.AccAddress("no_pub_key__________")
+	s.accountKeeper.SetAccount(ctx, s.accountKeeper.NewAccountWithAddress(ctx, noPkAddr))
+
+	newMsg := func(addr sdk.AccAddress, pk cryptotypes.PubKey) *types.MsgRotatePubKey {
+		msg, err := types.NewMsgRotatePubKey(addr, pk)
+		s.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{"invalid address", ctx, &types.MsgRotatePubKey{Address: "foo"}, "invalid address"},
+		{"empty public key", ctx, &types.MsgRotatePubKey{Address: addr.String()}, "new public key cannot be empty"},
+		{"unknown account", ctx, newMsg(sdk.AccAddress("unknown_____________"), newPk), "does not exist"},
+		{"account without public key", ctx, newMsg(noPkAddr, newPk), "has no public key to rotate"},
+		{"same public key", ctx, newMsg(addr, oldPk), "already has public key"},
+		{"valid rotation", ctx, newMsg(addr, newPk), ""},
+		{"rotation during the cool-down", ctx.WithBlockTime(blockTime.Add(types.DefaultPubKeyRotationCooldown - time.Second)), newMsg(addr, oldPk), "can't be rotated before"},
+		{"rotation after the cool-down", ctx.WithBlockTime(blockTime.Add(types.DefaultPubKeyRotationCooldown)), newMsg(addr, oldPk), ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RotatePubKey(tc.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the account is back to its original public key, keeping its address
+	s.Require().Equal(oldPk, s.accountKeeper.GetAccount(ctx, addr).GetPubKey())
+
+	lastRotation, err := s.accountKeeper.PubKeyRotationTimes.Get(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(blockTime.Add(types.DefaultPubKeyRotationCooldown), lastRotation)
+
+	// both public keys have been rotated away from once
+	for _, pk := range []cryptotypes.PubKey{oldPk, newPk} {
+		res, err := s.queryClient.RotatedPubKeys(ctx, &types.QueryRotatedPubKeysRequest{PubKeyAddress: sdk.AccAddress(pk.Address()).String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.RotatedPubKeys, 1)
+		s.Require().Equal(addr.String(), res.RotatedPubKeys[0].Address)
+		s.Require().Equal(int64(5), res.RotatedPubKeys[0].Height)
+		s.Require().Equal(pk, res.RotatedPubKeys[0].PubKey.GetCachedValue())
+	}
+
+	_, err = s.queryClient.RotatedPubKeys(ctx, &types.QueryRotatedPubKeysRequest{})
+	s.Require().ErrorContains(err, "public key address cannot be empty")
+}
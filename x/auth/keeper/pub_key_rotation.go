@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the public key of the account at the given address,
+// keeping its address. The replaced public key is indexed by its address for
+// auditing, and the public key of an account can't be rotated again before
+// the end of the cool-down period set in the params.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key to rotate", addr)
+	}
+
+	if oldPubKey.Equals(pubKey) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s already has public key %s", addr, pubKey)
+	}
+
+	params, err := ak.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	lastRotation, err := ak.PubKeyRotationTimes.Get(ctx, addr)
+	switch {
+	case err == nil:
+		if nextRotation := lastRotation.Add(params.PubKeyRotationCooldown); blockTime.Before(nextRotation) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "public key of account %s can't be rotated before %s", addr, nextRotation)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	anyPk, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+
+	err = ak.RotatedPubKeys.Set(ctx, collections.Join(sdk.AccAddress(oldPubKey.Address()), addr), types.RotatedPubKey{
+		Address:   addr.String(),
+		PubKey:    anyPk,
+		RotatedAt: blockTime,
+		Height:    sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := ak.PubKeyRotationTimes.Set(ctx, addr, blockTime); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, sdk.AccAddress(oldPubKey.Address()).String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, sdk.AccAddress(pubKey.Address()).String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasUnorderedTx returns whether the unordered tx with the given body hash and
+// timeout timestamp has been executed.
+func (ak AccountKeeper) HasUnorderedTx(ctx context.Context, txHash []byte, timeout time.Time) (bool, error) {
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeout, txHash))
+}
+
+// AddUnorderedTx records the unordered tx with the given body hash as executed
+// until its timeout timestamp.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, txHash []byte, timeout time.Time) error {
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeout, txHash))
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs whose timeout timestamp
+// is before the block time, as they can't be executed anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rng := new(collections.Range[collections.Pair[time.Time, []byte]]).
+		EndExclusive(collections.Join(blockTime, []byte{}))
+
+	return ak.UnorderedTxs.Clear(ctx, rng)
+}
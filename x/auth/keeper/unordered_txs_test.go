@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestUnorderedTxs() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(10000, 0).UTC())
+	blockTime := ctx.BlockTime()
+
+	expired, current, pending := []byte("expired"), []byte("current"), []byte("pending")
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, expired, blockTime.Add(-time.Second)))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, current, blockTime))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, pending, blockTime.Add(time.Second)))
+
+	has, err := suite.accountKeeper.HasUnorderedTx(ctx, pending, blockTime)
+	suite.Require().NoError(err)
+	suite.Require().False(has, "unordered txs are keyed by their timeout too")
+
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	has, err = suite.accountKeeper.HasUnorderedTx(ctx, expired, blockTime.Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	has, err = suite.accountKeeper.HasUnorderedTx(ctx, current, blockTime)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	has, err = suite.accountKeeper.HasUnorderedTx(ctx, pending, blockTime.Add(time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+}
+
+func (suite *KeeperTestSuite) TestUnorderedTxsGenesis() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(10000, 0).UTC())
+	timeout := ctx.BlockTime().Add(time.Minute)
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress("addr1_______________")))
+
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, []byte("tx1"), timeout))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, []byte("tx2"), timeout.Add(time.Second)))
+
+	genState := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.UnorderedTx{
+		{TxHash: []byte("tx1"), TimeoutTimestamp: timeout},
+		{TxHash: []byte("tx2"), TimeoutTimestamp: timeout.Add(time.Second)},
+	}, genState.UnorderedTxs)
+
+	// the unordered txs are still protected against replays once imported
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(timeout.Add(time.Hour))))
+	has, err := suite.accountKeeper.HasUnorderedTx(ctx, []byte("tx1"), timeout)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	suite.accountKeeper.InitGenesis(ctx, *genState)
+	for _, tx := range genState.UnorderedTxs {
+		has, err := suite.accountKeeper.HasUnorderedTx(ctx, tx.TxHash, tx.TimeoutTimestamp)
+		suite.Require().NoError(err)
+		suite.Require().True(has)
+	}
+}
@@ -1,6 +1,8 @@
 package legacytx
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -59,6 +61,12 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetTimeoutTimestamp does nothing for stdtx
+func (s *StdTxBuilder) SetTimeoutTimestamp(_ time.Time) {}
+
+// SetUnordered does nothing for stdtx
+func (s *StdTxBuilder) SetUnordered(_ bool) {}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -0,0 +1,24 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate sets the params added in the consensus version 6 of x/auth, which
+// are decoded as zero values from the params stored before, to their default
+// values. The native fee denom and the fee denom rates default to empty ones.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.PubKeyRotationCooldown = types.DefaultPubKeyRotationCooldown
+	p.GasRefundRatio = types.DefaultGasRefundRatio
+
+	return params.Set(ctx, p)
+}
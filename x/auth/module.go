@@ -28,7 +28,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
@@ -92,7 +92,10 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -137,6 +140,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5", types.ModuleName))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
@@ -158,6 +165,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock returns the end blocker for the auth module. It removes the
+// unordered txs which have timed out.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the auth module
@@ -187,6 +200,7 @@ func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.Weighte
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeAddAuthenticators),
 	)
 }
 
@@ -237,3 +251,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+// InvokeAddAuthenticators registers the authenticators of abstract accounts
+// provided by other modules.
+func InvokeAddAuthenticators(k keeper.AccountKeeper, routes []types.AuthenticatorRoute) {
+	for _, r := range routes {
+		k.RegisterAuthenticator(r.Name, r.Authenticator)
+	}
+}
@@ -0,0 +1,26 @@
+package posthandler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper interface {
+	GetParams(ctx context.Context) (params types.Params)
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the contract needed for BankKeeper related APIs.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeegrantKeeper defines the expected feegrant keeper, restoring the refunded
+// fees to the fee allowances they were granted with.
+type FeegrantKeeper interface {
+	RefundGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error
+}
@@ -0,0 +1,121 @@
+package posthandler
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GasRefundDecorator refunds the portion, set by the gas_refund_ratio auth
+// param, of the fees paid for the unused gas of a tx, from the fee collector
+// to the account the fees were deducted from by the DeductFeeDecorator, i.e.
+// the fee payer or the fee granter. The refund is also restored to the fee
+// allowance the fees were paid with, if any. The part of the fees burned
+// after their deduction, see ante.WithBurnedFee, is not refunded.
+//
+// The post handler only runs for the txs whose messages succeeded, so the
+// failed txs are not refunded and pay for their whole gas limit.
+//
+// The gas used is the gas consumed by the tx when the rest of the post handler
+// chain is run, the refund itself not consuming gas.
+type GasRefundDecorator struct {
+	accountKeeper  AccountKeeper
+	bankKeeper     BankKeeper
+	feegrantKeeper FeegrantKeeper
+}
+
+// NewGasRefundDecorator returns a new GasRefundDecorator. The feegrant keeper
+// is optional.
+func NewGasRefundDecorator(ak AccountKeeper, bk BankKeeper, fk FeegrantKeeper) GasRefundDecorator {
+	return GasRefundDecorator{
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+	}
+}
+
+func (d GasRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	newCtx, err := next(ctx, tx, simulate, success)
+	if err != nil {
+		return newCtx, err
+	}
+
+	if err := d.refundGas(newCtx); err != nil {
+		return newCtx, err
+	}
+
+	return newCtx, nil
+}
+
+// refundGas refunds the fees paid for the unused gas of the tx executed with
+// the context.
+func (d GasRefundDecorator) refundGas(ctx sdk.Context) error {
+	deducted, ok := ante.DeductedFeeFromContext(ctx)
+	if !ok || deducted.Amount.IsZero() {
+		return nil
+	}
+
+	gasMeter := ctx.GasMeter()
+
+	// the refund is bounded, and must neither make the tx run out of gas nor
+	// change its gas consumption when disabled
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	ratio := d.accountKeeper.GetParams(ctx).GasRefundRatio
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return nil
+	}
+
+	refund := GasRefund(deducted.Refundable(), gasMeter.Limit(), gasMeter.GasConsumed(), ratio)
+
+	// the fees deducted from the fee collector since by other means cannot be
+	// refunded either
+	feeCollector := d.accountKeeper.GetModuleAddress(types.FeeCollectorName)
+	refund = refund.Min(d.bankKeeper.SpendableCoins(ctx, feeCollector))
+	if refund.IsZero() {
+		return nil
+	}
+
+	refundee := deducted.DeductedFrom()
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, refundee, refund); err != nil {
+		return err
+	}
+
+	if deducted.Granter != nil && d.feegrantKeeper != nil {
+		if err := d.feegrantKeeper.RefundGrantedFees(ctx, deducted.Granter, deducted.Payer, refund); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGasRefund,
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GasRefund returns the portion, given by the ratio, of the fee paid for the
+// gas limit that corresponds to the unused gas, truncated.
+func GasRefund(fee sdk.Coins, gasLimit, gasUsed uint64, ratio math.LegacyDec) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.NewCoins()
+	}
+
+	unused := math.NewIntFromUint64(gasLimit - gasUsed)
+	limit := math.NewIntFromUint64(gasLimit)
+
+	refund := sdk.NewCoins()
+	for _, c := range fee {
+		amount := math.LegacyNewDecFromInt(c.Amount.Mul(unused)).Mul(ratio).QuoInt(limit).TruncateInt()
+		refund = refund.Add(sdk.NewCoin(c.Denom, amount))
+	}
+
+	return refund
+}
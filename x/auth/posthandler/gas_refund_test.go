@@ -0,0 +1,234 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type testAccountKeeper struct {
+	params types.Params
+}
+
+func (ak testAccountKeeper) GetParams(context.Context) types.Params { return ak.params }
+
+func (ak testAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return types.NewBaseAccountWithAddress(addr)
+}
+
+func (ak testAccountKeeper) SetAccount(context.Context, sdk.AccountI) {}
+
+func (ak testAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return types.NewModuleAddress(moduleName)
+}
+
+func (ak testAccountKeeper) AddressCodec() address.Codec { return authcodec.NewBech32Codec("cosmos") }
+
+type testBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk testBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, isNeg := bk.balances[from.String()].SafeSub(amt...)
+	if isNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk testBankKeeper) IsSendEnabledCoins(context.Context, ...sdk.Coin) error { return nil }
+
+func (bk testBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(from, to, amt)
+}
+
+func (bk testBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return bk.send(from, types.NewModuleAddress(module), amt)
+}
+
+func (bk testBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(types.NewModuleAddress(module), to, amt)
+}
+
+func (bk testBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+type testFeegrantKeeper struct {
+	refunds map[string]sdk.Coins
+}
+
+func (fk testFeegrantKeeper) UseGrantedFees(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins, []sdk.Msg) error {
+	return nil
+}
+
+func (fk testFeegrantKeeper) RefundGrantedFees(_ context.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	fk.refunds[granter.String()+"/"+grantee.String()] = refund
+	return nil
+}
+
+type testFeeTx struct {
+	gas     uint64
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx testFeeTx) GetMsgs() []sdk.Msg                    { return nil }
+func (tx testFeeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx testFeeTx) GetGas() uint64                        { return tx.gas }
+func (tx testFeeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx testFeeTx) FeePayer() []byte                      { return tx.payer }
+func (tx testFeeTx) FeeGranter() []byte                    { return tx.granter }
+
+func TestGasRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 7))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 2)),
+		posthandler.GasRefund(fee, 100000, 40000, math.LegacyNewDecWithPrec(5, 1)))
+	require.Equal(t, fee, posthandler.GasRefund(fee, 100000, 0, math.LegacyOneDec()))
+	require.True(t, posthandler.GasRefund(fee, 100000, 100000, math.LegacyOneDec()).IsZero())
+	require.True(t, posthandler.GasRefund(fee, 100000, 200000, math.LegacyOneDec()).IsZero())
+	require.True(t, posthandler.GasRefund(fee, 0, 0, math.LegacyOneDec()).IsZero())
+}
+
+func TestGasRefundDecorator(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	feeCollector := types.NewModuleAddress(types.FeeCollectorName)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	testCases := []struct {
+		name          string
+		ratio         math.LegacyDec
+		granter       sdk.AccAddress
+		burnt         sdk.Coins
+		recordBurn    bool
+		expRefund     sdk.Coins
+		expRefundee   sdk.AccAddress
+		expFeeRefunds map[string]sdk.Coins
+	}{
+		{
+			name:          "refunds disabled",
+			ratio:         math.LegacyZeroDec(),
+			expRefund:     sdk.NewCoins(),
+			expFeeRefunds: map[string]sdk.Coins{},
+		},
+		{
+			name:          "refund to the fee payer",
+			ratio:         math.LegacyNewDecWithPrec(5, 1),
+			expRefund:     sdk.NewCoins(sdk.NewInt64Coin("atom", 300)),
+			expRefundee:   payer,
+			expFeeRefunds: map[string]sdk.Coins{},
+		},
+		{
+			name:        "refund to the fee granter",
+			ratio:       math.LegacyOneDec(),
+			granter:     granter,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("atom", 600)),
+			expRefundee: granter,
+			expFeeRefunds: map[string]sdk.Coins{
+				granter.String() + "/" + payer.String(): sdk.NewCoins(sdk.NewInt64Coin("atom", 600)),
+			},
+		},
+		{
+			name:          "refund capped by the fee collector balance",
+			ratio:         math.LegacyOneDec(),
+			burnt:         sdk.NewCoins(sdk.NewInt64Coin("atom", 800)),
+			expRefund:     sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+			expRefundee:   payer,
+			expFeeRefunds: map[string]sdk.Coins{},
+		},
+		{
+			name:          "burned fees not refunded",
+			ratio:         math.LegacyOneDec(),
+			burnt:         sdk.NewCoins(sdk.NewInt64Coin("atom", 400)),
+			recordBurn:    true,
+			expRefund:     sdk.NewCoins(sdk.NewInt64Coin("atom", 360)),
+			expRefundee:   payer,
+			expFeeRefunds: map[string]sdk.Coins{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			ctx := testCtx.Ctx.WithBlockHeight(1).WithGasMeter(storetypes.NewGasMeter(100000))
+
+			params := types.DefaultParams()
+			params.GasRefundRatio = tc.ratio
+			ak := testAccountKeeper{params: params}
+			bk := testBankKeeper{balances: map[string]sdk.Coins{
+				payer.String():   fee,
+				granter.String(): fee,
+			}}
+			fk := testFeegrantKeeper{refunds: map[string]sdk.Coins{}}
+
+			anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(ak, bk, fk, nil))
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+				AccountKeeper:  ak,
+				BankKeeper:     bk,
+				FeegrantKeeper: fk,
+			})
+			require.NoError(t, err)
+
+			tx := testFeeTx{gas: 100000, fee: fee, payer: payer, granter: tc.granter}
+			ctx, err = anteHandler(ctx, tx, false)
+			require.NoError(t, err)
+
+			deducted, ok := ante.DeductedFeeFromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, fee, deducted.Amount)
+			require.Equal(t, sdk.AccAddress(payer), deducted.Payer)
+
+			if !tc.burnt.IsZero() {
+				bk.balances[feeCollector.String()] = bk.balances[feeCollector.String()].Sub(tc.burnt...)
+			}
+			if tc.recordBurn {
+				ctx = ante.WithBurnedFee(ctx, tc.burnt)
+			}
+
+			ctx.GasMeter().ConsumeGas(40000-ctx.GasMeter().GasConsumed(), "msgs")
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err = postHandler(ctx, tx, false, true)
+			require.NoError(t, err)
+
+			// the refund does not consume gas
+			require.Equal(t, storetypes.Gas(40000), ctx.GasMeter().GasConsumed())
+			require.Equal(t, fee.Sub(tc.burnt...).Sub(tc.expRefund...), bk.balances[feeCollector.String()])
+			if tc.expRefundee != nil {
+				require.Equal(t, tc.expRefund, bk.balances[tc.expRefundee.String()])
+			}
+			require.Equal(t, tc.expFeeRefunds, fk.refunds)
+
+			if tc.expRefund.IsZero() {
+				require.Empty(t, ctx.EventManager().Events())
+				return
+			}
+			require.Equal(t, sdk.Events{sdk.NewEvent(
+				types.EventTypeGasRefund,
+				sdk.NewAttribute(types.AttributeKeyRefund, tc.expRefund.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, tc.expRefundee.String()),
+			)}, ctx.EventManager().Events())
+		})
+	}
+}
@@ -5,11 +5,25 @@ import (
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AccountKeeper and BankKeeper are required to refund the fees paid for
+	// the unused gas of the txs, the refunds being disabled if any is nil.
+	AccountKeeper AccountKeeper
+	BankKeeper    BankKeeper
+	// FeegrantKeeper is optional, restoring the refunded fees to the fee
+	// allowances they were paid with.
+	FeegrantKeeper FeegrantKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns the default PostHandler chain, which refunds the
+// fees paid for the unused gas of the txs if the keepers are provided, and is
+// empty otherwise.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.AccountKeeper != nil && options.BankKeeper != nil {
+		postDecorators = append(postDecorators, NewGasRefundDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -41,20 +41,23 @@ func GetSignBytesAdapter(
 		return nil, err
 	}
 
-	anyPk, err := codectypes.NewAnyWithValue(signerData.PubKey)
-	if err != nil {
-		return nil, err
-	}
-
 	txSignerData := txsigning.SignerData{
 		ChainID:       signerData.ChainID,
 		AccountNumber: signerData.AccountNumber,
 		Sequence:      signerData.Sequence,
 		Address:       signerData.Address,
-		PubKey: &anypb.Any{
+	}
+	// signers without public keys, like abstract accounts, sign without one
+	if signerData.PubKey != nil {
+		anyPk, err := codectypes.NewAnyWithValue(signerData.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		txSignerData.PubKey = &anypb.Any{
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
-		},
+		}
 	}
 	// Generate the bytes to be signed.
 	return handlerMap.GetSignBytes(ctx, txSignMode, txSignerData, txData)
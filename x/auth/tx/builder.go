@@ -3,6 +3,7 @@ package tx
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
@@ -227,6 +228,20 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetTimeoutTimeStamp returns the transaction's timeout timestamp, or the zero
+// time if not set.
+func (w *wrapper) GetTimeoutTimeStamp() time.Time {
+	if w.tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+	return *w.tx.Body.TimeoutTimestamp
+}
+
+// GetUnordered returns whether the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -289,6 +304,27 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetTimeoutTimestamp sets the transaction's timestamp timeout, or unsets it
+// if timestamp is the zero time.
+func (w *wrapper) SetTimeoutTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		w.tx.Body.TimeoutTimestamp = nil
+	} else {
+		w.tx.Body.TimeoutTimestamp = &timestamp
+	}
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -355,9 +391,15 @@ func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	for i, sig := range signatures {
 		var modeInfo *tx.ModeInfo
 		modeInfo, rawSigs[i] = SignatureDataToModeInfoAndSig(sig.Data)
-		pubKey, err := codectypes.NewAnyWithValue(sig.PubKey)
-		if err != nil {
-			return err
+		// the public key is omitted for signers without one, like abstract
+		// accounts
+		var pubKey *codectypes.Any
+		if sig.PubKey != nil {
+			var err error
+			pubKey, err = codectypes.NewAnyWithValue(sig.PubKey)
+			if err != nil {
+				return err
+			}
 		}
 		signerInfos[i] = &tx.SignerInfo{
 			PublicKey: pubKey,
@@ -469,6 +511,12 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if w.tx.Body.TimeoutTimestamp != nil && (body.TimeoutTimestamp == nil || !w.tx.Body.TimeoutTimestamp.Equal(*body.TimeoutTimestamp)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout timestamp %s, got %v in AuxSignerData", w.tx.Body.TimeoutTimestamp, body.TimeoutTimestamp)
+	}
+	if w.tx.Body.Unordered && !body.Unordered {
+		return sdkerrors.ErrInvalidRequest.Wrap("TxBuilder is unordered, got an ordered transaction in AuxSignerData")
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -510,6 +558,8 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	w.tx.Body.TimeoutTimestamp = body.TimeoutTimestamp
+	w.SetUnordered(body.Unordered)
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
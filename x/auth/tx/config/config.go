@@ -110,14 +110,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			// meaning that both `runMsgs` and `postHandler` state will be committed if
 			// both are successful, and both will be reverted if any of the two fails.
 			//
-			// The SDK exposes a default empty postHandlers chain.
+			// The SDK exposes a default postHandlers chain, refunding the fees paid
+			// for the unused gas of the txs.
 			//
 			// Please note that changing any of the anteHandler or postHandler chain is
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
-			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
-			)
+			postHandler, err := posthandler.NewPostHandler(newPostHandlerOptions(in))
 			if err != nil {
 				panic(err)
 			}
@@ -153,6 +152,21 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 	return anteHandler, nil
 }
 
+// newPostHandlerOptions returns the options of the post handler, with the
+// keepers implementing the contracts it expects.
+func newPostHandlerOptions(in ModuleInputs) posthandler.HandlerOptions {
+	var options posthandler.HandlerOptions
+	if bk, ok := in.BankKeeper.(posthandler.BankKeeper); ok && in.AccountKeeper != nil {
+		options.AccountKeeper = in.AccountKeeper
+		options.BankKeeper = bk
+	}
+	if fk, ok := in.FeeGrantKeeper.(posthandler.FeegrantKeeper); ok {
+		options.FeegrantKeeper = fk
+	}
+
+	return options
+}
+
 // NewBankKeeperCoinMetadataQueryFn creates a new Textual struct using the given
 // BankKeeper to retrieve coin metadata.
 //
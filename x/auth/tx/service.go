@@ -24,20 +24,42 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithOptionsFn is the signature of the
+// Baseapp#SimulateWithOptions function.
+type baseAppSimulateWithOptionsFn func(txBytes []byte, opts txtypes.SimulateOptions) (sdk.GasInfo, *sdk.Result, *txtypes.SimulationTrace, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx           client.Context
+	simulate            baseAppSimulateFn
+	simulateWithOptions baseAppSimulateWithOptionsFn
+	interfaceRegistry   codectypes.InterfaceRegistry
+}
+
+// TxServerOption is an option of the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithSimulateWithOptions sets the function simulating the txs with the
+// options of the simulate request: the height of the state, the state
+// overrides and the trace. Without it, these options are not supported.
+func WithSimulateWithOptions(simulateWithOptions baseAppSimulateWithOptionsFn) TxServerOption {
+	return func(s *txServer) {
+		s.simulateWithOptions = simulateWithOptions
+	}
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...TxServerOption) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -72,7 +94,10 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 	}, nil
 }
 
-// Simulate implements the ServiceServer.Simulate RPC method.
+// Simulate implements the ServiceServer.Simulate RPC method. When a traced
+// simulation fails, the gas info and trace are returned in a SimulateResponse
+// attached to the details of the error status. These details are only carried
+// by gRPC, not by the ABCI queries.
 func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
@@ -95,14 +120,50 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	if req.Height == 0 && len(req.StateOverrides) == 0 && !req.Trace {
+		gasInfo, result, err := s.simulate(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+		}
+
+		return &txtypes.SimulateResponse{
+			GasInfo: &gasInfo,
+			Result:  result,
+		}, nil
+	}
+
+	if s.simulateWithOptions == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation height, state overrides and trace are not supported")
+	}
+
+	gasInfo, result, trace, err := s.simulateWithOptions(txBytes, txtypes.SimulateOptions{
+		Height:         req.Height,
+		StateOverrides: req.StateOverrides,
+		Trace:          req.Trace,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+		st := status.Newf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+		if trace == nil {
+			return nil, st.Err()
+		}
+
+		// the trace of a failed simulation is returned in the error details
+		stWithTrace, detailsErr := st.WithDetails(&txtypes.SimulateResponse{GasInfo: &gasInfo, Trace: trace})
+		if detailsErr != nil {
+			return nil, st.Err()
+		}
+
+		return nil, stWithTrace.Err()
 	}
 
 	return &txtypes.SimulateResponse{
 		GasInfo: &gasInfo,
 		Result:  result,
+		Trace:   trace,
 	}, nil
 }
 
@@ -310,10 +371,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
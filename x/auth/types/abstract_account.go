@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ AbstractAccountI = (*AbstractAccount)(nil)
+	_ GenesisAccount   = (*AbstractAccount)(nil)
+)
+
+// AbstractAccountI defines an account whose transactions are authenticated by
+// the registered Authenticator it delegates its authentication to, instead of
+// public key signatures.
+type AbstractAccountI interface {
+	sdk.AccountI
+
+	// GetAuthenticator returns the name of the authenticator of the account.
+	GetAuthenticator() string
+}
+
+// NewAbstractAccount creates a new AbstractAccount authenticated by the
+// authenticator registered with the given name.
+func NewAbstractAccount(ba *BaseAccount, authenticator string) *AbstractAccount {
+	return &AbstractAccount{
+		BaseAccount:   ba,
+		Authenticator: authenticator,
+	}
+}
+
+// GetAuthenticator returns the name of the authenticator of the account.
+func (aa AbstractAccount) GetAuthenticator() string {
+	return aa.Authenticator
+}
+
+// SetPubKey - Implements AccountI
+func (aa AbstractAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	return fmt.Errorf("not supported for abstract accounts")
+}
+
+// Validate checks for errors on the account fields
+func (aa AbstractAccount) Validate() error {
+	if strings.TrimSpace(aa.Authenticator) == "" {
+		return errors.New("abstract account authenticator cannot be blank")
+	}
+
+	if aa.BaseAccount == nil {
+		return errors.New("uninitialized AbstractAccount: BaseAccount is nil")
+	}
+
+	if aa.PubKey != nil {
+		return errors.New("abstract account cannot have a public key")
+	}
+
+	return aa.BaseAccount.Validate()
+}
@@ -199,3 +199,34 @@ func TestModuleAccountValidateNilBaseAccount(t *testing.T) {
 	ma := &types.ModuleAccount{Name: "foo"}
 	_ = ma.Validate()
 }
+
+func TestAbstractAccountValidate(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		name   string
+		acc    *types.AbstractAccount
+		expErr string
+	}{
+		{"valid", types.NewAbstractAccount(types.NewBaseAccountWithAddress(addr), "secp256k1"), ""},
+		{"blank authenticator", types.NewAbstractAccount(types.NewBaseAccountWithAddress(addr), " "), "authenticator cannot be blank"},
+		{"nil base account", types.NewAbstractAccount(nil, "secp256k1"), "BaseAccount is nil"},
+		{"public key", types.NewAbstractAccount(types.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0), "secp256k1"), "cannot have a public key"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.acc.Validate()
+			if tt.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expErr)
+			}
+		})
+	}
+
+	acc := types.NewAbstractAccount(types.NewBaseAccountWithAddress(addr), "secp256k1")
+	require.Error(t, acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+}
@@ -4,21 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -109,6 +115,127 @@ func (m *ModuleAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
+// AbstractAccount defines an account whose transactions are not authenticated
+// by public key signatures, but by the registered authenticator it delegates
+// its authentication to.
+//
+// Since: cosmos-sdk 0.50
+type AbstractAccount struct {
+	*BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
+	// authenticator is the name of the registered authenticator authenticating
+	// the transactions of the account.
+	Authenticator string `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *AbstractAccount) Reset()         { *m = AbstractAccount{} }
+func (m *AbstractAccount) String() string { return proto.CompactTextString(m) }
+func (*AbstractAccount) ProtoMessage()    {}
+func (*AbstractAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+}
+func (m *AbstractAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbstractAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbstractAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbstractAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbstractAccount.Merge(m, src)
+}
+func (m *AbstractAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbstractAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbstractAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbstractAccount proto.InternalMessageInfo
+
+// RotatedPubKey is a public key of an account replaced by MsgRotatePubKey. The
+// rotated public keys are indexed by their address, for auditing.
+//
+// Since: cosmos-sdk 0.50
+type RotatedPubKey struct {
+	// address is the address of the account which replaced the public key.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pub_key is the replaced public key.
+	PubKey *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// rotated_at is the block time at which the public key was replaced.
+	RotatedAt time.Time `protobuf:"bytes,3,opt,name=rotated_at,json=rotatedAt,proto3,stdtime" json:"rotated_at"`
+	// height is the block height at which the public key was replaced.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RotatedPubKey) Reset()         { *m = RotatedPubKey{} }
+func (m *RotatedPubKey) String() string { return proto.CompactTextString(m) }
+func (*RotatedPubKey) ProtoMessage()    {}
+func (*RotatedPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *RotatedPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatedPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatedPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotatedPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatedPubKey.Merge(m, src)
+}
+func (m *RotatedPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatedPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatedPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotatedPubKey proto.InternalMessageInfo
+
+func (m *RotatedPubKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RotatedPubKey) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *RotatedPubKey) GetRotatedAt() time.Time {
+	if m != nil {
+		return m.RotatedAt
+	}
+	return time.Time{}
+}
+
+func (m *RotatedPubKey) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // ModuleCredential represents a unclaimable pubkey for base accounts controlled by modules.
 //
 // Since: cosmos-sdk 0.47
@@ -124,7 +251,7 @@ func (m *ModuleCredential) Reset()         { *m = ModuleCredential{} }
 func (m *ModuleCredential) String() string { return proto.CompactTextString(m) }
 func (*ModuleCredential) ProtoMessage()    {}
 func (*ModuleCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
 }
 func (m *ModuleCredential) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,13 +301,34 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// pub_key_rotation_cooldown is the minimum duration between two rotations of
+	// the public key of an account. A zero duration disables the cool-down.
+	//
+	// Since: cosmos-sdk 0.50
+	PubKeyRotationCooldown time.Duration `protobuf:"bytes,6,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3,stdduration" json:"pub_key_rotation_cooldown"`
+	// native_fee_denom is the denom to which the fee_denom_rates convert.
+	//
+	// Since: cosmos-sdk 0.50
+	NativeFeeDenom string `protobuf:"bytes,7,opt,name=native_fee_denom,json=nativeFeeDenom,proto3" json:"native_fee_denom,omitempty"`
+	// fee_denom_rates are the denoms, other than native_fee_denom, accepted to
+	// pay fees, along with their conversion rates to native_fee_denom.
+	//
+	// Since: cosmos-sdk 0.50
+	FeeDenomRates []FeeDenomRate `protobuf:"bytes,8,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates"`
+	// gas_refund_ratio is the portion, between 0 and 1, of the fees paid for the
+	// unused gas of a tx that is refunded to the fee payer, or fee granter, after
+	// the tx is executed successfully, out of the fees not burned. A zero ratio
+	// disables the refunds.
+	//
+	// Since: cosmos-sdk 0.50
+	GasRefundRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=gas_refund_ratio,json=gasRefundRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_refund_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,63 +392,158 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetNativeFeeDenom() string {
+	if m != nil {
+		return m.NativeFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDenomRates() []FeeDenomRate {
+	if m != nil {
+		return m.FeeDenomRates
+	}
+	return nil
+}
+
+// FeeDenomRate defines a denom accepted to pay fees and the amount of the
+// native fee denom one unit of it is worth.
+//
+// Since: cosmos-sdk 0.50
+type FeeDenomRate struct {
+	Denom string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeDenomRate) Reset()         { *m = FeeDenomRate{} }
+func (m *FeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRate) ProtoMessage()    {}
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *FeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRate.Merge(m, src)
+}
+func (m *FeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRate proto.InternalMessageInfo
+
+func (m *FeeDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
+	proto.RegisterType((*AbstractAccount)(nil), "cosmos.auth.v1beta1.AbstractAccount")
+	proto.RegisterType((*RotatedPubKey)(nil), "cosmos.auth.v1beta1.RotatedPubKey")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeDenomRate)(nil), "cosmos.auth.v1beta1.FeeDenomRate")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x9b, 0xb4, 0xdd, 0x4c, 0x9a, 0x7e, 0x78, 0x43, 0x71, 0x03, 0x8a, 0xd3, 0x08, 0xd8,
+	0x50, 0x51, 0x87, 0x06, 0x75, 0x25, 0x7a, 0x6b, 0x52, 0x58, 0x96, 0xfd, 0xa0, 0x72, 0x97, 0x3d,
+	0xec, 0xc5, 0x8c, 0xed, 0x57, 0xc7, 0x6a, 0xec, 0x31, 0x9e, 0x71, 0xa9, 0xf7, 0xcc, 0x61, 0xc5,
+	0x69, 0xc5, 0x89, 0x13, 0x2a, 0x9c, 0x38, 0xf6, 0xd0, 0xff, 0x81, 0xd5, 0x9e, 0xaa, 0x3d, 0x21,
+	0x0e, 0x01, 0xb5, 0x87, 0xae, 0x10, 0xe2, 0x6f, 0x40, 0x9e, 0x71, 0xd2, 0x34, 0xcd, 0x61, 0x05,
+	0xda, 0x4b, 0xe5, 0xf9, 0xbd, 0xaf, 0xdf, 0x7b, 0xef, 0x37, 0xd3, 0xa0, 0x8a, 0x45, 0xa8, 0x47,
+	0x68, 0x03, 0x47, 0xac, 0xd3, 0xd8, 0x5f, 0x33, 0x81, 0xe1, 0x35, 0x7e, 0xd0, 0x82, 0x90, 0x30,
+	0x22, 0x5f, 0x17, 0x76, 0x8d, 0x43, 0xa9, 0xbd, 0xbc, 0x80, 0x3d, 0xd7, 0x27, 0x0d, 0xfe, 0x57,
+	0xf8, 0x95, 0x97, 0x84, 0x9f, 0xc1, 0x4f, 0x8d, 0x34, 0x48, 0x98, 0x4a, 0x0e, 0x71, 0x88, 0xc0,
+	0x93, 0xaf, 0x7e, 0x80, 0x43, 0x88, 0xd3, 0x85, 0x06, 0x3f, 0x99, 0xd1, 0x6e, 0x03, 0xfb, 0x71,
+	0x6a, 0xaa, 0x8c, 0x9a, 0xec, 0x28, 0xc4, 0xcc, 0x25, 0x7e, 0x6a, 0x57, 0x47, 0xed, 0xcc, 0xf5,
+	0x80, 0x32, 0xec, 0x05, 0xc2, 0xa1, 0xf6, 0xd3, 0x04, 0x2a, 0xb4, 0x30, 0x85, 0x4d, 0xcb, 0x22,
+	0x91, 0xcf, 0xe4, 0x26, 0x9a, 0xc6, 0xb6, 0x1d, 0x02, 0xa5, 0x8a, 0x54, 0x95, 0xea, 0xf9, 0x96,
+	0xf2, 0xe2, 0x78, 0xb5, 0x94, 0x92, 0xdc, 0x14, 0x96, 0x1d, 0x16, 0xba, 0xbe, 0xa3, 0xf7, 0x1d,
+	0xe5, 0x87, 0x68, 0x3a, 0x88, 0x4c, 0x63, 0x0f, 0x62, 0x65, 0xa2, 0x2a, 0xd5, 0x0b, 0xcd, 0x92,
+	0x26, 0xca, 0x6a, 0xfd, 0xb2, 0xda, 0xa6, 0x1f, 0xb7, 0x6e, 0xfc, 0xd5, 0x53, 0x4b, 0x41, 0x64,
+	0x76, 0x5d, 0x2b, 0xf1, 0xfd, 0x80, 0x78, 0x2e, 0x03, 0x2f, 0x60, 0xf1, 0xcf, 0xe7, 0x47, 0x2b,
+	0xe8, 0xc2, 0xa0, 0x4f, 0x05, 0x91, 0x79, 0x07, 0x62, 0xf9, 0x5d, 0x34, 0x8b, 0x05, 0x2d, 0xc3,
+	0x8f, 0x3c, 0x13, 0x42, 0x25, 0x5b, 0x95, 0xea, 0x39, 0xbd, 0x98, 0xa2, 0xf7, 0x39, 0x28, 0x97,
+	0xd1, 0x35, 0x0a, 0x5f, 0x47, 0xe0, 0x5b, 0xa0, 0xe4, 0xb8, 0xc3, 0xe0, 0xbc, 0xd1, 0x7e, 0x72,
+	0xa8, 0x66, 0x5e, 0x1e, 0xaa, 0x99, 0xe7, 0xc7, 0xab, 0x6f, 0x8f, 0xd9, 0x8f, 0x96, 0xf6, 0x7d,
+	0xfb, 0xbb, 0xf3, 0xa3, 0x95, 0x45, 0xe1, 0xb0, 0x4a, 0xed, 0xbd, 0xc6, 0xd0, 0x4c, 0x6a, 0x7f,
+	0x4b, 0xa8, 0x78, 0x8f, 0xd8, 0x51, 0x77, 0x30, 0xa5, 0xdb, 0x68, 0xc6, 0xc4, 0x14, 0x8c, 0x94,
+	0x08, 0x1f, 0x55, 0xa1, 0x59, 0xd5, 0xc6, 0x55, 0x18, 0xca, 0xd4, 0xca, 0x9d, 0xf4, 0x54, 0x49,
+	0x2f, 0x98, 0x43, 0x03, 0x97, 0x51, 0xce, 0xc7, 0x1e, 0xf0, 0xc9, 0xe5, 0x75, 0xfe, 0x2d, 0x57,
+	0x51, 0x21, 0x80, 0xd0, 0x73, 0x29, 0x75, 0x89, 0x4f, 0x95, 0x6c, 0x35, 0x5b, 0xcf, 0xeb, 0xc3,
+	0xd0, 0xc6, 0xa3, 0x27, 0xa2, 0xa7, 0xda, 0xb8, 0x8a, 0x97, 0xb8, 0xf2, 0xce, 0x94, 0xa1, 0xce,
+	0x2e, 0x59, 0xbf, 0x3f, 0x3f, 0x5a, 0x99, 0xf5, 0x38, 0xd2, 0x6f, 0xa6, 0xf6, 0xab, 0x84, 0xe6,
+	0x36, 0x4d, 0xca, 0x42, 0x6c, 0xb1, 0xd7, 0xd0, 0xf0, 0x3b, 0xa8, 0x98, 0xb8, 0x83, 0xcf, 0x5c,
+	0x0b, 0x33, 0x12, 0xa6, 0x9d, 0x5f, 0x06, 0xc5, 0xe2, 0x5e, 0x65, 0x69, 0xe5, 0xa1, 0xd6, 0x46,
+	0x58, 0xd7, 0xfe, 0x91, 0x50, 0x51, 0x27, 0x0c, 0x33, 0xb0, 0xb7, 0x85, 0xa4, 0xfe, 0x8b, 0xbc,
+	0x6f, 0xbd, 0x9a, 0xbc, 0x95, 0xe7, 0x17, 0x99, 0xac, 0x30, 0x0e, 0x18, 0xd1, 0x44, 0xd1, 0x81,
+	0x9e, 0x3f, 0x43, 0x28, 0x14, 0x6c, 0x0c, 0xcc, 0xb8, 0x96, 0x0b, 0xcd, 0xf2, 0x95, 0x5c, 0x0f,
+	0xfa, 0x37, 0xb4, 0x55, 0x7c, 0xd6, 0x53, 0x33, 0x4f, 0xff, 0x50, 0xa5, 0x5f, 0xce, 0x8f, 0x56,
+	0x24, 0x3d, 0x9f, 0x06, 0x6f, 0x32, 0x79, 0x11, 0x4d, 0x75, 0xc0, 0x75, 0x3a, 0x8c, 0x0b, 0x3e,
+	0xab, 0xa7, 0xa7, 0xda, 0xb7, 0x12, 0x9a, 0x17, 0xfb, 0x6d, 0x87, 0x60, 0x27, 0xd3, 0xc4, 0x5d,
+	0x59, 0x45, 0x85, 0x74, 0xc3, 0x5c, 0x68, 0xbc, 0x6f, 0x1d, 0x09, 0xe8, 0x7e, 0x22, 0xb7, 0x1b,
+	0x68, 0xce, 0x86, 0xd0, 0xdd, 0xe7, 0x0f, 0x47, 0xd2, 0x27, 0x55, 0x26, 0xaa, 0xd9, 0xfa, 0x8c,
+	0x3e, 0x7b, 0x01, 0xdf, 0x81, 0x98, 0x6e, 0xbc, 0x97, 0x0c, 0x7c, 0x79, 0x68, 0xe0, 0xb7, 0x42,
+	0x12, 0x05, 0xe9, 0xb4, 0x2f, 0x2a, 0xd6, 0x7e, 0x9c, 0x44, 0x53, 0xdb, 0x38, 0xc4, 0x1e, 0x95,
+	0x35, 0x74, 0xdd, 0xc3, 0x07, 0x86, 0x07, 0x1e, 0x31, 0xac, 0x0e, 0x4e, 0xb6, 0x03, 0xa1, 0x18,
+	0x7e, 0x4e, 0x5f, 0xf0, 0xf0, 0xc1, 0x3d, 0xf0, 0x48, 0x7b, 0x60, 0x90, 0xab, 0x68, 0x86, 0x1d,
+	0x18, 0xd4, 0x75, 0x8c, 0xae, 0xeb, 0xb9, 0x8c, 0x4f, 0x3c, 0xa7, 0x23, 0x76, 0xb0, 0xe3, 0x3a,
+	0x77, 0x13, 0x44, 0xfe, 0x10, 0xbd, 0xc1, 0x3d, 0x1e, 0x83, 0x61, 0x11, 0xca, 0x8c, 0x00, 0x42,
+	0xc3, 0x8c, 0x19, 0xa4, 0x8f, 0xc3, 0x42, 0xe2, 0xfa, 0x18, 0xda, 0x84, 0xb2, 0x6d, 0x08, 0x5b,
+	0x31, 0x03, 0xf9, 0x0b, 0xf4, 0x66, 0x92, 0x70, 0x1f, 0x42, 0x77, 0x37, 0x16, 0x41, 0x60, 0x37,
+	0xd7, 0xd7, 0xd7, 0x3e, 0x16, 0xef, 0x45, 0x4b, 0x39, 0xed, 0xa9, 0xa5, 0x1d, 0xd7, 0x79, 0xc8,
+	0x3d, 0x92, 0xd0, 0x4f, 0xb6, 0xb8, 0x5d, 0x2f, 0xd1, 0x4b, 0xa8, 0x88, 0x92, 0xbf, 0x44, 0x4b,
+	0xa3, 0x09, 0x29, 0x58, 0x41, 0x73, 0xfd, 0xe6, 0xde, 0x9a, 0x32, 0xc9, 0x53, 0x96, 0x4f, 0x7b,
+	0xea, 0xe2, 0xa5, 0x94, 0x3b, 0x7d, 0x0f, 0x7d, 0x91, 0x8e, 0xc5, 0x65, 0x0b, 0x2d, 0xa5, 0x42,
+	0x33, 0xf8, 0xaa, 0x93, 0x6d, 0x58, 0x84, 0x74, 0x6d, 0xf2, 0x8d, 0xaf, 0x4c, 0x71, 0xb9, 0x2c,
+	0x5d, 0x91, 0xcb, 0x56, 0xfa, 0xe0, 0x0b, 0xb5, 0xfc, 0x30, 0x50, 0xcb, 0xa2, 0x10, 0x9d, 0x9e,
+	0x26, 0x6a, 0xa7, 0x79, 0xe4, 0x3a, 0x9a, 0xf7, 0x31, 0x73, 0xf7, 0xc1, 0xd8, 0x05, 0x30, 0x6c,
+	0xf0, 0x89, 0xa7, 0x4c, 0x73, 0x49, 0xcc, 0x0a, 0xfc, 0x53, 0x80, 0xad, 0x04, 0x95, 0x1f, 0xa0,
+	0xb9, 0x81, 0x8b, 0x11, 0x62, 0x06, 0x54, 0xb9, 0x56, 0xcd, 0xd6, 0x0b, 0xcd, 0xe5, 0xb1, 0xd7,
+	0xbe, 0x1f, 0xa7, 0x63, 0x06, 0xad, 0x7c, 0x42, 0x46, 0x10, 0x29, 0xee, 0x0e, 0x19, 0xa8, 0xfc,
+	0x15, 0x9a, 0x77, 0x30, 0x35, 0x42, 0xd8, 0x8d, 0x7c, 0xdb, 0xe0, 0xdc, 0x95, 0x3c, 0xbf, 0x8a,
+	0x37, 0x93, 0x98, 0xdf, 0x7b, 0xea, 0x5b, 0x22, 0x3b, 0xb5, 0xf7, 0x34, 0x97, 0x34, 0x3c, 0xcc,
+	0x3a, 0xda, 0x5d, 0x70, 0xb0, 0x15, 0x6f, 0x81, 0xf5, 0xe2, 0x78, 0x15, 0xa5, 0xc5, 0xb7, 0xc0,
+	0x12, 0x05, 0x66, 0x1d, 0x4c, 0x75, 0x9e, 0x4e, 0x4f, 0xb2, 0x6d, 0x2c, 0xbf, 0x3c, 0x54, 0xa5,
+	0xd1, 0x57, 0xef, 0x40, 0xfc, 0xdb, 0x16, 0xaa, 0xac, 0xed, 0xa3, 0x99, 0x61, 0xba, 0x72, 0x09,
+	0x4d, 0x8a, 0x49, 0x88, 0xcb, 0x21, 0x0e, 0xf2, 0xe7, 0x28, 0x97, 0xb4, 0xad, 0x4c, 0xfc, 0x2f,
+	0x7a, 0x3c, 0xc7, 0x46, 0x2e, 0x21, 0xd5, 0x6a, 0x3f, 0x3b, 0xad, 0x48, 0x27, 0xa7, 0x15, 0xe9,
+	0xcf, 0xd3, 0x8a, 0xf4, 0xf4, 0xac, 0x92, 0x39, 0x39, 0xab, 0x64, 0x7e, 0x3b, 0xab, 0x64, 0x1e,
+	0xbd, 0xef, 0xb8, 0xac, 0x13, 0x99, 0x9a, 0x45, 0xbc, 0xf4, 0x27, 0x41, 0xe3, 0x2a, 0x7b, 0x16,
+	0x07, 0x40, 0xcd, 0x29, 0xbe, 0xfb, 0x8f, 0xfe, 0x1d, 0x00, 0xda, 0xe6, 0x8a, 0x9d, 0x90, 0x08,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +580,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
+	if this.NativeFeeDenom != that1.NativeFeeDenom {
+		return false
+	}
+	if len(this.FeeDenomRates) != len(that1.FeeDenomRates) {
+		return false
+	}
+	for i := range this.FeeDenomRates {
+		if !this.FeeDenomRates[i].Equal(&that1.FeeDenomRates[i]) {
+			return false
+		}
+	}
+	if !this.GasRefundRatio.Equal(that1.GasRefundRatio) {
+		return false
+	}
+	return true
+}
+func (this *FeeDenomRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenomRate)
+	if !ok {
+		that2, ok := that.(FeeDenomRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -442,6 +729,103 @@ func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AbstractAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbstractAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbstractAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticator) > 0 {
+		i -= len(m.Authenticator)
+		copy(dAtA[i:], m.Authenticator)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Authenticator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotatedPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatedPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RotatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ModuleCredential) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -501,6 +885,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GasRefundRatio.Size()
+		i -= size
+		if _, err := m.GasRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.FeeDenomRates) > 0 {
+		for iNdEx := len(m.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NativeFeeDenom) > 0 {
+		i -= len(m.NativeFeeDenom)
+		copy(dAtA[i:], m.NativeFeeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.NativeFeeDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PubKeyRotationCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuth(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -529,6 +952,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -586,13 +1049,52 @@ func (m *ModuleAccount) Size() (n int) {
 	return n
 }
 
-func (m *ModuleCredential) Size() (n int) {
+func (m *AbstractAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ModuleName)
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Authenticator)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *RotatedPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RotatedAt)
+	n += 1 + l + sovAuth(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ModuleCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
@@ -626,6 +1128,35 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	l = len(m.NativeFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.FeeDenomRates) > 0 {
+		for _, e := range m.FeeDenomRates {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = m.GasRefundRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *FeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -941,7 +1472,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+func (m *AbstractAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -964,17 +1495,17 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbstractAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbstractAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -984,29 +1515,33 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1016,23 +1551,23 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
-			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			m.Authenticator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1055,7 +1590,7 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RotatedPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,17 +1613,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotatedPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotatedPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1098,16 +1633,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.TxSigLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1117,16 +1665,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedAt", wireType)
 			}
-			m.TxSizeCostPerByte = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1136,16 +1701,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RotatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SigVerifyCostED25519 = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1155,16 +1734,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SigVerifyCostSecp256k1 = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1174,11 +1803,450 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PubKeyRotationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRates = append(m.FeeDenomRates, FeeDenomRate{})
+			if err := m.FeeDenomRates[len(m.FeeDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Authenticator authenticates the transactions signed by abstract accounts, in
+// place of the public key signature verification of the ante handler.
+type Authenticator interface {
+	// Authenticate returns an error if the signature of the request does not
+	// authenticate its account. The gas it consumes is charged to the
+	// transaction.
+	Authenticate(ctx context.Context, req AuthenticationRequest) error
+}
+
+// AuthenticationRequest is the signature of an abstract account to authenticate.
+type AuthenticationRequest struct {
+	// Account is the abstract account signing the transaction.
+	Account AbstractAccountI
+	// Tx is the transaction signed by the account.
+	Tx sdk.Tx
+	// SignMode is the sign mode of the signature.
+	SignMode signing.SignMode
+	// SignBytes are the bytes signed by the account in this sign mode.
+	SignBytes []byte
+	// Signature is the signature of the account.
+	Signature []byte
+	// Simulate is set when the transaction is simulated, in which case the
+	// signature is usually empty: authenticators should then consume the gas
+	// of an actual authentication, without failing on the signature.
+	Simulate bool
+}
+
+// AuthenticatorFunc is an adapter to use ordinary functions as Authenticators.
+type AuthenticatorFunc func(ctx context.Context, req AuthenticationRequest) error
+
+// Authenticate implements Authenticator.
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, req AuthenticationRequest) error {
+	return f(ctx, req)
+}
+
+// AuthenticatorRoute registers an Authenticator of abstract accounts under a
+// name, so that modules can provide authenticators with depinject.
+type AuthenticatorRoute struct {
+	Name          string
+	Authenticator Authenticator
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (AuthenticatorRoute) IsManyPerContainerType() {}
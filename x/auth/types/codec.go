@@ -17,10 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*sdk.AccountI)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
+	cdc.RegisterConcrete(&AbstractAccount{}, "cosmos-sdk/AbstractAccount", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params", nil)
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/MsgRotatePubKey")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -33,6 +35,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&AbstractAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +43,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&AbstractAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -47,6 +51,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*GenesisAccount)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&AbstractAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -57,5 +62,6 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRotatePubKey{},
 	)
 }
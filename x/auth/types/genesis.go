@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -72,7 +73,33 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return ValidateGenUnorderedTxs(data.UnorderedTxs)
+}
+
+// ValidateGenUnorderedTxs checks that the unordered txs have a body hash and a
+// timeout timestamp, and are not duplicated.
+func ValidateGenUnorderedTxs(txs []UnorderedTx) error {
+	seen := make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		if len(tx.TxHash) != sha256.Size {
+			return fmt.Errorf("invalid unordered tx hash %X, expected %d bytes", tx.TxHash, sha256.Size)
+		}
+		if tx.TimeoutTimestamp.IsZero() {
+			return fmt.Errorf("unordered tx %X has no timeout timestamp", tx.TxHash)
+		}
+
+		key := fmt.Sprintf("%X/%d", tx.TxHash, tx.TimeoutTimestamp.UnixNano())
+		if seen[key] {
+			return fmt.Errorf("duplicate unordered tx %X with timeout timestamp %s", tx.TxHash, tx.TimeoutTimestamp)
+		}
+		seen[key] = true
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
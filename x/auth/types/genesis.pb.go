@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// unordered_txs are the unordered txs executed before genesis, which are
+	// protected against replays until their timeout timestamp.
+	//
+	// Since: cosmos-sdk 0.50
+	UnorderedTxs []UnorderedTx `protobuf:"bytes,3,rep,name=unordered_txs,json=unorderedTxs,proto3" json:"unordered_txs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,31 +89,103 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetUnorderedTxs() []UnorderedTx {
+	if m != nil {
+		return m.UnorderedTxs
+	}
+	return nil
+}
+
+// UnorderedTx is an executed unordered tx.
+//
+// Since: cosmos-sdk 0.50
+type UnorderedTx struct {
+	// tx_hash is the hash of the body of the tx.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// timeout_timestamp is the timeout timestamp of the tx.
+	TimeoutTimestamp time.Time `protobuf:"bytes,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp"`
+}
+
+func (m *UnorderedTx) Reset()         { *m = UnorderedTx{} }
+func (m *UnorderedTx) String() string { return proto.CompactTextString(m) }
+func (*UnorderedTx) ProtoMessage()    {}
+func (*UnorderedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *UnorderedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedTx.Merge(m, src)
+}
+func (m *UnorderedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedTx proto.InternalMessageInfo
+
+func (m *UnorderedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *UnorderedTx) GetTimeoutTimestamp() time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*UnorderedTx)(nil), "cosmos.auth.v1beta1.UnorderedTx")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xe2, 0x40,
+	0x14, 0xc7, 0x3b, 0xb0, 0x61, 0xd9, 0x81, 0x4d, 0x96, 0x2e, 0xc9, 0x76, 0xd9, 0xa4, 0xb0, 0x9c,
+	0xd8, 0x4d, 0x9c, 0x11, 0xbc, 0x9b, 0x88, 0x07, 0x4d, 0xbc, 0x68, 0xc5, 0x8b, 0x17, 0x32, 0x2d,
+	0x63, 0xdb, 0x68, 0x3b, 0x4d, 0x67, 0x6a, 0xda, 0x6f, 0xc1, 0xc7, 0xf0, 0xe8, 0xc7, 0xe0, 0x62,
+	0xc2, 0xd1, 0x93, 0x1a, 0x38, 0xf8, 0x35, 0x4c, 0x67, 0x0a, 0x18, 0xe5, 0xd2, 0x4e, 0xde, 0xfb,
+	0xbd, 0xfc, 0xff, 0xff, 0xf7, 0xe0, 0x5f, 0x87, 0xf1, 0x80, 0x71, 0x4c, 0x12, 0xe1, 0xe1, 0xdb,
+	0xbe, 0x4d, 0x05, 0xe9, 0x63, 0x97, 0x86, 0x94, 0xfb, 0x1c, 0x45, 0x31, 0x13, 0x4c, 0xff, 0xa9,
+	0x10, 0x94, 0x23, 0xa8, 0x40, 0x5a, 0xbf, 0x5d, 0xc6, 0xdc, 0x1b, 0x8a, 0x25, 0x62, 0x27, 0x57,
+	0x98, 0x84, 0x99, 0xe2, 0x5b, 0xed, 0x8f, 0x2d, 0xe1, 0x07, 0x94, 0x0b, 0x12, 0x44, 0x05, 0xd0,
+	0x74, 0x99, 0xcb, 0xe4, 0x13, 0xe7, 0xaf, 0xa2, 0x6a, 0x6e, 0x73, 0x22, 0x35, 0x55, 0xbf, 0x41,
+	0x02, 0x3f, 0x64, 0x58, 0x7e, 0x55, 0xa9, 0xfb, 0x00, 0x60, 0xfd, 0x48, 0x79, 0x3d, 0x17, 0x44,
+	0x50, 0x7d, 0x1f, 0x56, 0x22, 0x12, 0x93, 0x80, 0x1b, 0xa0, 0x03, 0x7a, 0xb5, 0xc1, 0x1f, 0xb4,
+	0xc5, 0x3b, 0x3a, 0x95, 0xc8, 0xf0, 0xdb, 0xec, 0xa9, 0xad, 0xdd, 0xbd, 0xde, 0xff, 0x07, 0x56,
+	0x31, 0xa5, 0xef, 0xc2, 0x2a, 0x71, 0x1c, 0x96, 0x84, 0x82, 0x1b, 0xa5, 0x4e, 0xb9, 0x57, 0x1b,
+	0x34, 0x91, 0x4a, 0x83, 0x56, 0x69, 0xd0, 0x41, 0x98, 0x59, 0x6b, 0x4a, 0x3f, 0x81, 0xdf, 0x93,
+	0x90, 0xc5, 0x13, 0x1a, 0xd3, 0xc9, 0x58, 0xa4, 0xdc, 0x28, 0xcb, 0xb1, 0xce, 0x56, 0xe1, 0x8b,
+	0x15, 0x39, 0x4a, 0x87, 0x5f, 0x72, 0x75, 0xab, 0x9e, 0x6c, 0x4a, 0xbc, 0x9b, 0xc1, 0xda, 0x3b,
+	0x44, 0xff, 0x05, 0xbf, 0x8a, 0x74, 0xec, 0x11, 0xee, 0xc9, 0x38, 0x75, 0xab, 0x22, 0xd2, 0x63,
+	0xc2, 0x3d, 0xfd, 0x0c, 0x36, 0xf2, 0x9d, 0xb2, 0x44, 0x8c, 0xd7, 0xbb, 0x35, 0x4a, 0x32, 0x71,
+	0xeb, 0x93, 0xdf, 0xd1, 0x8a, 0x18, 0x56, 0x73, 0xc9, 0xe9, 0x73, 0x1b, 0x58, 0x3f, 0x8a, 0xf1,
+	0x4d, 0xef, 0x70, 0xb6, 0x30, 0xc1, 0x7c, 0x61, 0x82, 0x97, 0x85, 0x09, 0xa6, 0x4b, 0x53, 0x9b,
+	0x2f, 0x4d, 0xed, 0x71, 0x69, 0x6a, 0x97, 0xff, 0x5c, 0x5f, 0x78, 0x89, 0x8d, 0x1c, 0x16, 0xe0,
+	0xe2, 0x44, 0xea, 0xb7, 0xc3, 0x27, 0xd7, 0x38, 0x55, 0xf7, 0x12, 0x59, 0x44, 0xb9, 0x5d, 0x91,
+	0xa2, 0x7b, 0x6f, 0x03, 0x00, 0x93, 0xc7, 0x62, 0xf4, 0x55, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +208,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnorderedTxs) > 0 {
+		for iNdEx := len(m.UnorderedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +249,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +312,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnorderedTxs) > 0 {
+		for _, e := range m.UnorderedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnorderedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +438,157 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedTxs = append(m.UnorderedTxs, UnorderedTx{})
+			if err := m.UnorderedTxs[len(m.UnorderedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnorderedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
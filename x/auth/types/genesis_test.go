@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -52,6 +54,22 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenUnorderedTxs(t *testing.T) {
+	txHash := sha256.Sum256([]byte("body"))
+	timeout := time.Unix(10000, 0).UTC()
+
+	require.NoError(t, types.ValidateGenUnorderedTxs([]types.UnorderedTx{
+		{TxHash: txHash[:], TimeoutTimestamp: timeout},
+		{TxHash: txHash[:], TimeoutTimestamp: timeout.Add(time.Second)},
+	}))
+	require.Error(t, types.ValidateGenUnorderedTxs([]types.UnorderedTx{{TxHash: []byte("body"), TimeoutTimestamp: timeout}}))
+	require.Error(t, types.ValidateGenUnorderedTxs([]types.UnorderedTx{{TxHash: txHash[:]}}))
+	require.Error(t, types.ValidateGenUnorderedTxs([]types.UnorderedTx{
+		{TxHash: txHash[:], TimeoutTimestamp: timeout},
+		{TxHash: txHash[:], TimeoutTimestamp: timeout},
+	}))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
 	cdc := encodingConfig.Codec
@@ -26,6 +26,18 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// UnorderedTxsKeyPrefix prefix for the store of unordered tx body hashes by
+	// timeout timestamp
+	UnorderedTxsKeyPrefix = collections.NewPrefix(3)
+
+	// PubKeyRotationTimesKeyPrefix prefix for the store of the time of the last
+	// public key rotation by account address
+	PubKeyRotationTimesKeyPrefix = collections.NewPrefix(4)
+
+	// RotatedPubKeysKeyPrefix prefix for the store of the rotated public keys
+	// by public key address and account address
+	RotatedPubKeysKeyPrefix = collections.NewPrefix(5)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
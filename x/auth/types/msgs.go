@@ -0,0 +1,31 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRotatePubKey{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRotatePubKey{}
+)
+
+// NewMsgRotatePubKey returns a reference to a new MsgRotatePubKey.
+func NewMsgRotatePubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	anyPk, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:   addr.String(),
+		NewPubKey: anyPk,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
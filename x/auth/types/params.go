@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
@@ -11,16 +16,25 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultPubKeyRotationCooldown = 24 * time.Hour
 )
 
+// DefaultGasRefundRatio is the default gas refund ratio, disabling the refunds.
+var DefaultGasRefundRatio = math.LegacyZeroDec()
+
 // NewParams creates a new Params object
-func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+func NewParams(
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	pubKeyRotationCooldown time.Duration,
+) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
 		TxSigLimit:             txSigLimit,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		PubKeyRotationCooldown: pubKeyRotationCooldown,
 	}
 }
 
@@ -32,6 +46,8 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
+		GasRefundRatio:         DefaultGasRefundRatio,
 	}
 }
 
@@ -112,6 +128,101 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePubKeyRotationCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("public key rotation cool-down cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeDenomRates(nativeDenom string, rates []FeeDenomRate) error {
+	if len(rates) == 0 {
+		if nativeDenom != "" {
+			return sdk.ValidateDenom(nativeDenom)
+		}
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(nativeDenom); err != nil {
+		return fmt.Errorf("invalid native fee denom: %w", err)
+	}
+
+	seen := make(map[string]bool, len(rates))
+	for _, r := range rates {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+		if r.Denom == nativeDenom {
+			return fmt.Errorf("fee denom %s cannot be the native fee denom", r.Denom)
+		}
+		if seen[r.Denom] {
+			return fmt.Errorf("duplicate fee denom: %s", r.Denom)
+		}
+		seen[r.Denom] = true
+
+		if r.Rate.IsNil() || !r.Rate.IsPositive() {
+			return fmt.Errorf("fee denom %s rate must be positive: %s", r.Denom, r.Rate)
+		}
+	}
+
+	return nil
+}
+
+func validateGasRefundRatio(ratio math.LegacyDec) error {
+	// a nil ratio, e.g. of params stored before its introduction, disables the
+	// refunds
+	if ratio.IsNil() {
+		return nil
+	}
+
+	if ratio.IsNegative() || ratio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("gas refund ratio must be between 0 and 1: %s", ratio)
+	}
+
+	return nil
+}
+
+// FeeDenomRate returns the conversion rate of the given denom to the native
+// fee denom, and whether the denom is accepted to pay fees.
+func (p Params) FeeDenomRate(denom string) (math.LegacyDec, bool) {
+	for _, r := range p.FeeDenomRates {
+		if r.Denom == denom {
+			return r.Rate, true
+		}
+	}
+
+	return math.LegacyDec{}, false
+}
+
+// ConvertFees returns the fees with all the coins of an accepted fee denom
+// replaced by their value in the native fee denom, truncated. Coins of other
+// denoms are returned unchanged.
+func (p Params) ConvertFees(fees sdk.Coins) sdk.Coins {
+	if len(p.FeeDenomRates) == 0 {
+		return fees
+	}
+
+	converted := sdk.NewCoins()
+	native := math.ZeroInt()
+	for _, c := range fees {
+		rate, ok := p.FeeDenomRate(c.Denom)
+		if !ok {
+			converted = converted.Add(c)
+			continue
+		}
+
+		native = native.Add(rate.MulInt(c.Amount).TruncateInt())
+	}
+
+	return converted.Add(sdk.NewCoin(p.NativeFeeDenom, native))
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +240,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePubKeyRotationCooldown(p.PubKeyRotationCooldown); err != nil {
+		return err
+	}
+	if err := validateFeeDenomRates(p.NativeFeeDenom, p.FeeDenomRates); err != nil {
+		return err
+	}
+	if err := validateGasRefundRatio(p.GasRefundRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -3,9 +3,13 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -26,15 +30,32 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyRotationCooldown), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
+			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyRotationCooldown), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
 		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			types.DefaultSigVerifyCostED25519, 0, types.DefaultPubKeyRotationCooldown), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyRotationCooldown), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyRotationCooldown), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"negative public key rotation cool-down", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, -time.Second), fmt.Errorf("public key rotation cool-down cannot be negative: -1s")},
+		{"valid fee denom rates", withFeeDenomRates("stake", types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyNewDec(2)}), nil},
+		{"fee denom rates without native fee denom", withFeeDenomRates("", types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyNewDec(2)}),
+			fmt.Errorf("invalid native fee denom: %w", fmt.Errorf("invalid denom: "))},
+		{"native fee denom in fee denom rates", withFeeDenomRates("stake", types.FeeDenomRate{Denom: "stake", Rate: math.LegacyOneDec()}),
+			fmt.Errorf("fee denom stake cannot be the native fee denom")},
+		{"duplicate fee denom", withFeeDenomRates("stake", types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyOneDec()}, types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyOneDec()}),
+			fmt.Errorf("duplicate fee denom: usdc")},
+		{"non-positive fee denom rate", withFeeDenomRates("stake", types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyZeroDec()}),
+			fmt.Errorf("fee denom usdc rate must be positive: 0.000000000000000000")},
+		{"valid gas refund ratio", withGasRefundRatio(math.LegacyNewDecWithPrec(5, 1)), nil},
+		{"nil gas refund ratio", withGasRefundRatio(math.LegacyDec{}), nil},
+		{"negative gas refund ratio", withGasRefundRatio(math.LegacyNewDec(-1)),
+			fmt.Errorf("gas refund ratio must be between 0 and 1: -1.000000000000000000")},
+		{"gas refund ratio greater than 1", withGasRefundRatio(math.LegacyNewDec(2)),
+			fmt.Errorf("gas refund ratio must be between 0 and 1: 2.000000000000000000")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -50,3 +71,34 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withFeeDenomRates(nativeDenom string, rates ...types.FeeDenomRate) types.Params {
+	params := types.DefaultParams()
+	params.NativeFeeDenom = nativeDenom
+	params.FeeDenomRates = rates
+	return params
+}
+
+func withGasRefundRatio(ratio math.LegacyDec) types.Params {
+	params := types.DefaultParams()
+	params.GasRefundRatio = ratio
+	return params
+}
+
+func TestParams_ConvertFees(t *testing.T) {
+	params := withFeeDenomRates("stake",
+		types.FeeDenomRate{Denom: "usdc", Rate: math.LegacyNewDec(2)},
+		types.FeeDenomRate{Denom: "usdt", Rate: math.LegacyNewDecWithPrec(5, 1)},
+	)
+
+	// coins of accepted denoms are converted and merged into the native denom
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 10), sdk.NewInt64Coin("usdt", 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 31)), params.ConvertFees(fees))
+
+	// coins of other denoms are kept as is
+	fees = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("usdc", 10))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 20)), params.ConvertFees(fees))
+
+	// without fee denom rates the fees are unchanged
+	require.Equal(t, fees, types.DefaultParams().ConvertFees(fees))
+}
@@ -11,3 +11,14 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryRotatedPubKeysResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range m.RotatedPubKeys {
+		if err := m.RotatedPubKeys[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryRotatedPubKeysResponse{}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -962,6 +963,207 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAuthenticatorsRequest is the Query/Authenticators request type.
+//
+// Since: cosmos-sdk 0.50
+type QueryAuthenticatorsRequest struct {
+}
+
+func (m *QueryAuthenticatorsRequest) Reset()         { *m = QueryAuthenticatorsRequest{} }
+func (m *QueryAuthenticatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthenticatorsRequest) ProtoMessage()    {}
+func (*QueryAuthenticatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryAuthenticatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthenticatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthenticatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthenticatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthenticatorsRequest.Merge(m, src)
+}
+func (m *QueryAuthenticatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthenticatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthenticatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthenticatorsRequest proto.InternalMessageInfo
+
+// QueryAuthenticatorsResponse is the Query/Authenticators response type.
+//
+// Since: cosmos-sdk 0.50
+type QueryAuthenticatorsResponse struct {
+	// names are the names of the registered authenticators, in lexical order.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *QueryAuthenticatorsResponse) Reset()         { *m = QueryAuthenticatorsResponse{} }
+func (m *QueryAuthenticatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthenticatorsResponse) ProtoMessage()    {}
+func (*QueryAuthenticatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryAuthenticatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthenticatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthenticatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthenticatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthenticatorsResponse.Merge(m, src)
+}
+func (m *QueryAuthenticatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthenticatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthenticatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthenticatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAuthenticatorsResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+// QueryRotatedPubKeysRequest is the Query/RotatedPubKeys request type.
+//
+// Since: cosmos-sdk 0.50
+type QueryRotatedPubKeysRequest struct {
+	// pub_key_address is the address of the rotated public key.
+	PubKeyAddress string `protobuf:"bytes,1,opt,name=pub_key_address,json=pubKeyAddress,proto3" json:"pub_key_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRotatedPubKeysRequest) Reset()         { *m = QueryRotatedPubKeysRequest{} }
+func (m *QueryRotatedPubKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRotatedPubKeysRequest) ProtoMessage()    {}
+func (*QueryRotatedPubKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryRotatedPubKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRotatedPubKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRotatedPubKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRotatedPubKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRotatedPubKeysRequest.Merge(m, src)
+}
+func (m *QueryRotatedPubKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRotatedPubKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRotatedPubKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRotatedPubKeysRequest proto.InternalMessageInfo
+
+func (m *QueryRotatedPubKeysRequest) GetPubKeyAddress() string {
+	if m != nil {
+		return m.PubKeyAddress
+	}
+	return ""
+}
+
+func (m *QueryRotatedPubKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRotatedPubKeysResponse is the Query/RotatedPubKeys response type.
+//
+// Since: cosmos-sdk 0.50
+type QueryRotatedPubKeysResponse struct {
+	// rotated_pub_keys are the rotations of the public key, by account address.
+	RotatedPubKeys []RotatedPubKey `protobuf:"bytes,1,rep,name=rotated_pub_keys,json=rotatedPubKeys,proto3" json:"rotated_pub_keys"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRotatedPubKeysResponse) Reset()         { *m = QueryRotatedPubKeysResponse{} }
+func (m *QueryRotatedPubKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRotatedPubKeysResponse) ProtoMessage()    {}
+func (*QueryRotatedPubKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryRotatedPubKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRotatedPubKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRotatedPubKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRotatedPubKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRotatedPubKeysResponse.Merge(m, src)
+}
+func (m *QueryRotatedPubKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRotatedPubKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRotatedPubKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRotatedPubKeysResponse proto.InternalMessageInfo
+
+func (m *QueryRotatedPubKeysResponse) GetRotatedPubKeys() []RotatedPubKey {
+	if m != nil {
+		return m.RotatedPubKeys
+	}
+	return nil
+}
+
+func (m *QueryRotatedPubKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,79 +1185,95 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAuthenticatorsRequest)(nil), "cosmos.auth.v1beta1.QueryAuthenticatorsRequest")
+	proto.RegisterType((*QueryAuthenticatorsResponse)(nil), "cosmos.auth.v1beta1.QueryAuthenticatorsResponse")
+	proto.RegisterType((*QueryRotatedPubKeysRequest)(nil), "cosmos.auth.v1beta1.QueryRotatedPubKeysRequest")
+	proto.RegisterType((*QueryRotatedPubKeysResponse)(nil), "cosmos.auth.v1beta1.QueryRotatedPubKeysResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x34, 0x2b, 0xf1, 0xe3, 0x54, 0xef, 0x02, 0xea, 0x61, 0x51, 0x70,
-	0x57, 0x08, 0x71, 0x20, 0x72, 0x62, 0x27, 0xb5, 0xd8, 0x78, 0xb2, 0xb1, 0x03, 0x1b, 0xaa, 0x5c,
-	0x90, 0x90, 0x7a, 0x41, 0x42, 0x82, 0x3f, 0x60, 0x0f, 0x88, 0xf3, 0x22, 0x95, 0x1b, 0x7f, 0xc0,
-	0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x1b, 0xff, 0x02, 0xca, 0xcc, 0xb3, 0x63,
-	0xb7, 0x93, 0xc4, 0x85, 0x53, 0x9d, 0x99, 0xf7, 0xbe, 0xef, 0x33, 0x6f, 0x9e, 0xfd, 0x2d, 0x14,
-	0x9a, 0xd4, 0xeb, 0x50, 0x4f, 0x33, 0xfb, 0xfe, 0x91, 0xf6, 0xd9, 0x6e, 0xc3, 0xf6, 0xcd, 0x5d,
-	0xed, 0x61, 0xdf, 0xee, 0x0d, 0xd4, 0x6e, 0x8f, 0xfa, 0x94, 0xac, 0xf2, 0x00, 0x75, 0x14, 0xa0,
-	0x62, 0x80, 0xbc, 0x83, 0x59, 0x0d, 0xd3, 0xb3, 0x79, 0x74, 0x98, 0xdb, 0x35, 0xdb, 0x8e, 0x6b,
-	0xfa, 0x0e, 0x75, 0xb9, 0x80, 0x9c, 0x69, 0xd3, 0x36, 0x65, 0x8f, 0xda, 0xe8, 0x09, 0x57, 0x5f,
-	0x6e, 0x53, 0xda, 0x7e, 0x60, 0x6b, 0xec, 0x57, 0xa3, 0xdf, 0xd2, 0x4c, 0x17, 0x2b, 0xca, 0x6b,
-	0xb8, 0x65, 0x76, 0x1d, 0xcd, 0x74, 0x5d, 0xea, 0x33, 0x35, 0x0f, 0x77, 0xf3, 0x22, 0x60, 0x06,
-	0x87, 0xc2, 0x7c, 0xbf, 0xce, 0x2b, 0x22, 0x3c, 0xdf, 0xca, 0x61, 0x6a, 0x00, 0x1c, 0x3d, 0xa7,
-	0xf2, 0x09, 0x64, 0x3e, 0x18, 0xfd, 0xdc, 0x6f, 0x36, 0x69, 0xdf, 0xf5, 0x3d, 0xc3, 0x7e, 0xd8,
-	0xb7, 0x3d, 0x9f, 0xbc, 0x0b, 0x30, 0x3e, 0x52, 0x56, 0x2a, 0x4a, 0xa5, 0xe5, 0xca, 0xa6, 0x8a,
-	0xba, 0xa3, 0xf3, 0xab, 0x5c, 0x05, 0x51, 0xd4, 0x9a, 0xd9, 0xb6, 0x31, 0xd7, 0x88, 0x64, 0x2a,
-	0xa7, 0x12, 0xdc, 0xbc, 0x50, 0xc0, 0xeb, 0x52, 0xd7, 0xb3, 0x89, 0x01, 0x4b, 0x26, 0xae, 0x65,
-	0xa5, 0xe2, 0xb5, 0xd2, 0x72, 0x25, 0xa3, 0xf2, 0x16, 0xa8, 0x41, 0x77, 0xd4, 0x7d, 0x77, 0xa0,
-	0x17, 0x9f, 0x9d, 0x96, 0xd7, 0x04, 0xb7, 0xa1, 0xa2, 0xe2, 0x81, 0x11, 0xea, 0x90, 0xf7, 0x62,
-	0xd4, 0x73, 0x8c, 0x7a, 0x6b, 0x26, 0x35, 0x07, 0x8a, 0x61, 0x1f, 0xc2, 0x6a, 0x94, 0x3a, 0xe8,
-	0x4a, 0x05, 0x16, 0x4d, 0xcb, 0xea, 0xd9, 0x9e, 0xc7, 0x5a, 0x72, 0x5d, 0xcf, 0xfe, 0x72, 0x5a,
-	0xce, 0xa0, 0xfe, 0x3e, 0xdf, 0x39, 0xf4, 0x7b, 0x8e, 0xdb, 0x36, 0x82, 0xc0, 0xb7, 0x96, 0x4e,
-	0x1e, 0x17, 0x52, 0x7f, 0x3f, 0x2e, 0xa4, 0x94, 0xa3, 0x78, 0xaf, 0xc3, 0x4e, 0xd4, 0x60, 0x11,
-	0x4f, 0x80, 0x8d, 0xfe, 0xaf, 0x8d, 0x08, 0x64, 0x94, 0x0c, 0x10, 0x56, 0xa9, 0x66, 0xf6, 0xcc,
-	0x4e, 0x70, 0xa7, 0x4a, 0x0d, 0x0f, 0x15, 0xac, 0x62, 0xf9, 0x37, 0x61, 0xa1, 0xcb, 0x56, 0xb0,
-	0x7a, 0x4e, 0x15, 0x15, 0xe1, 0x49, 0xfa, 0xfc, 0xd3, 0xdf, 0x0b, 0x29, 0x03, 0x13, 0x94, 0x35,
-	0x90, 0x99, 0xe2, 0x3d, 0x6a, 0xf5, 0x1f, 0xd8, 0x17, 0x66, 0x48, 0xf9, 0x1c, 0x72, 0xc2, 0x5d,
-	0xac, 0xfb, 0x51, 0xc2, 0x01, 0xd8, 0x7c, 0x76, 0x5a, 0x56, 0x44, 0x48, 0x31, 0xdd, 0xc8, 0x18,
-	0x28, 0xb7, 0xa1, 0x70, 0xb9, 0xb0, 0x3e, 0x78, 0xdf, 0xec, 0x04, 0x33, 0x4a, 0x08, 0xcc, 0xbb,
-	0x66, 0xc7, 0xe6, 0xd7, 0x68, 0xb0, 0x67, 0xe5, 0x0b, 0x28, 0x4e, 0x4e, 0x43, 0xe8, 0x0f, 0x93,
-	0xdd, 0x55, 0x52, 0xe6, 0xf0, 0xc6, 0x6e, 0xc2, 0xaa, 0x6e, 0x37, 0x8f, 0xf6, 0x2a, 0xb5, 0x9e,
-	0xdd, 0x72, 0x1e, 0x05, 0x2d, 0x7c, 0x1b, 0x32, 0xf1, 0x65, 0xc4, 0xd8, 0x80, 0x1b, 0x0d, 0xb6,
-	0x5e, 0xef, 0xb2, 0x0d, 0x3c, 0x47, 0xba, 0x11, 0x09, 0x56, 0x74, 0xc8, 0xe1, 0x4c, 0xea, 0x03,
-	0xdf, 0xf6, 0xee, 0x53, 0x1c, 0x4d, 0x6c, 0xc1, 0x06, 0xdc, 0xc0, 0x19, 0xad, 0x37, 0x46, 0xfb,
-	0x4c, 0x23, 0x6d, 0xa4, 0xcd, 0x48, 0x8e, 0xf2, 0x0e, 0xac, 0x89, 0x35, 0x10, 0xe4, 0x55, 0x58,
-	0x09, 0x44, 0x3c, 0xb6, 0x83, 0x24, 0x81, 0x34, 0x0f, 0x57, 0xee, 0x86, 0x28, 0x7c, 0xe1, 0x3e,
-	0x65, 0x72, 0x01, 0x4a, 0x42, 0x95, 0x3b, 0x21, 0xcc, 0x05, 0x95, 0x71, 0x57, 0x66, 0x9f, 0xe8,
-	0x10, 0xf2, 0xd1, 0xb7, 0x30, 0x3c, 0xdd, 0xc1, 0xdd, 0xf1, 0x6c, 0xcc, 0x39, 0x16, 0xcb, 0xbd,
-	0xa6, 0xcf, 0x65, 0x25, 0x63, 0xce, 0xb1, 0xc8, 0x3a, 0x00, 0x5e, 0x55, 0xdd, 0xb1, 0xd8, 0x97,
-	0x65, 0xde, 0xb8, 0x8e, 0x2b, 0x07, 0x96, 0x62, 0xe1, 0xc4, 0x89, 0x44, 0x11, 0x6e, 0x1f, 0x5e,
-	0x0c, 0x14, 0x92, 0x7e, 0x43, 0x56, 0xcc, 0x98, 0x9c, 0x72, 0x0f, 0x5e, 0x8a, 0x56, 0x39, 0x70,
-	0x5b, 0xf4, 0x7f, 0x7c, 0x99, 0x94, 0x1a, 0x64, 0x2f, 0xcb, 0x21, 0x6d, 0x15, 0xe6, 0x1d, 0xb7,
-	0x45, 0x71, 0xc8, 0x8b, 0xc2, 0x4f, 0x82, 0x6e, 0x7a, 0xc1, 0x24, 0x1b, 0x2c, 0xba, 0xf2, 0x4f,
-	0x1a, 0x5e, 0x60, 0x92, 0xe4, 0x6b, 0x09, 0x96, 0x82, 0x37, 0x9e, 0x6c, 0x0b, 0xd3, 0x45, 0xbe,
-	0x23, 0xef, 0x24, 0x09, 0xe5, 0x8c, 0xca, 0xce, 0xc9, 0x5f, 0x4f, 0x76, 0xa4, 0x2f, 0x7f, 0xfd,
-	0xf3, 0xdb, 0xb9, 0x02, 0x59, 0xd7, 0x84, 0x0e, 0x19, 0x20, 0x7c, 0x27, 0xc1, 0x22, 0x0a, 0x90,
-	0xd2, 0xcc, 0x1a, 0x01, 0xcd, 0x76, 0x82, 0x48, 0x84, 0xa9, 0x8e, 0x61, 0xb6, 0xc9, 0xd6, 0x54,
-	0x18, 0xed, 0x18, 0x6f, 0x60, 0x48, 0x7e, 0x92, 0x80, 0x5c, 0x9e, 0x19, 0xb2, 0x37, 0xb3, 0xee,
-	0xe5, 0xb1, 0x95, 0xab, 0x57, 0x4b, 0xba, 0x02, 0x77, 0xf8, 0x4e, 0xd5, 0x1d, 0x4b, 0x3b, 0x76,
-	0xac, 0x21, 0xf9, 0x4a, 0x82, 0x05, 0xee, 0x08, 0x64, 0x6b, 0x72, 0xd9, 0x98, 0xfd, 0xc8, 0xa5,
-	0xd9, 0x81, 0xc8, 0x54, 0x1a, 0x33, 0xad, 0x93, 0x9c, 0x90, 0x89, 0x1b, 0x10, 0xf9, 0x41, 0x82,
-	0x95, 0xb8, 0xbd, 0x10, 0x6d, 0x72, 0x19, 0xa1, 0x4d, 0xc9, 0xaf, 0x27, 0x4f, 0x40, 0xbe, 0xdd,
-	0x31, 0xdf, 0x26, 0x79, 0x45, 0xc8, 0xd7, 0x61, 0x99, 0xf5, 0x70, 0xfe, 0x7e, 0x96, 0x60, 0x55,
-	0xe0, 0x2b, 0xa4, 0x9a, 0xb0, 0x78, 0xcc, 0xbd, 0xe4, 0xdb, 0x57, 0xcc, 0x42, 0xee, 0x37, 0xc6,
-	0xdc, 0x65, 0xf2, 0x5a, 0x12, 0x6e, 0xed, 0x78, 0xe4, 0x8c, 0x43, 0x72, 0x22, 0x41, 0x3a, 0x6a,
-	0x44, 0x13, 0xde, 0x21, 0x81, 0x85, 0x4d, 0x78, 0x87, 0x44, 0xae, 0xa6, 0x6c, 0x4c, 0xbd, 0x72,
-	0xee, 0x6d, 0xe4, 0x89, 0x04, 0x19, 0x91, 0x25, 0x11, 0xf1, 0x3d, 0x4e, 0x71, 0x40, 0x79, 0xf7,
-	0x0a, 0x19, 0x88, 0xb8, 0x37, 0xb5, 0x7b, 0x1c, 0x31, 0x7c, 0xbf, 0xb9, 0x0b, 0x0d, 0xc9, 0x8f,
-	0x63, 0xe4, 0x98, 0x71, 0x4d, 0x47, 0x16, 0x39, 0xe5, 0x74, 0x64, 0xa1, 0x2b, 0x2a, 0x55, 0x86,
-	0xac, 0x92, 0x5b, 0x89, 0x90, 0xb9, 0xff, 0x0e, 0xc9, 0xf7, 0x12, 0x2c, 0x47, 0x8c, 0x81, 0xdc,
-	0x9a, 0xf9, 0x75, 0x89, 0xd8, 0x91, 0x5c, 0x4e, 0x18, 0x9d, 0x7c, 0x30, 0x43, 0xf7, 0x75, 0x5b,
-	0x74, 0xfc, 0x01, 0xd5, 0xef, 0x3c, 0x3d, 0xcb, 0x4b, 0xcf, 0xcf, 0xf2, 0xd2, 0x1f, 0x67, 0x79,
-	0xe9, 0x9b, 0xf3, 0x7c, 0xea, 0xf9, 0x79, 0x3e, 0xf5, 0xdb, 0x79, 0x3e, 0xf5, 0xf1, 0x76, 0xdb,
-	0xf1, 0x8f, 0xfa, 0x0d, 0xb5, 0x49, 0x3b, 0x81, 0x20, 0xff, 0x53, 0xf6, 0xac, 0x4f, 0xb5, 0x47,
-	0x5c, 0xdd, 0x1f, 0x74, 0x6d, 0xaf, 0xb1, 0xc0, 0xfe, 0x77, 0xdb, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0xa2, 0x5f, 0xef, 0x16, 0x0e, 0x00, 0x00,
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xc7, 0xe3, 0xac, 0xeb, 0x8f, 0xd3, 0x36, 0x85, 0xdb, 0x4c, 0x14, 0xa7, 0x4d, 0x2a, 0x97,
+	0xf5, 0xd7, 0x16, 0xbb, 0xbf, 0x26, 0x18, 0xbc, 0x50, 0xaf, 0x80, 0x2a, 0x34, 0x14, 0xdc, 0x09,
+	0x10, 0x0f, 0x44, 0x4e, 0xec, 0xa6, 0xd6, 0x16, 0x3b, 0x8b, 0x1d, 0x58, 0xa8, 0xf2, 0x82, 0x84,
+	0xd4, 0x17, 0x24, 0x24, 0xf8, 0x03, 0xfa, 0x80, 0x26, 0x1e, 0x87, 0x54, 0x1e, 0x90, 0x90, 0x78,
+	0x9d, 0xf6, 0x80, 0x26, 0x78, 0xe1, 0x09, 0xa1, 0x16, 0x09, 0xfe, 0x0c, 0x94, 0x7b, 0x8f, 0x1d,
+	0x3b, 0xbd, 0x49, 0xdc, 0xb1, 0x97, 0x36, 0xb9, 0xf7, 0x9c, 0xef, 0xf9, 0xdc, 0x73, 0x8f, 0x8f,
+	0x4f, 0x20, 0x57, 0x76, 0xdc, 0xaa, 0xe3, 0x2a, 0x7a, 0xc3, 0x3b, 0x50, 0x3e, 0x5d, 0x2f, 0x99,
+	0x9e, 0xbe, 0xae, 0xdc, 0x6f, 0x98, 0xf5, 0xa6, 0x5c, 0xab, 0x3b, 0x9e, 0x43, 0xa6, 0x99, 0x81,
+	0xdc, 0x36, 0x90, 0xd1, 0x40, 0x7c, 0x51, 0xaf, 0x5a, 0xb6, 0xa3, 0xd0, 0xbf, 0xcc, 0x4e, 0x5c,
+	0x45, 0xa1, 0x92, 0xee, 0x9a, 0x4c, 0x20, 0x90, 0xab, 0xe9, 0x15, 0xcb, 0xd6, 0x3d, 0xcb, 0xb1,
+	0xd1, 0x36, 0x5d, 0x71, 0x2a, 0x0e, 0xfd, 0xa8, 0xb4, 0x3f, 0xe1, 0xea, 0xcb, 0x15, 0xc7, 0xa9,
+	0xdc, 0x33, 0x15, 0xfa, 0xad, 0xd4, 0xd8, 0x57, 0x74, 0x1b, 0x21, 0xc4, 0x59, 0xdc, 0xd2, 0x6b,
+	0x96, 0xa2, 0xdb, 0xb6, 0xe3, 0x51, 0x35, 0x17, 0x77, 0xb3, 0xbc, 0x33, 0x50, 0x5e, 0x14, 0x66,
+	0xfb, 0x45, 0x16, 0x11, 0xcf, 0xc3, 0xb6, 0x32, 0xe8, 0xea, 0x03, 0x87, 0x8f, 0x2e, 0x7d, 0x02,
+	0xe9, 0xf7, 0xdb, 0x5f, 0xb7, 0xcb, 0x65, 0xa7, 0x61, 0x7b, 0xae, 0x66, 0xde, 0x6f, 0x98, 0xae,
+	0x47, 0xde, 0x06, 0xe8, 0x1c, 0x69, 0x46, 0x98, 0x17, 0x96, 0xc7, 0x37, 0x16, 0x65, 0xd4, 0x6d,
+	0x9f, 0x5f, 0x66, 0x2a, 0x88, 0x22, 0x17, 0xf4, 0x8a, 0x89, 0xbe, 0x5a, 0xc8, 0x53, 0x3a, 0x11,
+	0xe0, 0x4a, 0x57, 0x00, 0xb7, 0xe6, 0xd8, 0xae, 0x49, 0x34, 0x18, 0xd5, 0x71, 0x6d, 0x46, 0x98,
+	0xbf, 0xb4, 0x3c, 0xbe, 0x91, 0x96, 0x59, 0x0a, 0x64, 0x3f, 0x3b, 0xf2, 0xb6, 0xdd, 0x54, 0xe7,
+	0x9f, 0x9c, 0xe4, 0x67, 0x39, 0x17, 0x24, 0xa3, 0xe2, 0xae, 0x16, 0xe8, 0x90, 0x77, 0x22, 0xd4,
+	0x49, 0x4a, 0xbd, 0x34, 0x90, 0x9a, 0x01, 0x45, 0xb0, 0xf7, 0x60, 0x3a, 0x4c, 0xed, 0x67, 0x65,
+	0x03, 0x46, 0x74, 0xc3, 0xa8, 0x9b, 0xae, 0x4b, 0x53, 0x32, 0xa6, 0xce, 0xfc, 0x76, 0x92, 0x4f,
+	0xa3, 0xfe, 0x36, 0xdb, 0xd9, 0xf3, 0xea, 0x96, 0x5d, 0xd1, 0x7c, 0xc3, 0xd7, 0x47, 0x8f, 0x8e,
+	0x73, 0x89, 0x7f, 0x8f, 0x73, 0x09, 0xe9, 0x20, 0x9a, 0xeb, 0x20, 0x13, 0x05, 0x18, 0xc1, 0x13,
+	0x60, 0xa2, 0x9f, 0x35, 0x11, 0xbe, 0x8c, 0x94, 0x06, 0x42, 0x23, 0x15, 0xf4, 0xba, 0x5e, 0xf5,
+	0xef, 0x54, 0x2a, 0xc0, 0x74, 0x64, 0x15, 0xc3, 0xdf, 0x84, 0xe1, 0x1a, 0x5d, 0xc1, 0xe8, 0x19,
+	0x99, 0x17, 0x84, 0x39, 0xa9, 0x43, 0x8f, 0xff, 0xcc, 0x25, 0x34, 0x74, 0x90, 0x66, 0x41, 0xa4,
+	0x8a, 0xb7, 0x1d, 0xa3, 0x71, 0xcf, 0xec, 0xaa, 0x21, 0xe9, 0x33, 0xc8, 0x70, 0x77, 0x31, 0xee,
+	0x47, 0x31, 0x0b, 0x60, 0xf1, 0xc9, 0x49, 0x5e, 0xe2, 0x21, 0x45, 0x74, 0x43, 0x65, 0x20, 0xdd,
+	0x80, 0xdc, 0xf9, 0xc0, 0x6a, 0xf3, 0x3d, 0xbd, 0xea, 0xd7, 0x28, 0x21, 0x30, 0x64, 0xeb, 0x55,
+	0x93, 0x5d, 0xa3, 0x46, 0x3f, 0x4b, 0x9f, 0xc3, 0x7c, 0x6f, 0x37, 0x84, 0xfe, 0x20, 0xde, 0x5d,
+	0xc5, 0x65, 0x0e, 0x6e, 0xec, 0x0a, 0x4c, 0xab, 0x66, 0xf9, 0x60, 0x73, 0xa3, 0x50, 0x37, 0xf7,
+	0xad, 0x07, 0x7e, 0x0a, 0xdf, 0x80, 0x74, 0x74, 0x19, 0x31, 0x16, 0x60, 0xb2, 0x44, 0xd7, 0x8b,
+	0x35, 0xba, 0x81, 0xe7, 0x98, 0x28, 0x85, 0x8c, 0x25, 0x15, 0x32, 0x58, 0x93, 0x6a, 0xd3, 0x33,
+	0xdd, 0x3b, 0x0e, 0x96, 0x26, 0xa6, 0x60, 0x01, 0x26, 0xb1, 0x46, 0x8b, 0xa5, 0xf6, 0x3e, 0xd5,
+	0x98, 0xd0, 0x26, 0xf4, 0x90, 0x8f, 0xf4, 0x16, 0xcc, 0xf2, 0x35, 0x10, 0xe4, 0x2a, 0xa4, 0x7c,
+	0x11, 0x97, 0xee, 0x20, 0x89, 0x2f, 0xcd, 0xcc, 0xa5, 0x9d, 0x00, 0x85, 0x2d, 0xdc, 0x71, 0xa8,
+	0x9c, 0x8f, 0x12, 0x53, 0xe5, 0x56, 0x00, 0xd3, 0xa5, 0xd2, 0xc9, 0xca, 0xe0, 0x13, 0xed, 0x41,
+	0x36, 0xfc, 0x14, 0x06, 0xa7, 0xdb, 0xdd, 0xe9, 0xd4, 0x46, 0xd2, 0x32, 0xa8, 0xef, 0x25, 0x35,
+	0x39, 0x23, 0x68, 0x49, 0xcb, 0x20, 0x73, 0x00, 0x78, 0x55, 0x45, 0xcb, 0xa0, 0x9d, 0x65, 0x48,
+	0x1b, 0xc3, 0x95, 0x5d, 0x43, 0x32, 0x20, 0xd7, 0x53, 0x14, 0xe1, 0xb6, 0x61, 0xca, 0x57, 0x88,
+	0xdb, 0x43, 0x52, 0x7a, 0x44, 0x4e, 0xba, 0x0d, 0x2f, 0x85, 0xa3, 0xec, 0xda, 0xfb, 0xce, 0xff,
+	0xe8, 0x4c, 0x52, 0x01, 0x66, 0xce, 0xcb, 0x21, 0xed, 0x16, 0x0c, 0x59, 0xf6, 0xbe, 0x83, 0x45,
+	0x3e, 0xcf, 0x6d, 0x09, 0xaa, 0xee, 0xfa, 0x95, 0xac, 0x51, 0xeb, 0xa0, 0x1f, 0x6c, 0x37, 0xbc,
+	0x03, 0xd3, 0xf6, 0xac, 0xb2, 0xee, 0x39, 0xf5, 0xa0, 0x1f, 0x6c, 0x42, 0x86, 0xbb, 0x8b, 0x21,
+	0xd3, 0x70, 0xb9, 0xfd, 0x18, 0xb2, 0x66, 0x30, 0xa6, 0xb1, 0x2f, 0xd2, 0x43, 0x01, 0x35, 0x35,
+	0xc7, 0xd3, 0x3d, 0xd3, 0x28, 0x34, 0x4a, 0xef, 0x9a, 0xcd, 0xa0, 0x72, 0xde, 0x84, 0xa9, 0x5a,
+	0xa3, 0x54, 0xbc, 0x6b, 0x36, 0x63, 0x67, 0x75, 0xb2, 0x46, 0x25, 0x70, 0xb1, 0xeb, 0x4d, 0x97,
+	0x7c, 0xe6, 0x37, 0xdd, 0x2f, 0x02, 0x64, 0xb8, 0xa0, 0x78, 0xbc, 0x0f, 0xe1, 0x85, 0x3a, 0xdb,
+	0x29, 0x22, 0xb1, 0xdf, 0xf6, 0x24, 0x6e, 0x76, 0x23, 0x32, 0xea, 0x58, 0xbb, 0xef, 0x7e, 0xff,
+	0xcf, 0xa3, 0x55, 0x41, 0x4b, 0xd5, 0x23, 0x01, 0x9e, 0xdb, 0x4b, 0x6f, 0xe3, 0xd7, 0x29, 0xb8,
+	0x4c, 0x4f, 0x40, 0xbe, 0x12, 0x60, 0xd4, 0xef, 0xd7, 0x64, 0x85, 0x8b, 0xc7, 0x9b, 0x1a, 0xc4,
+	0xd5, 0x38, 0xa6, 0x2c, 0xb2, 0xb4, 0x7a, 0xd4, 0x3e, 0xcd, 0x17, 0xbf, 0xff, 0xfd, 0x4d, 0x32,
+	0x47, 0xe6, 0x14, 0xee, 0x7c, 0xe3, 0x23, 0x7c, 0x2b, 0xc0, 0x08, 0x0a, 0x90, 0xe5, 0x81, 0x31,
+	0x7c, 0x9a, 0x95, 0x18, 0x96, 0x08, 0xb3, 0xd5, 0x81, 0x59, 0x21, 0x4b, 0x7d, 0x61, 0x94, 0x43,
+	0x2c, 0xb4, 0x16, 0xf9, 0x51, 0x00, 0x72, 0xfe, 0x89, 0x27, 0x9b, 0x03, 0xe3, 0x9e, 0x6f, 0x3a,
+	0xe2, 0xd6, 0xc5, 0x9c, 0x2e, 0xc0, 0x1d, 0x74, 0xc4, 0xa2, 0x65, 0x28, 0x87, 0x96, 0xd1, 0x22,
+	0x5f, 0x0a, 0x30, 0xcc, 0xde, 0xe7, 0x64, 0xa9, 0x77, 0xd8, 0xc8, 0xf0, 0x20, 0x2e, 0x0f, 0x36,
+	0x44, 0xa6, 0xe5, 0x0e, 0xd3, 0x1c, 0xc9, 0x70, 0x99, 0xd8, 0xf8, 0x40, 0x1e, 0x0a, 0x90, 0x8a,
+	0x0e, 0x07, 0x44, 0xe9, 0x1d, 0x86, 0x3b, 0x64, 0x88, 0x6b, 0xf1, 0x1d, 0x90, 0x6f, 0xbd, 0xc3,
+	0xb7, 0x48, 0x5e, 0xe1, 0xf2, 0x55, 0xa9, 0x67, 0x31, 0xa8, 0xbf, 0x9f, 0x05, 0x98, 0xe6, 0x4c,
+	0x05, 0x64, 0x2b, 0x66, 0xf0, 0xc8, 0xec, 0x21, 0xde, 0xb8, 0xa0, 0x17, 0x72, 0xbf, 0xd6, 0xe1,
+	0xce, 0x93, 0x6b, 0x71, 0xb8, 0x95, 0xc3, 0x76, 0x0f, 0x6d, 0x91, 0x23, 0x01, 0x26, 0xc2, 0x63,
+	0x44, 0x8f, 0x67, 0x88, 0x33, 0x80, 0x88, 0x2b, 0x31, 0x2c, 0x91, 0x6f, 0xa1, 0xef, 0x95, 0xb3,
+	0xc9, 0x84, 0x3c, 0x12, 0x20, 0xcd, 0x1b, 0x28, 0x08, 0xff, 0x1e, 0xfb, 0xcc, 0x2f, 0xe2, 0xfa,
+	0x05, 0x3c, 0x10, 0x71, 0xb3, 0x6f, 0xf6, 0x18, 0xa2, 0x72, 0x18, 0x99, 0x21, 0x5a, 0xe4, 0x87,
+	0x0e, 0x72, 0x64, 0xec, 0xe8, 0x8f, 0xcc, 0x9b, 0x73, 0xc4, 0xf5, 0x0b, 0x78, 0xf8, 0x4f, 0x38,
+	0x45, 0x96, 0xc9, 0xf5, 0x58, 0xc8, 0x6c, 0x7a, 0x6a, 0x91, 0xef, 0x04, 0x18, 0x0f, 0xbd, 0xd6,
+	0xc9, 0xf5, 0x81, 0xdd, 0x25, 0x34, 0x4c, 0x88, 0xf9, 0x98, 0xd6, 0xf1, 0x0b, 0x33, 0x98, 0x9d,
+	0xec, 0x7d, 0x27, 0xd4, 0x40, 0x8f, 0x05, 0x48, 0x45, 0xa7, 0x81, 0x7e, 0x0d, 0x80, 0x3b, 0x55,
+	0x88, 0x6b, 0xf1, 0x1d, 0x90, 0xf7, 0x1a, 0x45, 0xbd, 0x4a, 0x16, 0x94, 0x5e, 0x3f, 0xaa, 0x43,
+	0x3c, 0x3f, 0x09, 0x90, 0x8a, 0xbe, 0xd1, 0xfb, 0x21, 0x72, 0x87, 0x14, 0x71, 0x2d, 0xbe, 0x03,
+	0x22, 0xee, 0x74, 0x52, 0x7a, 0x93, 0xbc, 0xca, 0xe5, 0xec, 0x1e, 0x26, 0x94, 0xc3, 0xae, 0x41,
+	0xa8, 0xa5, 0xde, 0x7a, 0x7c, 0x9a, 0x15, 0x9e, 0x9e, 0x66, 0x85, 0xbf, 0x4e, 0xb3, 0xc2, 0xd7,
+	0x67, 0xd9, 0xc4, 0xd3, 0xb3, 0x6c, 0xe2, 0x8f, 0xb3, 0x6c, 0xe2, 0xe3, 0x95, 0x8a, 0xe5, 0x1d,
+	0x34, 0x4a, 0x72, 0xd9, 0xa9, 0xfa, 0xe2, 0xec, 0x5f, 0xde, 0x35, 0xee, 0x2a, 0x0f, 0x58, 0x24,
+	0xaf, 0x59, 0x33, 0xdd, 0xd2, 0x30, 0xfd, 0x61, 0xb3, 0xf9, 0xdf, 0x00, 0x66, 0x80, 0x7c, 0x42,
+	0x46, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1107,6 +1325,16 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// Authenticators returns the names of the authenticators registered to
+	// authenticate abstract accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error)
+	// RotatedPubKeys returns the accounts which replaced the public key with the
+	// given address with MsgRotatePubKey.
+	//
+	// Since: cosmos-sdk 0.50
+	RotatedPubKeys(ctx context.Context, in *QueryRotatedPubKeysRequest, opts ...grpc.CallOption) (*QueryRotatedPubKeysResponse, error)
 }
 
 type queryClient struct {
@@ -1207,6 +1435,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error) {
+	out := new(QueryAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Authenticators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RotatedPubKeys(ctx context.Context, in *QueryRotatedPubKeysRequest, opts ...grpc.CallOption) (*QueryRotatedPubKeysResponse, error) {
+	out := new(QueryRotatedPubKeysResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/RotatedPubKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1246,6 +1492,16 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// Authenticators returns the names of the authenticators registered to
+	// authenticate abstract accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error)
+	// RotatedPubKeys returns the accounts which replaced the public key with the
+	// given address with MsgRotatePubKey.
+	//
+	// Since: cosmos-sdk 0.50
+	RotatedPubKeys(context.Context, *QueryRotatedPubKeysRequest) (*QueryRotatedPubKeysResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1282,6 +1538,12 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) Authenticators(ctx context.Context, req *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticators not implemented")
+}
+func (*UnimplementedQueryServer) RotatedPubKeys(ctx context.Context, req *QueryRotatedPubKeysRequest) (*QueryRotatedPubKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatedPubKeys not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1467,6 +1729,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Authenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/Authenticators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticators(ctx, req.(*QueryAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RotatedPubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRotatedPubKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RotatedPubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/RotatedPubKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RotatedPubKeys(ctx, req.(*QueryRotatedPubKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1511,6 +1809,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "Authenticators",
+			Handler:    _Query_Authenticators_Handler,
+		},
+		{
+			MethodName: "RotatedPubKeys",
+			Handler:    _Query_RotatedPubKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2147,73 +2453,219 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRotatedPubKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRotatedPubKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRotatedPubKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKeyAddress) > 0 {
+		i -= len(m.PubKeyAddress)
+		copy(dAtA[i:], m.PubKeyAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKeyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRotatedPubKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRotatedPubKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRotatedPubKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RotatedPubKeys) > 0 {
+		for iNdEx := len(m.RotatedPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotatedPubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryParamsRequest) Size() (n int) {
@@ -2414,6 +2866,66 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRotatedPubKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKeyAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRotatedPubKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RotatedPubKeys) > 0 {
+		for _, e := range m.RotatedPubKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4031,6 +4543,376 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthenticatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthenticatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRotatedPubKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRotatedPubKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRotatedPubKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRotatedPubKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRotatedPubKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRotatedPubKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedPubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotatedPubKeys = append(m.RotatedPubKeys, RotatedPubKey{})
+			if err := m.RotatedPubKeys[len(m.RotatedPubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// NewAccountStateOverride returns the state override setting the given account
+// when simulating a tx, e.g. to simulate it with another sequence:
+//
+//	err := acc.SetSequence(sequence)
+//	override, err := NewAccountStateOverride(cdc, acc)
+func NewAccountStateOverride(cdc codec.BinaryCodec, acc sdk.AccountI) (txtypes.StateOverride, error) {
+	key, err := collections.EncodeKeyWithPrefix(AddressStoreKeyPrefix, sdk.AccAddressKey, acc.GetAddress())
+	if err != nil {
+		return txtypes.StateOverride{}, err
+	}
+
+	value, err := codec.CollInterfaceValue[sdk.AccountI](cdc).Encode(acc)
+	if err != nil {
+		return txtypes.StateOverride{}, err
+	}
+
+	return txtypes.StateOverride{StoreKey: StoreKey, Key: key, Value: value}, nil
+}
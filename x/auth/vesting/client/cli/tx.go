@@ -21,6 +21,8 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
+	FlagMerge   = "merge"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -37,6 +39,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewMsgCreateVestingAccountCmd(ac),
 		NewMsgCreatePermanentLockedAccountCmd(ac),
 		NewMsgCreatePeriodicVestingAccountCmd(ac),
+		NewMsgCreateClawbackVestingAccountCmd(ac),
+		NewMsgClawbackCmd(ac),
 	)
 
 	return txCmd
@@ -187,29 +191,163 @@ func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
-			var periods []types.Period
+			periods, err := parsePeriods(vestingData.Periods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg.Merge, _ = cmd.Flags().GetBool(FlagMerge)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Merge the periods into the existing periodic vesting account if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ClawbackVestingData defines the lockup and vesting schedules of a clawback
+// vesting account.
+type ClawbackVestingData struct {
+	StartTime      int64         `json:"start_time"`
+	LockupPeriods  []InputPeriod `json:"lockup_periods"`
+	VestingPeriods []InputPeriod `json:"vesting_periods"`
+}
 
-			for i, p := range vestingData.Periods {
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new clawback vesting account funded with an allocation of tokens.",
+		Long: `Create a new vesting account funded with an allocation of tokens, whose unvested tokens can be
+clawed back by the sender. Tokens are unlocked by the lockup periods and vested by the vesting periods, both
+starting at start_time, and are only spendable once both unlocked and vested. If no lockup (resp. vesting)
+periods are given, tokens are unlocked (resp. vested) at start_time. For instance, the following periods.json
+file shows 20 "test" coins vesting 30 days apart from each other, all locked for 90 days.
+		Where periods.json contains:
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+{ "start_time": 1625204910,
+"lockup_periods":[
+ {
+  "coins": "20test",
+  "length_seconds":7776000 //90 days
+ }
+],
+"vesting_periods":[
+ {
+  "coins": "10test",
+  "length_seconds":2592000 //30 days
+ },
+ {
+  "coins": "10test",
+  "length_seconds":2592000 //30 days
+ }
+]
+}
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData ClawbackVestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			lockupPeriods, err := parsePeriods(vestingData.LockupPeriods)
+			if err != nil {
+				return err
+			}
+
+			vestingPeriods, err := parsePeriods(vestingData.VestingPeriods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, lockupPeriods, vestingPeriods)
+			msg.Merge, _ = cmd.Flags().GetBool(FlagMerge)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Merge the periods into the existing clawback vesting account funded by the sender if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account. Only the funder of the account
+may claw back its tokens, which are sent to the '--dest' address, or to the funder if unset. Unvested
+tokens which are delegated are recovered as delegations to the same validators.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr []byte
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				destAddr, err = ac.StringToBytes(dest)
 				if err != nil {
 					return err
 				}
-
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
-				}
-
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, destAddr)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "Address receiving the unvested tokens, defaults to the funder")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parsePeriods parses the periods of a vesting schedule.
+func parsePeriods(inputPeriods []InputPeriod) ([]types.Period, error) {
+	var periods []types.Period
+	for i, p := range inputPeriods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Length < 0 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,54 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// RegisterInvariants registers the vesting module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, ak keeper.AccountKeeper) {
+	ir.RegisterRoute(types.ModuleName, "original-vesting", OriginalVestingInvariant(ak))
+}
+
+// OriginalVestingInvariant checks that the original vesting of the periodic
+// and clawback vesting accounts is the sum of their periods, including after
+// grants were merged into them.
+func OriginalVestingInvariant(ak keeper.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		ak.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+			switch acc := acc.(type) {
+			case *types.PeriodicVestingAccount:
+				if total := types.Periods(acc.VestingPeriods).TotalAmount(); !total.Equal(acc.OriginalVesting) {
+					count++
+					msg += fmt.Sprintf("\t%s has an original vesting of %s but vesting periods of %s\n", acc.Address, acc.OriginalVesting, total)
+				}
+			case *types.ClawbackVestingAccount:
+				if total := types.Periods(acc.LockupPeriods).TotalAmount(); !total.Equal(acc.OriginalVesting) {
+					count++
+					msg += fmt.Sprintf("\t%s has an original vesting of %s but lockup periods of %s\n", acc.Address, acc.OriginalVesting, total)
+				}
+				if total := types.Periods(acc.VestingPeriods).TotalAmount(); !total.Equal(acc.OriginalVesting) {
+					count++
+					msg += fmt.Sprintf("\t%s has an original vesting of %s but vesting periods of %s\n", acc.Address, acc.OriginalVesting, total)
+				}
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "original-vesting",
+			fmt.Sprintf("amount of inconsistent original vestings found %d\n%s", count, msg),
+		), broken
+	}
+}
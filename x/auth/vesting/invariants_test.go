@@ -0,0 +1,44 @@
+package vesting_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *VestingTestSuite) TestOriginalVestingInvariant() {
+	startTime := s.ctx.BlockTime().Unix()
+	periods := vestingtypes.Periods{{Length: 10, Amount: sdk.Coins{periodCoin}}, {Length: 20, Amount: sdk.Coins{periodCoin}}}
+	original := sdk.NewCoins(periodCoin.Add(periodCoin))
+
+	periodic, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(to1Addr), original, startTime, periods)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, periodic))
+	clawback, err := vestingtypes.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(to2Addr), fromAddr, startTime, periods, periods)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, clawback))
+
+	invariant := vesting.OriginalVestingInvariant(s.accountKeeper)
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+
+	// the merged grants are added to both the periods and the original vesting
+	s.Require().NoError(clawback.AddGrant(s.ctx.BlockTime(), startTime+5, periods, periods))
+	s.accountKeeper.SetAccount(s.ctx, clawback)
+	_, broken = invariant(s.ctx)
+	s.Require().False(broken)
+
+	clawback.LockupPeriods = clawback.LockupPeriods[1:]
+	s.accountKeeper.SetAccount(s.ctx, clawback)
+	msg, broken := invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "amount of inconsistent original vestings found 1")
+	s.Require().Contains(msg, "lockup periods")
+
+	periodic.OriginalVesting = sdk.NewCoins(fooCoin)
+	s.accountKeeper.SetAccount(s.ctx, periodic)
+	msg, broken = invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "amount of inconsistent original vestings found 2")
+}
@@ -1,11 +1,14 @@
 package vesting
 
 import (
+	"bytes"
 	"context"
+	gomath "math"
 
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,12 +21,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -184,7 +188,8 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+	acc := s.AccountKeeper.GetAccount(ctx, to)
+	if acc != nil && !msg.Merge {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
@@ -192,18 +197,30 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	baseAccount := authtypes.NewBaseAccountWithAddress(to)
-	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
-	vestingAccount, err := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-	}
+	if acc != nil {
+		vestingAccount, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a periodic vesting account to merge into", msg.ToAddress)
+		}
 
-	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+		if err := vestingAccount.AddGrant(ctx.BlockTime(), msg.StartTime, msg.VestingPeriods); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 
-	defer func() {
-		telemetry.IncrCounter(1, "new", "account")
+		s.AccountKeeper.SetAccount(ctx, vestingAccount)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount, err := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		s.AccountKeeper.SetAccount(ctx, vestingAccount)
+		defer telemetry.IncrCounter(1, "new", "account")
+	}
 
+	defer func() {
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -222,6 +239,291 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
 
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	lockupCoins, err := validatePeriods("lockup", msg.LockupPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingCoins, err := validatePeriods("vesting", msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCoins := vestingCoins
+	switch {
+	case len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0:
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lockup and vesting periods cannot both be empty")
+	case len(msg.VestingPeriods) == 0:
+		totalCoins = lockupCoins
+	case len(msg.LockupPeriods) > 0 && !lockupCoins.Equal(vestingCoins):
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lockup periods total %s does not match vesting periods total %s", lockupCoins, vestingCoins)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, to)
+	if acc != nil && !msg.Merge {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	if acc != nil {
+		vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a clawback vesting account to merge into", msg.ToAddress)
+		}
+
+		// coins merged into the account can be clawed back by its funder,
+		// so only the funder may add any
+		funder, err := s.AccountKeeper.AddressCodec().StringToBytes(vestingAccount.FunderAddress)
+		if err != nil || !bytes.Equal(funder, from) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the funder of account %s may merge into it", msg.ToAddress)
+		}
+
+		if err := vestingAccount.AddGrant(ctx.BlockTime(), msg.StartTime, msg.LockupPeriods, msg.VestingPeriods); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		s.AccountKeeper.SetAccount(ctx, vestingAccount)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount, err := types.NewClawbackVestingAccount(baseAccount, from, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		s.AccountKeeper.SetAccount(ctx, vestingAccount)
+		defer telemetry.IncrCounter(1, "new", "account")
+	}
+
+	defer func() {
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = s.BankKeeper.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.AddressCodec().StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'dest' address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(dest))
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	accFunder, err := s.AccountKeeper.AddressCodec().StringToBytes(vestingAccount.FunderAddress)
+	if err != nil || !bytes.Equal(accFunder, funder) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder of account %s", msg.Address)
+	}
+
+	unvested, delegated := vestingAccount.Clawback(ctx.BlockTime())
+	if unvested.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has no unvested coins", msg.Address)
+	}
+
+	// The account is updated first, so that the unvested coins it holds are
+	// no longer locked and can be sent.
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	clawedBack := unvested.Sub(delegated...)
+	if !clawedBack.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom, err := s.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toTransfer := delegated.AmountOf(bondDenom)
+	transferred, err := s.transferDelegations(ctx, addr, dest, toTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The delegations may fall short of the unvested delegated coins because of
+	// slashing, in which case those coins are lost. However, unbonding coins
+	// would be returned to the account as free coins, so the clawback must wait
+	// for the unbonding to complete.
+	if transferred.LT(toTransfer) {
+		unbonding, err := s.StakingKeeper.GetDelegatorUnbonding(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if unbonding.IsPositive() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unvested coins of account %s are unbonding, clawback must wait for the unbonding to complete", msg.Address)
+		}
+	}
+
+	if transferred.IsPositive() {
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, transferred))
+	}
+
+	defer func() {
+		for _, a := range clawedBack {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgClawbackResponse{Amount: clawedBack}, nil
+}
+
+// transferDelegations transfers delegations worth up to amount tokens from
+// delAddr to destAddr, without going through unbonding. It returns the amount
+// of tokens actually transferred.
+func (s msgServer) transferDelegations(ctx context.Context, delAddr, destAddr sdk.AccAddress, amount math.Int) (math.Int, error) {
+	transferred := math.ZeroInt()
+	if !amount.IsPositive() {
+		return transferred, nil
+	}
+
+	delegations, err := s.StakingKeeper.GetDelegatorDelegations(ctx, delAddr, gomath.MaxUint16)
+	if err != nil {
+		return transferred, err
+	}
+
+	for _, delegation := range delegations {
+		remaining := amount.Sub(transferred)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr, err := s.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return transferred, err
+		}
+
+		validator, err := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return transferred, err
+		}
+
+		shares, err := validator.SharesFromTokens(remaining)
+		if err != nil {
+			// the validator has no tokens left, so the delegation is worthless
+			continue
+		}
+		shares = math.LegacyMinDec(shares, delegation.Shares)
+
+		// unbonding all the shares of an unbonded validator removes it, so the
+		// delegation could not be recreated
+		if validator.IsUnbonded() && shares.Equal(validator.DelegatorShares) {
+			return transferred, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot transfer the delegation to unbonded validator %s", delegation.ValidatorAddress)
+		}
+
+		tokens, err := s.StakingKeeper.Unbond(ctx, delAddr, valAddr, shares)
+		if err != nil {
+			return transferred, err
+		}
+		if tokens.IsZero() {
+			continue
+		}
+
+		// the unbonded tokens are still in the pool matching the validator
+		// status, so they are delegated again without moving any coins
+		validator, err = s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return transferred, err
+		}
+
+		if _, err := s.StakingKeeper.Delegate(ctx, destAddr, tokens, validator.GetStatus(), validator, false); err != nil {
+			return transferred, err
+		}
+
+		transferred = transferred.Add(tokens)
+	}
+
+	return transferred, nil
+}
+
+// validatePeriods checks the periods of a vesting schedule and returns their
+// total amount.
+func validatePeriods(name string, periods []types.Period) (sdk.Coins, error) {
+	var total sdk.Coins
+	for i, period := range periods {
+		if period.Length < 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s period length of %d in period %d, length cannot be negative", name, period.Length, i)
+		}
+
+		if err := validateAmount(period.Amount); err != nil {
+			return nil, err
+		}
+
+		total = total.Add(period.Amount...)
+	}
+
+	return total, nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if !amount.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -29,6 +30,7 @@ var (
 	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
 	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
 	to3Addr    = sdk.AccAddress([]byte("to3__________________"))
+	valAddr    = sdk.ValAddress([]byte("val1_________________"))
 	fooCoin    = sdk.NewInt64Coin("foo", 100)
 	periodCoin = sdk.NewInt64Coin("foo", 20)
 )
@@ -39,6 +41,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -53,6 +56,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		storeService,
@@ -65,7 +69,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -400,6 +404,65 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 			expErr:    false,
 			expErrMsg: "",
 		},
+		{
+			name: "merge into a non-vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+			},
+			input: mergePeriodicMsg(vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to1Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+				},
+			)),
+			expErr:    true,
+			expErrMsg: "must be a periodic vesting account to merge into",
+		},
+		{
+			name: "merge into a periodic vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(periodCoin)).Return(nil)
+			},
+			input: mergePeriodicMsg(vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to2Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+				},
+			)),
+			expErr:    false,
+			expErrMsg: "",
+		},
+		{
+			name: "merge into a missing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to3Addr, sdk.NewCoins(periodCoin)).Return(nil)
+			},
+			input: mergePeriodicMsg(vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to3Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+				},
+			)),
+			expErr:    false,
+			expErrMsg: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -416,6 +479,355 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 			}
 		})
 	}
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoins(periodCoin.Add(periodCoin).Add(fooCoin)), acc.OriginalVesting)
+	s.Require().Equal(sdk.NewCoins(periodCoin.Add(periodCoin)), acc.VestingPeriods[0].Amount)
+}
+
+func mergePeriodicMsg(msg *vestingtypes.MsgCreatePeriodicVestingAccount) *vestingtypes.MsgCreatePeriodicVestingAccount {
+	msg.Merge = true
+	return msg
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(fooCoin),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+		expOrig   sdk.Coins
+		expEnd    int64
+	}{
+		{
+			name:      "empty from address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount([]byte{}, to1Addr, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "invalid 'from' address",
+		},
+		{
+			name:      "empty to address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, []byte{}, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "invalid start time",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 0, nil, periods),
+			expErr:    true,
+			expErrMsg: "invalid start time",
+		},
+		{
+			name: "negative period length",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), []vestingtypes.Period{
+				{
+					Length: -1,
+					Amount: sdk.NewCoins(periodCoin),
+				},
+			}, nil),
+			expErr:    true,
+			expErrMsg: "invalid lockup period length",
+		},
+		{
+			name: "invalid period amount",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, []vestingtypes.Period{
+				{
+					Length: 10,
+					Amount: sdk.Coins{sdk.Coin{Denom: "foo", Amount: math.NewInt(0)}},
+				},
+			}),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name:      "empty periods",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, nil),
+			expErr:    true,
+			expErrMsg: "cannot both be empty",
+		},
+		{
+			name: "lockup and vesting totals mismatch",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), []vestingtypes.Period{
+				{
+					Length: 10,
+					Amount: sdk.NewCoins(periodCoin),
+				},
+			}, periods),
+			expErr:    true,
+			expErrMsg: "does not match vesting periods total",
+		},
+		{
+			name: "account exists",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create a valid clawback vesting account without lockup",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input:   vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, 1000, nil, periods),
+			expOrig: sdk.NewCoins(periodCoin.Add(fooCoin)),
+			expEnd:  1030,
+		},
+		{
+			name: "create a valid clawback vesting account with lockup",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to3Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to3Addr, 1000, []vestingtypes.Period{
+				{
+					Length: 50,
+					Amount: sdk.NewCoins(periodCoin.Add(fooCoin)),
+				},
+			}, periods),
+			expOrig: sdk.NewCoins(periodCoin.Add(fooCoin)),
+			expEnd:  1050,
+		},
+		{
+			name: "merge into a non-vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     mergeClawbackMsg(vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 1000, nil, periods)),
+			expErr:    true,
+			expErrMsg: "must be a clawback vesting account to merge into",
+		},
+		{
+			name: "merge by another funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     mergeClawbackMsg(vestingtypes.NewMsgCreateClawbackVestingAccount(to1Addr, to2Addr, 1000, nil, periods)),
+			expErr:    true,
+			expErrMsg: "only the funder",
+		},
+		{
+			name: "merge by the funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input:   mergeClawbackMsg(vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, 1010, nil, periods)),
+			expOrig: sdk.NewCoins(periodCoin.Add(fooCoin).Add(periodCoin).Add(fooCoin)),
+			expEnd:  1040,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				addr, err := s.accountKeeper.AddressCodec().StringToBytes(tc.input.ToAddress)
+				s.Require().NoError(err)
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+				s.Require().Equal(tc.expOrig, acc.OriginalVesting)
+				s.Require().Equal(tc.expEnd, acc.EndTime)
+			}
+		})
+	}
+}
+
+func mergeClawbackMsg(msg *vestingtypes.MsgCreateClawbackVestingAccount) *vestingtypes.MsgCreateClawbackVestingAccount {
+	msg.Merge = true
+	return msg
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	bondDenom := fooCoin.Denom
+	unbondingAddr := sdk.AccAddress([]byte("unbonding____________"))
+	delegatorAddr := sdk.AccAddress([]byte("delegator____________"))
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(100),
+		DelegatorShares: math.LegacyNewDec(100),
+	}
+
+	// createAccount creates a clawback vesting account funded by fromAddr, with
+	// 20foo vested and 100foo unvested, of which delegatedVesting is delegated.
+	createAccount := func(addr sdk.AccAddress, delegatedVesting int64) {
+		startTime := s.ctx.BlockTime().Unix() - 15
+		acc, err := vestingtypes.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), fromAddr, startTime, nil, []vestingtypes.Period{
+			{
+				Length: 10,
+				Amount: sdk.NewCoins(periodCoin),
+			},
+			{
+				Length: 20,
+				Amount: sdk.NewCoins(fooCoin),
+			},
+		})
+		s.Require().NoError(err)
+		if delegatedVesting > 0 {
+			acc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, delegatedVesting))
+		}
+		s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, acc))
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "empty funder address",
+			input:     vestingtypes.NewMsgClawback([]byte{}, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "invalid 'funder' address",
+		},
+		{
+			name:      "empty address",
+			input:     vestingtypes.NewMsgClawback(fromAddr, []byte{}, nil),
+			expErr:    true,
+			expErrMsg: "invalid address",
+		},
+		{
+			name: "blocked destination",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "account does not exist",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "does not exist",
+		},
+		{
+			name: "not a clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not a clawback vesting account",
+		},
+		{
+			name: "not the funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				createAccount(to2Addr, 0)
+			},
+			input:     vestingtypes.NewMsgClawback(to3Addr, to2Addr, nil),
+			expErr:    true,
+			expErrMsg: "can only be requested by the funder",
+		},
+		{
+			name: "unvested coins are unbonding",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), unbondingAddr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(bondDenom, nil)
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), unbondingAddr, gomock.Any()).Return(nil, nil)
+				s.stakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), unbondingAddr).Return(math.NewInt(60), nil)
+				createAccount(unbondingAddr, 60)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, unbondingAddr, nil),
+			expErr:    true,
+			expErrMsg: "must wait for the unbonding to complete",
+		},
+		{
+			name: "clawback unvested coins from balance",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to3Addr, fromAddr, sdk.NewCoins(fooCoin)).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(bondDenom, nil)
+				createAccount(to3Addr, 0)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to3Addr, nil),
+			expAmount: sdk.NewCoins(fooCoin),
+		},
+		{
+			name: "clawback unvested coins from balance and delegations",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delegatorAddr, to1Addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(bondDenom, nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(authcodec.NewBech32Codec("cosmosvaloper"))
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), delegatorAddr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(delegatorAddr.String(), valAddr.String(), math.LegacyNewDec(100)),
+				}, nil)
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, nil).Times(2)
+				s.stakingKeeper.EXPECT().Unbond(gomock.Any(), delegatorAddr, valAddr, math.LegacyNewDec(60)).Return(math.NewInt(60), nil)
+				s.stakingKeeper.EXPECT().Delegate(gomock.Any(), to1Addr, math.NewInt(60), stakingtypes.Bonded, validator, false).Return(math.LegacyNewDec(60), nil)
+				createAccount(delegatorAddr, 60)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, delegatorAddr, to1Addr),
+			expAmount: sdk.NewCoins(fooCoin),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAmount, res.Amount)
+
+				addr, err := s.accountKeeper.AddressCodec().StringToBytes(tc.input.Address)
+				s.Require().NoError(err)
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+				s.Require().True(acc.DelegatedVesting.IsZero())
+				s.Require().Len(acc.VestingPeriods, 1)
+				s.Require().True(acc.GetVestingCoins(s.ctx.BlockTime()).IsZero())
+			}
+		})
+	}
 }
 
 func TestVestingTestSuite(t *testing.T) {
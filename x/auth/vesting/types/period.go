@@ -40,6 +40,48 @@ func (p Periods) TotalAmount() sdk.Coins {
 	return total
 }
 
+// MergePeriods merges the schedules of periods p starting at startP and q
+// starting at startQ into a single schedule starting at the earliest start
+// time, which vests at any time the sum of the amounts vested by p and q.
+// It returns the start time and the periods of the merged schedule.
+func MergePeriods(startP int64, p Periods, startQ int64, q Periods) (int64, Periods) {
+	startTime := startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+
+	var merged Periods
+	lastTime, timeP, timeQ := startTime, startP, startQ
+	for iP, iQ := 0, 0; iP < len(p) || iQ < len(q); {
+		// the next period to elapse is taken from p, q, or both if they
+		// elapse at the same time
+		takeP := iP < len(p) && (iQ == len(q) || timeP+p[iP].Length <= timeQ+q[iQ].Length)
+		takeQ := iQ < len(q) && (iP == len(p) || timeQ+q[iQ].Length <= timeP+p[iP].Length)
+
+		var (
+			eventTime int64
+			amount    sdk.Coins
+		)
+		if takeP {
+			timeP += p[iP].Length
+			eventTime = timeP
+			amount = amount.Add(p[iP].Amount...)
+			iP++
+		}
+		if takeQ {
+			timeQ += q[iQ].Length
+			eventTime = timeQ
+			amount = amount.Add(q[iQ].Amount...)
+			iQ++
+		}
+
+		merged = append(merged, Period{Length: eventTime - lastTime, Amount: amount})
+		lastTime = eventTime
+	}
+
+	return startTime, merged
+}
+
 // String implements the fmt.Stringer interface
 func (p Periods) String() string {
 	periodsListString := make([]string, len(p))
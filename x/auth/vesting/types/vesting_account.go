@@ -18,6 +18,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -140,6 +141,15 @@ func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
 	return bva.DelegatedVesting
 }
 
+// realignDelegations re-assigns the delegated coins between delegated vesting
+// and delegated free coins given the coins currently vesting, such that vesting
+// coins are considered delegated first.
+func (bva *BaseVestingAccount) realignDelegations(vestingCoins sdk.Coins) {
+	delegated := bva.DelegatedVesting.Add(bva.DelegatedFree...)
+	bva.DelegatedVesting = delegated.Min(vestingCoins)
+	bva.DelegatedFree = delegated.Sub(bva.DelegatedVesting...)
+}
+
 // GetEndTime returns a vesting account's end time
 func (bva BaseVestingAccount) GetEndTime() int64 {
 	return bva.EndTime
@@ -355,6 +365,26 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a new grant of coins vesting according to grantPeriods
+// from grantStartTime into the account. Both schedules are re-aligned against
+// the earliest start time, so that the coins vested at any time are the sum of
+// the coins vested by each of them. The delegated coins are re-assigned given
+// the coins vesting at blockTime.
+//
+// It is the callers responsibility to ensure the account receives the coins of
+// the grant.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, grantStartTime int64, grantPeriods Periods) error {
+	startTime, periods := MergePeriods(pva.StartTime, pva.VestingPeriods, grantStartTime, grantPeriods)
+
+	pva.StartTime = startTime
+	pva.VestingPeriods = periods
+	pva.EndTime = startTime + periods.TotalLength()
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantPeriods.TotalAmount()...)
+	pva.BaseVestingAccount.realignDelegations(pva.GetVestingCoins(blockTime))
+
+	return pva.Validate()
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -518,3 +548,268 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by
+// funder. Its original vesting is the total amount of the vesting periods.
+// If lockupPeriods (resp. vestingPeriods) is empty, all coins are unlocked
+// (resp. vested) at startTime.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods) (*ClawbackVestingAccount, error) {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: vestingPeriods.TotalAmount()}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: lockupPeriods.TotalAmount()}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  vestingPeriods.TotalAmount(),
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          startTime + maxInt64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+
+	if clawbackVestingAccount.OriginalVesting.IsZero() {
+		return clawbackVestingAccount, fmt.Errorf("invalid coins: %s", clawbackVestingAccount.OriginalVesting)
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// GetVestedCoins returns the total number of vested coins, defined as the
+// coins that are both unlocked by the lockup schedule and vested by the vesting
+// schedule. If no coins are vested, nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	unlocked := periodsElapsedAmount(cva.StartTime, cva.LockupPeriods, blockTime)
+	vested := periodsElapsedAmount(cva.StartTime, cva.VestingPeriods, blockTime)
+	if unlocked.IsZero() || vested.IsZero() {
+		return nil
+	}
+
+	return unlocked.Min(vested)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// GetUnvestedCoins returns the coins which are not vested yet by the vesting
+// schedule, regardless of the lockup schedule. These are the coins recovered
+// by a clawback.
+func (cva ClawbackVestingAccount) GetUnvestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(periodsElapsedAmount(cva.StartTime, cva.VestingPeriods, blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetFunder returns the address of the funder of the account.
+func (cva ClawbackVestingAccount) GetFunder() string {
+	return cva.FunderAddress
+}
+
+// GetLockupPeriods returns the lockup periods of the clawback vesting account.
+func (cva ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return cva.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of the clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// Clawback removes the coins which are not vested at blockTime from the
+// account: the vesting schedule is truncated to the periods already vested,
+// and the lockup schedule is capped to the remaining original vesting. It
+// returns the unvested coins, along with the part of them which is delegated.
+//
+// The delegated part is determined the same way as for locked coins, i.e.
+// vesting coins are considered delegated first. It is removed from the
+// delegated vesting amount, the caller is responsible for transferring the
+// corresponding delegations.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) (unvested, delegated sdk.Coins) {
+	unvested = cva.GetUnvestedCoins(blockTime)
+	delegated = unvested.Min(cva.DelegatedVesting)
+
+	var vestingPeriods Periods
+	elapsed := cva.StartTime
+	for _, period := range cva.VestingPeriods {
+		elapsed += period.Length
+		if elapsed > blockTime.Unix() {
+			break
+		}
+		vestingPeriods = append(vestingPeriods, period)
+	}
+
+	cva.OriginalVesting = cva.OriginalVesting.Sub(unvested...)
+	cva.DelegatedVesting = cva.DelegatedVesting.Sub(delegated...)
+	cva.VestingPeriods = vestingPeriods
+	cva.LockupPeriods = capPeriods(cva.LockupPeriods, cva.OriginalVesting)
+	cva.EndTime = cva.StartTime + maxInt64(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength())
+
+	return unvested, delegated
+}
+
+// AddGrant merges a new grant of coins unlocked according to lockupPeriods
+// and vested according to vestingPeriods from grantStartTime into the account,
+// with the same defaults as NewClawbackVestingAccount for empty schedules.
+// Each schedule is re-aligned against the earliest start time, so that the
+// coins unlocked (resp. vested) at any time are the sum of the coins unlocked
+// (resp. vested) by each of them. The delegated coins are re-assigned given
+// the coins vesting at blockTime.
+//
+// It is the callers responsibility to ensure the account receives the coins of
+// the grant.
+func (cva *ClawbackVestingAccount) AddGrant(blockTime time.Time, grantStartTime int64, lockupPeriods, vestingPeriods Periods) error {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: vestingPeriods.TotalAmount()}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: lockupPeriods.TotalAmount()}}
+	}
+
+	_, mergedLockupPeriods := MergePeriods(cva.StartTime, cva.LockupPeriods, grantStartTime, lockupPeriods)
+	startTime, mergedVestingPeriods := MergePeriods(cva.StartTime, cva.VestingPeriods, grantStartTime, vestingPeriods)
+
+	cva.StartTime = startTime
+	cva.LockupPeriods = mergedLockupPeriods
+	cva.VestingPeriods = mergedVestingPeriods
+	cva.EndTime = startTime + maxInt64(mergedLockupPeriods.TotalLength(), mergedVestingPeriods.TotalLength())
+	cva.OriginalVesting = cva.OriginalVesting.Add(vestingPeriods.TotalAmount()...)
+	cva.BaseVestingAccount.realignDelegations(cva.GetVestingCoins(blockTime))
+
+	return cva.Validate()
+}
+
+// Validate checks for errors on the account fields. Unlike other vesting
+// accounts, the original vesting may be empty once all its unvested coins
+// have been clawed back.
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+	if cva.EndTime < cva.StartTime {
+		return errors.New("vesting end-time cannot be before start-time")
+	}
+	if err := validatePeriods("lockup", cva.LockupPeriods, cva.OriginalVesting); err != nil {
+		return err
+	}
+	if err := validatePeriods("vesting", cva.VestingPeriods, cva.OriginalVesting); err != nil {
+		return err
+	}
+	if endTime := cva.StartTime + maxInt64(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength()); endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of lockup and vesting periods")
+	}
+
+	if cva.EndTime < 0 {
+		return errors.New("end time cannot be negative")
+	}
+	if !cva.OriginalVesting.IsValid() {
+		return fmt.Errorf("invalid coins: %s", cva.OriginalVesting.String())
+	}
+	if !(cva.DelegatedVesting.IsAllLTE(cva.OriginalVesting)) {
+		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
+	}
+
+	return cva.BaseAccount.Validate()
+}
+
+// periodsElapsedAmount returns the total amount of the periods starting at
+// startTime which have elapsed at blockTime.
+func periodsElapsedAmount(startTime int64, periods Periods, blockTime time.Time) sdk.Coins {
+	var amount sdk.Coins
+	elapsed := startTime
+	for _, period := range periods {
+		elapsed += period.Length
+		if elapsed > blockTime.Unix() {
+			break
+		}
+		amount = amount.Add(period.Amount...)
+	}
+
+	return amount
+}
+
+// capPeriods returns the periods with their cumulative amount capped to limit.
+// Periods left without any coins are merged into the next one.
+func capPeriods(periods Periods, limit sdk.Coins) Periods {
+	var (
+		capped Periods
+		total  sdk.Coins
+		length int64
+	)
+	for _, period := range periods {
+		length += period.Length
+		amount := period.Amount.Min(limit.Sub(total...))
+		if amount.IsZero() {
+			continue
+		}
+
+		capped = append(capped, Period{Length: length, Amount: amount})
+		total = total.Add(amount...)
+		length = 0
+	}
+
+	return capped
+}
+
+// validatePeriods checks that the periods have non-negative lengths and valid
+// amounts, adding up to total.
+func validatePeriods(name string, periods Periods, total sdk.Coins) error {
+	amount := sdk.NewCoins()
+	for i, p := range periods {
+		if p.Length < 0 {
+			return fmt.Errorf("%s period #%d has a negative length: %d", name, i, p.Length)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return fmt.Errorf("%s period #%d has invalid coins: %s", name, i, p.Amount.String())
+		}
+
+		amount = amount.Add(p.Amount...)
+	}
+	if !amount.Equal(total) {
+		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in %s periods (%v)", total, name, amount)
+	}
+
+	return nil
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -885,6 +885,234 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(15 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, origCoins, cva.OriginalVesting)
+	require.Equal(t, endTime.Unix(), cva.EndTime)
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetUnvestedCoins(now))
+
+	// require no coins vested after period 1 while they are still locked
+	require.Nil(t, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount.Add(vestingPeriods[2].Amount...), cva.GetUnvestedCoins(now.Add(12*time.Hour)))
+
+	// require 50% of coins vested once unlocked
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedCoins(now.Add(15*time.Hour)))
+
+	// require 75% of coins vested after period 2
+	require.Equal(t, vestingPeriods[0].Amount.Add(vestingPeriods[1].Amount...), cva.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require 100% of coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(endTime))
+	require.True(t, cva.GetUnvestedCoins(endTime).IsZero())
+
+	// require all coins unlocked at start without a lockup schedule
+	cva, err = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, err)
+	require.Equal(t, vestingPeriods[0].Amount, cva.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at start without a vesting schedule
+	cva, err = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, nil)
+	require.NoError(t, err)
+	require.Nil(t, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.True(t, cva.GetUnvestedCoins(now.Add(12*time.Hour)).IsZero())
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(15*time.Hour)))
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 400)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 200), sdk.NewInt64Coin(stakeDenom, 40)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 400), sdk.NewInt64Coin(stakeDenom, 60)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+
+	// delegate all the staking coins, which are all vesting
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+
+	// require the coins of periods 2 and 3 to be clawed back during period 2,
+	// with the unvested staking coins taken from the delegations
+	unvested, delegated := cva.Clawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, delegated)
+	require.Equal(t, vestingPeriods[0].Amount, cva.OriginalVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, []types.Period{vestingPeriods[0]}, cva.VestingPeriods)
+	require.NoError(t, cva.Validate())
+
+	// require the lockup schedule to be capped to the remaining coins
+	require.Equal(t, []types.Period{
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 400)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 100), sdk.NewInt64Coin(stakeDenom, 40)}},
+		{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}},
+	}, cva.LockupPeriods)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), cva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.GetVestingCoins(now.Add(15*time.Hour)))
+
+	// require nothing more to be clawed back once clawed back
+	unvested, delegated = cva.Clawback(now.Add(20 * time.Hour))
+	require.True(t, unvested.IsZero())
+	require.True(t, delegated.IsZero())
+
+	// require the account to remain valid once all its coins are clawed back
+	cva, err = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+	unvested, _ = cva.Clawback(now)
+	require.Equal(t, origCoins, unvested)
+	require.True(t, cva.OriginalVesting.IsZero())
+	require.Empty(t, cva.LockupPeriods)
+	require.Empty(t, cva.VestingPeriods)
+	require.Equal(t, now.Unix(), cva.EndTime)
+	require.NoError(t, cva.Validate())
+}
+
+func TestMergePeriods(t *testing.T) {
+	p := types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}},
+		{Length: 20, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+	}
+	q := types.Periods{
+		{Length: 15, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}},
+	}
+
+	// q starts 5s after p: it elapses at 20s and 30s
+	startTime, merged := types.MergePeriods(100, p, 105, q)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(stakeDenom, 20)}},
+	}, merged)
+
+	// q starts before p: p elapses at 15s and 35s, q at 15s and 25s
+	startTime, merged = types.MergePeriods(105, p, 100, q)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, types.Periods{
+		{Length: 15, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(stakeDenom, 10)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+	}, merged)
+	require.Equal(t, p.TotalAmount().Add(q.TotalAmount()...), merged.TotalAmount())
+
+	// merging an empty schedule keeps the periods, re-aligned to the earliest start
+	startTime, merged = types.MergePeriods(100, p, 90, nil)
+	require.Equal(t, int64(90), startTime)
+	require.Equal(t, types.Periods{
+		{Length: 20, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}},
+		{Length: 20, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+	}, merged)
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+
+	// delegate half of the vested coins, and all the vesting ones
+	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
+
+	// add a grant starting 6 hours after the account
+	require.NoError(t, pva.AddGrant(now.Add(12*time.Hour), now.Add(6*time.Hour).Unix(), grantPeriods))
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 300)}, pva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 125)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}, pva.VestingPeriods)
+
+	// require the vested coins to be the sum of both schedules
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 175)}, pva.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require the delegated coins to be re-assigned, vesting ones first
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, pva.DelegatedVesting)
+	require.True(t, pva.DelegatedFree.IsZero())
+
+	// require an invalid grant to be rejected
+	require.Error(t, pva.AddGrant(now, now.Unix(), types.Periods{{Length: -1, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}}}))
+}
+
+func TestAddGrantClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	cva, err := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, err)
+
+	// add a grant without lockup, starting 12 hours before the account
+	require.NoError(t, cva.AddGrant(now, now.Add(-12*time.Hour).Unix(), nil, types.Periods{
+		types.Period{Length: int64(48 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}))
+	require.Equal(t, now.Add(-12*time.Hour).Unix(), cva.StartTime)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), cva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, cva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: 0, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+		{Length: int64(30 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}, cva.LockupPeriods)
+	require.Equal(t, []types.Period{
+		{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}, cva.VestingPeriods)
+	require.NoError(t, cva.Validate())
+
+	// require mismatching lockup and vesting schedules to be rejected
+	require.Error(t, cva.AddGrant(now, now.Unix(), lockupPeriods, vestingPeriods[:1]))
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
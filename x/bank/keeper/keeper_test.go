@@ -13,6 +13,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	errorsmod "cosmossdk.io/errors"
@@ -1928,3 +1929,26 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func TestBalanceStateOverride(t *testing.T) {
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	authKeeper := banktestutil.NewMockAccountKeeper(gomock.NewController(t))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	bankKeeper := keeper.NewBaseKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		runtime.NewKVStoreService(key),
+		authKeeper,
+		nil,
+		authtypes.NewModuleAddress(banktypes.GovModuleName).String(),
+		log.NewNopLogger(),
+	)
+
+	balance := sdk.NewInt64Coin(fooDenom, 100)
+	override, err := banktypes.NewBalanceStateOverride(accAddrs[0], balance)
+	require.NoError(t, err)
+	require.Equal(t, banktypes.StoreKey, override.StoreKey)
+
+	ctx.KVStore(key).Set(override.Key, override.Value)
+	require.Equal(t, balance, bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// NewBalanceStateOverride returns the state override setting the balance of
+// the given address in the denom of the given coin when simulating a tx. The
+// total supply is not overridden.
+func NewBalanceStateOverride(addr sdk.AccAddress, balance sdk.Coin) (txtypes.StateOverride, error) {
+	key, err := collections.EncodeKeyWithPrefix(BalancesPrefix, collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Join(addr, balance.Denom))
+	if err != nil {
+		return txtypes.StateOverride{}, err
+	}
+
+	value, err := BalanceValueCodec.Encode(balance.Amount)
+	if err != nil {
+		return txtypes.StateOverride{}, err
+	}
+
+	return txtypes.StateOverride{StoreKey: StoreKey, Key: key, Value: value}, nil
+}
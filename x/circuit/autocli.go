@@ -26,6 +26,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod: "MsgFilters",
+					Use:       "msg-filters",
+					Short:     "Query all the message filters",
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query all the message rate limits",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
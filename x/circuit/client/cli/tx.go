@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +18,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// FlagResetAfter is the flag setting the duration after which a trip is automatically reset.
+const FlagResetAfter = "reset-after"
+
 // NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -29,6 +34,9 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		AuthorizeCircuitBreakerCmd(),
 		TripCircuitBreakerCmd(),
+		AddMsgFilterCmd(),
+		RemoveMsgFilterCmd(),
+		SetRateLimitCmd(),
 	)
 
 	return txCmd
@@ -94,10 +102,16 @@ func TripCircuitBreakerCmd() *cobra.Command {
 
 			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","))
 
+			msg.ResetAfter, err = getResetAfter(cmd)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Duration(FlagResetAfter, 0, "Duration after which the message is automatically enabled again")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -128,3 +142,120 @@ func ResetCircuitBreakerCmd() *cobra.Command {
 
 	return cmd
 }
+
+// AddMsgFilterCmd returns a CLI command handler for creating a MsgAddMsgFilter transaction.
+func AddMsgFilterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-filter [type_url] [conditions]",
+		Short: "Disable the messages of a type whose fields match all the conditions",
+		Long: `Disable the messages of a type whose fields match all the conditions.
+Conditions are a comma separated list of path=value, where path is the dot separated
+path of a field using proto field names.`,
+		Example: fmt.Sprintf(`%s circuit add-filter "cosmos.bank.v1beta1.MsgSend" "amount.denom=uatom,from_address=cosmos1..."`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var conditions []types.FieldCondition
+			for _, cond := range strings.Split(args[1], ",") {
+				path, value, ok := strings.Cut(cond, "=")
+				if !ok {
+					return fmt.Errorf("invalid condition %q, expected path=value", cond)
+				}
+				conditions = append(conditions, types.FieldCondition{Path: path, Value: value})
+			}
+
+			resetAfter, err := getResetAfter(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddMsgFilter(clientCtx.GetFromAddress().String(), args[0], conditions, resetAfter)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagResetAfter, 0, "Duration after which the filter is automatically removed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RemoveMsgFilterCmd returns a CLI command handler for creating a MsgRemoveMsgFilter transaction.
+func RemoveMsgFilterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-filter [id]",
+		Short:   "Remove a message filter",
+		Example: fmt.Sprintf(`%s circuit remove-filter 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveMsgFilter(clientCtx.GetFromAddress().String(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SetRateLimitCmd returns a CLI command handler for creating a MsgSetRateLimit transaction.
+func SetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rate-limit [type_url] [max_msgs] [window]",
+		Short:   "Limit the number of messages of a type executed within a window, a max_msgs of 0 removes the limit",
+		Example: fmt.Sprintf(`%s circuit set-rate-limit "cosmos.bank.v1beta1.MsgSend" 100 1h`, version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxMsgs, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var window time.Duration
+			if len(args) == 3 {
+				window, err = time.ParseDuration(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetRateLimit(clientCtx.GetFromAddress().String(), args[0], maxMsgs, window)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getResetAfter(cmd *cobra.Command) (*time.Duration, error) {
+	resetAfter, err := cmd.Flags().GetDuration(FlagResetAfter)
+	if err != nil || resetAfter == 0 {
+		return nil, err
+	}
+
+	return &resetAfter, nil
+}
@@ -39,10 +39,43 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	tripExpiries, msgFilters, rateLimits := k.exportScopedTrips(ctx)
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		TripExpiries:       tripExpiries,
+		MsgFilters:         msgFilters,
+		RateLimits:         rateLimits,
+	}
+}
+
+func (k *Keeper) exportScopedTrips(ctx context.Context) (tripExpiries []*types.TripExpiry, msgFilters []*types.MsgFilter, rateLimits []*types.RateLimit) {
+	err := k.TripExpiries.Walk(ctx, nil, func(_ string, expiry types.TripExpiry) (stop bool, err error) {
+		tripExpiries = append(tripExpiries, &expiry)
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	err = k.MsgFilters.Walk(ctx, nil, func(_ collections.Pair[string, uint64], filter types.MsgFilter) (stop bool, err error) {
+		msgFilters = append(msgFilters, &filter)
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	err = k.RateLimits.Walk(ctx, nil, func(_ string, rateLimit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, &rateLimit)
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
 	}
+
+	return tripExpiries, msgFilters, rateLimits
 }
 
 // InitGenesis initializes the circuit module's state from a given genesis state.
@@ -64,4 +97,30 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, expiry := range genState.TripExpiries {
+		if err := k.TripExpiries.Set(ctx, expiry.MsgTypeUrl, *expiry); err != nil {
+			panic(err)
+		}
+	}
+
+	var nextFilterID uint64
+	for _, filter := range genState.MsgFilters {
+		if err := k.MsgFilters.Set(ctx, collections.Join(filter.MsgTypeUrl, filter.Id), *filter); err != nil {
+			panic(err)
+		}
+		if filter.Id >= nextFilterID {
+			nextFilterID = filter.Id + 1
+		}
+	}
+	if nextFilterID > 0 {
+		if err := k.MsgFilterSeq.Set(ctx, nextFilterID); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rateLimit := range genState.RateLimits {
+		if err := k.RateLimits.Set(ctx, rateLimit.MsgTypeUrl, *rateLimit); err != nil {
+			panic(err)
+		}
+	}
 }
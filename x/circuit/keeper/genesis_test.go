@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -68,9 +69,15 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	url := "test_url"
 
+	expiresAt := time.Unix(1000, 0).UTC()
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		TripExpiries:       []*types.TripExpiry{{MsgTypeUrl: url, ExpiresAt: expiresAt}},
+		MsgFilters: []*types.MsgFilter{
+			{Id: 4, MsgTypeUrl: "filter_url", Conditions: []types.FieldCondition{{Path: "denom", Value: "uatom"}}, ExpiresAt: &expiresAt},
+		},
+		RateLimits: []*types.RateLimit{{MsgTypeUrl: "rate_limit_url", MaxMsgs: 5, Window: time.Hour}},
 	}
 
 	s.keeper.InitGenesis(s.ctx, genesisState)
@@ -85,4 +92,12 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.TripExpiries, exportedGenesisState.TripExpiries)
+	s.Require().Equal(genesisState.MsgFilters, exportedGenesisState.MsgFilters)
+	s.Require().Equal(genesisState.RateLimits, exportedGenesisState.RateLimits)
+
+	// new filters get an id after the ones in genesis
+	nextID, err := s.keeper.MsgFilterSeq.Peek(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), nextID)
 }
@@ -1,14 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	context "context"
+	"encoding/json"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the circuit module's keeper.
@@ -25,6 +30,16 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// TripExpiries contains the time at which disabled message URLs are automatically reset
+	TripExpiries collections.Map[string, types.TripExpiry]
+	// MsgFilters contains the filters disabling messages by field, indexed by message URL and filter id
+	MsgFilters collections.Map[collections.Pair[string, uint64], types.MsgFilter]
+	// MsgFilterSeq is the sequence used to assign filter ids
+	MsgFilterSeq collections.Sequence
+	// RateLimits contains the rate limits of message URLs
+	RateLimits collections.Map[string, types.RateLimit]
+	// RateLimitUsages contains the usage of the current window of each rate limit
+	RateLimitUsages collections.Map[string, types.RateLimitUsage]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +69,39 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		TripExpiries: collections.NewMap(
+			sb,
+			types.TripExpiryPrefix,
+			"trip_expiries",
+			collections.StringKey,
+			codec.CollValue[types.TripExpiry](cdc),
+		),
+		MsgFilters: collections.NewMap(
+			sb,
+			types.MsgFilterPrefix,
+			"msg_filters",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.MsgFilter](cdc),
+		),
+		MsgFilterSeq: collections.NewSequence(
+			sb,
+			types.MsgFilterSeqPrefix,
+			"msg_filter_seq",
+		),
+		RateLimits: collections.NewMap(
+			sb,
+			types.RateLimitPrefix,
+			"rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		RateLimitUsages: collections.NewMap(
+			sb,
+			types.RateLimitUsagePrefix,
+			"rate_limit_usages",
+			collections.StringKey,
+			codec.CollValue[types.RateLimitUsage](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -70,7 +118,156 @@ func (k *Keeper) GetAuthority() []byte {
 }
 
 // IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// A msg URL whose trip has expired is allowed, it is removed from the DisableList at the next BeginBlock.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
-	return !has, err
+	if err != nil || !has {
+		return !has, err
+	}
+
+	expiry, err := k.TripExpiries.Get(ctx, msgURL)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return !sdk.UnwrapSDKContext(ctx).BlockTime().Before(expiry.ExpiresAt), nil
+}
+
+// IsMsgAllowed returns true when the msg URL is allowed, no active MsgFilter of the msg URL
+// matches the msg and the rate limit of the msg URL is not reached, else false.
+// When the msg is allowed, it is counted in the current window of the rate limit.
+func (k *Keeper) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	msgURL := sdk.MsgTypeURL(msg)
+	isAllowed, err := k.IsAllowed(ctx, msgURL)
+	if err != nil || !isAllowed {
+		return isAllowed, err
+	}
+
+	// the circuit breaker bookkeeping must not change the gas consumption of msgs
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	isAllowed, err = k.checkMsgFilters(sdkCtx, msgURL, msg)
+	if err != nil || !isAllowed {
+		return isAllowed, err
+	}
+
+	return k.useRateLimit(sdkCtx, msgURL)
+}
+
+// checkMsgFilters returns false when an active filter of msgURL matches msg.
+func (k *Keeper) checkMsgFilters(ctx sdk.Context, msgURL string, msg sdk.Msg) (bool, error) {
+	var decoded map[string]any
+	isAllowed := true
+	err := k.MsgFilters.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](msgURL), func(_ collections.Pair[string, uint64], filter types.MsgFilter) (bool, error) {
+		if filter.ExpiresAt != nil && !ctx.BlockTime().Before(*filter.ExpiresAt) {
+			return false, nil
+		}
+
+		// only decode the msg once there is an active filter for it
+		if decoded == nil {
+			bz, err := k.marshalJSON(msg)
+			if err != nil {
+				return true, err
+			}
+			dec := json.NewDecoder(bytes.NewReader(bz))
+			dec.UseNumber()
+			if err := dec.Decode(&decoded); err != nil {
+				return true, err
+			}
+		}
+
+		isAllowed = !filter.Matches(decoded)
+		return !isAllowed, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return false, err
+	}
+
+	return isAllowed, nil
+}
+
+// useRateLimit counts a msg in the current window of the rate limit of msgURL
+// and returns false when the window is already full.
+func (k *Keeper) useRateLimit(ctx sdk.Context, msgURL string) (bool, error) {
+	rateLimit, err := k.RateLimits.Get(ctx, msgURL)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	usage, err := k.RateLimitUsages.Get(ctx, msgURL)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	blockTime := ctx.BlockTime()
+	if err != nil || !blockTime.Before(usage.WindowStart.Add(rateLimit.Window)) {
+		usage = types.RateLimitUsage{WindowStart: blockTime}
+	}
+
+	if usage.Count >= rateLimit.MaxMsgs {
+		return false, nil
+	}
+
+	usage.Count++
+	return true, k.RateLimitUsages.Set(ctx, msgURL, usage)
+}
+
+func (k *Keeper) marshalJSON(msg sdk.Msg) ([]byte, error) {
+	if cdc, ok := k.cdc.(codec.JSONCodec); ok {
+		return cdc.MarshalJSON(msg)
+	}
+
+	return codec.ProtoMarshalJSON(msg, nil)
+}
+
+// PruneExpired resets the circuit breaker of the msg URLs whose trip has
+// expired and removes the expired msg filters.
+func (k *Keeper) PruneExpired(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var expiredURLs []string
+	err := k.TripExpiries.Walk(ctx, nil, func(msgURL string, expiry types.TripExpiry) (bool, error) {
+		if !blockTime.Before(expiry.ExpiresAt) {
+			expiredURLs = append(expiredURLs, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, msgURL := range expiredURLs {
+		if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+		if err := k.TripExpiries.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+	}
+
+	var expiredFilters []collections.Pair[string, uint64]
+	err = k.MsgFilters.Walk(ctx, nil, func(key collections.Pair[string, uint64], filter types.MsgFilter) (bool, error) {
+		if filter.ExpiresAt != nil && !blockTime.Before(*filter.ExpiresAt) {
+			expiredFilters = append(expiredFilters, key)
+		}
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, key := range expiredFilters {
+		if err := k.MsgFilters.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	context "context"
 	"testing"
+	"time"
 
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
@@ -17,8 +19,10 @@ import (
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var addresses = []string{
@@ -162,3 +166,142 @@ func TestIterateDisabledList(t *testing.T) {
 	require.Equal(t, mockMsgs[1], returnedDisabled[0])
 	require.Equal(t, mockMsgs[2], returnedDisabled[1])
 }
+
+func TestIsAllowedTripExpiry(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	now := time.Unix(1000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	require.NoError(t, f.keeper.DisableList.Set(ctx, f.mockMsgURL))
+	require.NoError(t, f.keeper.TripExpiries.Set(ctx, f.mockMsgURL, types.TripExpiry{MsgTypeUrl: f.mockMsgURL, ExpiresAt: now.Add(time.Hour)}))
+
+	allowed, err := f.keeper.IsAllowed(ctx, f.mockMsgURL)
+	require.NoError(t, err)
+	require.False(t, allowed, "trip has not expired yet")
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	allowed, err = f.keeper.IsAllowed(ctx, f.mockMsgURL)
+	require.NoError(t, err)
+	require.True(t, allowed, "trip has expired")
+
+	// pruning removes the expired trip
+	require.NoError(t, f.keeper.PruneExpired(ctx))
+	has, err := f.keeper.DisableList.Has(ctx, f.mockMsgURL)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.TripExpiries.Has(ctx, f.mockMsgURL)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestIsMsgAllowedMsgFilters(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	now := time.Unix(1000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	msgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiresAt := now.Add(time.Hour)
+	filters := []types.MsgFilter{
+		{Id: 0, MsgTypeUrl: msgURL, Conditions: []types.FieldCondition{{Path: "amount.denom", Value: "uatom"}}},
+		{Id: 1, MsgTypeUrl: msgURL, Conditions: []types.FieldCondition{
+			{Path: "from_address", Value: addresses[0]},
+			{Path: "to_address", Value: addresses[1]},
+		}, ExpiresAt: &expiresAt},
+	}
+	for _, filter := range filters {
+		require.NoError(t, f.keeper.MsgFilters.Set(ctx, collections.Join(filter.MsgTypeUrl, filter.Id), filter))
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		allowed bool
+	}{
+		{
+			name:    "denom matches",
+			msg:     banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("uatom", 1))),
+			allowed: false,
+		},
+		{
+			name:    "denom does not match",
+			msg:     banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+			allowed: true,
+		},
+		{
+			name:    "all addresses match",
+			msg:     &banktypes.MsgSend{FromAddress: addresses[0], ToAddress: addresses[1], Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			allowed: false,
+		},
+		{
+			name:    "only one address matches",
+			msg:     &banktypes.MsgSend{FromAddress: addresses[0], ToAddress: addresses[2], Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			allowed: true,
+		},
+		{
+			name:    "other msg type",
+			msg:     &banktypes.MsgMultiSend{Inputs: []banktypes.Input{{Address: addresses[0], Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))}}},
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		allowed, err := f.keeper.IsMsgAllowed(ctx, tc.msg)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.allowed, allowed, tc.name)
+	}
+
+	// the address filter expires
+	ctx = ctx.WithBlockTime(expiresAt)
+	allowed, err := f.keeper.IsMsgAllowed(ctx, testCases[2].msg)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, f.keeper.PruneExpired(ctx))
+	has, err := f.keeper.MsgFilters.Has(ctx, collections.Join(msgURL, uint64(1)))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.MsgFilters.Has(ctx, collections.Join(msgURL, uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestIsMsgAllowedRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	now := time.Unix(1000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	msgURL := sdk.MsgTypeURL(msg)
+	require.NoError(t, f.keeper.RateLimits.Set(ctx, msgURL, types.RateLimit{MsgTypeUrl: msgURL, MaxMsgs: 2, Window: time.Minute}))
+
+	for i := 0; i < 2; i++ {
+		allowed, err := f.keeper.IsMsgAllowed(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	// the rate limit does not consume more gas than checking the disable list
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err := f.keeper.IsAllowed(ctx, msgURL)
+	require.NoError(t, err)
+	isAllowedGas := ctx.GasMeter().GasConsumed() - gasBefore
+
+	gasBefore = ctx.GasMeter().GasConsumed()
+	allowed, err := f.keeper.IsMsgAllowed(ctx, msg)
+	require.NoError(t, err)
+	require.False(t, allowed, "rate limit is reached")
+	require.Equal(t, isAllowedGas, ctx.GasMeter().GasConsumed()-gasBefore)
+
+	// a new window starts
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	allowed, err = f.keeper.IsMsgAllowed(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	usage, err := f.keeper.RateLimitUsages.Get(ctx, msgURL)
+	require.NoError(t, err)
+	require.Equal(t, types.RateLimitUsage{WindowStart: now.Add(time.Minute), Count: 1}, usage)
+}
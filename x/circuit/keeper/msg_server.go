@@ -134,14 +134,29 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("authority", msg.Authority),
+		sdk.NewAttribute("msg_url", urls),
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ResetAfter != nil {
+		if *msg.ResetAfter <= 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reset after must be positive")
+		}
+
+		expiresAt := sdkCtx.BlockTime().Add(*msg.ResetAfter)
+		for _, msgTypeURL := range msg.MsgTypeUrls {
+			expiry := types.TripExpiry{MsgTypeUrl: msgTypeURL, ExpiresAt: expiresAt}
+			if err = srv.TripExpiries.Set(ctx, msgTypeURL, expiry); err != nil {
+				return nil, err
+			}
+		}
+		attrs = append(attrs, sdk.NewAttribute("expires_at", expiresAt.String()))
+	}
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			"trip_circuit_breaker",
-			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
-		),
+		sdk.NewEvent("trip_circuit_breaker", attrs...),
 	})
 
 	return &types.MsgTripCircuitBreakerResponse{
@@ -179,6 +194,10 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 			if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 				return nil, err
 			}
+
+			if err = srv.TripExpiries.Remove(ctx, msgTypeURL); err != nil {
+				return nil, err
+			}
 		}
 	} else {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
@@ -197,3 +216,164 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
+
+// AddMsgFilter pauses processing of the Msg's of a type URL whose fields
+// match the given conditions.
+func (srv msgServer) AddMsgFilter(ctx context.Context, msg *types.MsgAddMsgFilter) (*types.MsgAddMsgFilterResponse, error) {
+	if err := srv.checkTripPermission(ctx, msg.Authority, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	filter := types.MsgFilter{
+		MsgTypeUrl: msg.MsgTypeUrl,
+		Conditions: msg.Conditions,
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ResetAfter != nil {
+		if *msg.ResetAfter <= 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reset after must be positive")
+		}
+
+		expiresAt := sdkCtx.BlockTime().Add(*msg.ResetAfter)
+		filter.ExpiresAt = &expiresAt
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	id, err := srv.MsgFilterSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter.Id = id
+
+	if err = srv.MsgFilters.Set(ctx, collections.Join(filter.MsgTypeUrl, id), filter); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"add_msg_filter",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", msg.MsgTypeUrl),
+			sdk.NewAttribute("filter_id", fmt.Sprint(id)),
+		),
+	})
+
+	return &types.MsgAddMsgFilterResponse{Id: id}, nil
+}
+
+// RemoveMsgFilter removes a filter added using AddMsgFilter.
+func (srv msgServer) RemoveMsgFilter(ctx context.Context, msg *types.MsgRemoveMsgFilter) (*types.MsgRemoveMsgFilterResponse, error) {
+	var (
+		key   collections.Pair[string, uint64]
+		found bool
+	)
+	err := srv.MsgFilters.Walk(ctx, nil, func(k collections.Pair[string, uint64], filter types.MsgFilter) (bool, error) {
+		if filter.Id == msg.Id {
+			key, found = k, true
+		}
+		return found, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "msg filter %d not found", msg.Id)
+	}
+
+	if err = srv.checkTripPermission(ctx, msg.Authority, key.K1()); err != nil {
+		return nil, err
+	}
+
+	if err = srv.MsgFilters.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"remove_msg_filter",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", key.K1()),
+			sdk.NewAttribute("filter_id", fmt.Sprint(msg.Id)),
+		),
+	})
+
+	return &types.MsgRemoveMsgFilterResponse{}, nil
+}
+
+// SetRateLimit sets or removes the rate limit of a Msg type URL.
+func (srv msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	rateLimit := msg.RateLimit
+	if err := srv.checkTripPermission(ctx, msg.Authority, rateLimit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if rateLimit.MaxMsgs == 0 {
+		if err := srv.RateLimits.Remove(ctx, rateLimit.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := rateLimit.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if err := srv.RateLimits.Set(ctx, rateLimit.MsgTypeUrl, rateLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	// a new rate limit always starts with a new window
+	if err := srv.RateLimitUsages.Remove(ctx, rateLimit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", rateLimit.MsgTypeUrl),
+			sdk.NewAttribute("max_msgs", fmt.Sprint(rateLimit.MaxMsgs)),
+			sdk.NewAttribute("window", rateLimit.Window.String()),
+		),
+	})
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// checkTripPermission returns an error if the authority is not allowed to
+// trip the circuit breaker of msgTypeURL.
+func (srv msgServer) checkTripPermission(ctx context.Context, authority, msgTypeURL string) error {
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN, types.Permissions_LEVEL_ALL_MSGS:
+		return nil
+	case types.Permissions_LEVEL_SOME_MSGS:
+		for _, msgurl := range perms.LimitTypeUrls {
+			if msgTypeURL == msgurl {
+				return nil
+			}
+		}
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
+	}
+}
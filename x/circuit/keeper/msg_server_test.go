@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +12,7 @@ import (
 	"cosmossdk.io/x/circuit/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "cosmos.bank.v1beta1.MsgSend"
@@ -310,6 +312,166 @@ func TestResetCircuitBreaker(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTripCircuitBreakerResetAfter(t *testing.T) {
+	ft := initFixture(t)
+	now := time.Unix(1000, 0).UTC()
+	ft.ctx = sdk.UnwrapSDKContext(ft.ctx).WithBlockTime(now)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	resetAfter := time.Hour
+	trip := &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ResetAfter: &resetAfter}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expires_at", now.Add(resetAfter).String()),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	expiry, err := ft.keeper.TripExpiries.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, types.TripExpiry{MsgTypeUrl: msgSend, ExpiresAt: now.Add(resetAfter)}, expiry)
+
+	// resetting the circuit breaker removes the expiry
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	has, err := ft.keeper.TripExpiries.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the reset duration must be positive
+	resetAfter = 0
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.ErrorContains(t, err, "reset after must be positive")
+}
+
+func TestAddAndRemoveMsgFilter(t *testing.T) {
+	ft := initFixture(t)
+	now := time.Unix(1000, 0).UTC()
+	ft.ctx = sdk.UnwrapSDKContext(ft.ctx).WithBlockTime(now)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	conditions := []types.FieldCondition{{Path: "amount.denom", Value: "uatom"}}
+	resetAfter := time.Hour
+
+	// admin adds a filter
+	res, err := srv.AddMsgFilter(ft.ctx, types.NewMsgAddMsgFilter(authority, msgSend, conditions, &resetAfter))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"add_msg_filter",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("filter_id", "0"),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	expiresAt := now.Add(resetAfter)
+	filter, err := ft.keeper.MsgFilters.Get(ft.ctx, collections.Join(msgSend, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, types.MsgFilter{Id: 0, MsgTypeUrl: msgSend, Conditions: conditions, ExpiresAt: &expiresAt}, filter)
+
+	// a filter without conditions is rejected
+	_, err = srv.AddMsgFilter(ft.ctx, types.NewMsgAddMsgFilter(authority, msgSend, nil, nil))
+	require.ErrorContains(t, err, "no conditions")
+
+	// user with permissions for some msgs can only filter these msgs
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSend}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: somemsgs})
+	require.NoError(t, err)
+
+	res, err = srv.AddMsgFilter(ft.ctx, types.NewMsgAddMsgFilter(addresses[1], msgSend, conditions, nil))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	_, err = srv.AddMsgFilter(ft.ctx, types.NewMsgAddMsgFilter(addresses[1], "cosmos.staking.v1beta1.MsgDelegate", conditions, nil))
+	require.ErrorContains(t, err, "MsgDelegate: unauthorized")
+
+	// user with no permission attempts to add or remove a filter
+	_, err = srv.AddMsgFilter(ft.ctx, types.NewMsgAddMsgFilter(addresses[2], msgSend, conditions, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RemoveMsgFilter(ft.ctx, types.NewMsgRemoveMsgFilter(addresses[2], 1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// user removes a filter
+	_, err = srv.RemoveMsgFilter(ft.ctx, types.NewMsgRemoveMsgFilter(addresses[1], 1))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"remove_msg_filter",
+			sdk.NewAttribute("authority", addresses[1]),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("filter_id", "1"),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	has, err := ft.keeper.MsgFilters.Has(ft.ctx, collections.Join(msgSend, uint64(1)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = srv.RemoveMsgFilter(ft.ctx, types.NewMsgRemoveMsgFilter(authority, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestSetRateLimit(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// admin sets a rate limit
+	_, err = srv.SetRateLimit(ft.ctx, types.NewMsgSetRateLimit(authority, msgSend, 10, time.Hour))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("max_msgs", "10"),
+			sdk.NewAttribute("window", time.Hour.String()),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	rateLimit, err := ft.keeper.RateLimits.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, types.RateLimit{MsgTypeUrl: msgSend, MaxMsgs: 10, Window: time.Hour}, rateLimit)
+
+	// the window must be positive
+	_, err = srv.SetRateLimit(ft.ctx, types.NewMsgSetRateLimit(authority, msgSend, 10, 0))
+	require.ErrorContains(t, err, "positive window")
+
+	// user with no permission attempts to set a rate limit
+	_, err = srv.SetRateLimit(ft.ctx, types.NewMsgSetRateLimit(addresses[1], msgSend, 1, time.Hour))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a max of zero msgs removes the rate limit
+	_, err = srv.SetRateLimit(ft.ctx, types.NewMsgSetRateLimit(authority, msgSend, 0, 0))
+	require.NoError(t, err)
+
+	has, err := ft.keeper.RateLimits.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
 func lastEvent(ctx context.Context) sdk.Event {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	events := sdkCtx.EventManager().Events()
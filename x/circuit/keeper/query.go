@@ -78,3 +78,37 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// MsgFilters returns the active Msg filters.
+func (qs QueryServer) MsgFilters(ctx context.Context, req *types.QueryMsgFiltersRequest) (*types.MsgFiltersResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.MsgFilters,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.MsgFilter) (*types.MsgFilter, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFiltersResponse{MsgFilters: results, Pagination: pageRes}, nil
+}
+
+// RateLimits returns the Msg rate limits.
+func (qs QueryServer) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.RateLimitsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.RateLimits,
+		req.Pagination,
+		func(_ string, value types.RateLimit) (*types.RateLimit, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitsResponse{RateLimits: results, Pagination: pageRes}, nil
+}
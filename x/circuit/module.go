@@ -91,7 +91,10 @@ type AppModule struct {
 	keeper keeper.Keeper
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -116,6 +119,12 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// BeginBlock resets the circuit breakers and removes the msg filters which
+// have expired.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.PruneExpired(ctx)
+}
+
 // InitGenesis performs genesis initialization for the circuit module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeCircuitBreaker{}, "cosmos-sdk/MsgAuthorizeCircuitBreaker")
 	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker")
 	legacy.RegisterAminoMsg(cdc, &MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgAddMsgFilter{}, "cosmos-sdk/MsgAddMsgFilter")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMsgFilter{}, "cosmos-sdk/MsgRemoveMsgFilter")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRateLimit{}, "cosmos-sdk/MsgSetRateLimit")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -22,6 +25,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgAddMsgFilter{},
+		&MsgRemoveMsgFilter{},
+		&MsgSetRateLimit{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
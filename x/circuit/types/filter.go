@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate performs a stateless validation of the filter.
+func (f MsgFilter) Validate() error {
+	if f.MsgTypeUrl == "" {
+		return fmt.Errorf("msg filter %d has an empty msg type url", f.Id)
+	}
+	if len(f.Conditions) == 0 {
+		return fmt.Errorf("msg filter %d has no conditions", f.Id)
+	}
+	for _, cond := range f.Conditions {
+		if cond.Path == "" {
+			return fmt.Errorf("msg filter %d has a condition with an empty path", f.Id)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true when all the conditions of the filter match the given
+// message, decoded from its proto JSON encoding.
+func (f MsgFilter) Matches(msg map[string]any) bool {
+	for _, cond := range f.Conditions {
+		if !matchPath(msg, strings.Split(cond.Path, "."), cond.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchPath walks the path in value and returns true if the field it leads to
+// is equal to expected. Repeated fields match if any of their elements matches.
+func matchPath(value any, path []string, expected string) bool {
+	switch v := value.(type) {
+	case []any:
+		for _, elem := range v {
+			if matchPath(elem, path, expected) {
+				return true
+			}
+		}
+		return false
+	case map[string]any:
+		if len(path) == 0 {
+			return false
+		}
+		field, ok := v[path[0]]
+		if !ok {
+			return false
+		}
+		return matchPath(field, path[1:], expected)
+	case nil:
+		return false
+	default:
+		return len(path) == 0 && fmt.Sprint(v) == expected
+	}
+}
+
+// Validate performs a stateless validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return fmt.Errorf("rate limit has an empty msg type url")
+	}
+	if r.MaxMsgs == 0 {
+		return fmt.Errorf("rate limit of %s must allow at least one msg", r.MsgTypeUrl)
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("rate limit of %s must have a positive window", r.MsgTypeUrl)
+	}
+
+	return nil
+}
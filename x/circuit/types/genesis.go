@@ -20,6 +20,40 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+	for _, expiry := range gs.TripExpiries {
+		if !disabled[expiry.MsgTypeUrl] {
+			return fmt.Errorf("trip expiry for msg type url %s which is not disabled", expiry.MsgTypeUrl)
+		}
+	}
+
+	filterIDs := make(map[uint64]bool, len(gs.MsgFilters))
+	for _, filter := range gs.MsgFilters {
+		if filterIDs[filter.Id] {
+			return fmt.Errorf("duplicate msg filter id: %d", filter.Id)
+		}
+		filterIDs[filter.Id] = true
+
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	rateLimits := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if rateLimits[rateLimit.MsgTypeUrl] {
+			return fmt.Errorf("duplicate rate limit for msg type url: %s", rateLimit.MsgTypeUrl)
+		}
+		rateLimits[rateLimit.MsgTypeUrl] = true
+
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
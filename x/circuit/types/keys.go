@@ -17,4 +17,9 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripExpiryPrefix        = collections.NewPrefix(3)
+	MsgFilterPrefix         = collections.NewPrefix(4)
+	MsgFilterSeqPrefix      = collections.NewPrefix(5)
+	RateLimitPrefix         = collections.NewPrefix(6)
+	RateLimitUsagePrefix    = collections.NewPrefix(7)
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,9 @@ var (
 	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
 	_ sdk.Msg = &MsgTripCircuitBreaker{}
 	_ sdk.Msg = &MsgResetCircuitBreaker{}
+	_ sdk.Msg = &MsgAddMsgFilter{}
+	_ sdk.Msg = &MsgRemoveMsgFilter{}
+	_ sdk.Msg = &MsgSetRateLimit{}
 )
 
 // NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
@@ -34,3 +39,33 @@ func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuit
 		MsgTypeUrls: urls,
 	}
 }
+
+// NewMsgAddMsgFilter creates a new MsgAddMsgFilter instance.
+func NewMsgAddMsgFilter(authority, url string, conditions []FieldCondition, resetAfter *time.Duration) *MsgAddMsgFilter {
+	return &MsgAddMsgFilter{
+		Authority:  authority,
+		MsgTypeUrl: url,
+		Conditions: conditions,
+		ResetAfter: resetAfter,
+	}
+}
+
+// NewMsgRemoveMsgFilter creates a new MsgRemoveMsgFilter instance.
+func NewMsgRemoveMsgFilter(authority string, id uint64) *MsgRemoveMsgFilter {
+	return &MsgRemoveMsgFilter{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance.
+func NewMsgSetRateLimit(authority, url string, maxMsgs uint64, window time.Duration) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		RateLimit: RateLimit{
+			MsgTypeUrl: url,
+			MaxMsgs:    maxMsgs,
+			Window:     window,
+		},
+	}
+}
@@ -16,8 +16,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod: "GasSchedule",
+					Use:       "gas-schedule",
+					Short:     "Query the active gas schedule of the store operations",
+				},
 			},
 		},
-		// Tx is purposely left empty, as the only tx is MsgUpdateParams which is gov gated.
+		// Tx is purposely left empty, as MsgUpdateParams and MsgUpdateGasSchedule are gov gated.
 	}
 }
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/consensus/types"
+)
+
+// InitGenesis sets the gas schedule of the genesis state, if any. The consensus
+// params are initialized by baseapp from the genesis of CometBFT.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if gs.GasSchedule == nil {
+		return nil
+	}
+
+	_, err := k.setGasSchedule(ctx, *gs.GasSchedule)
+	return err
+}
+
+// ExportGenesis returns the genesis state of the consensus module, with the gas
+// schedule set by governance, if any.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	schedule, err := k.GasScheduleStore.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultGenesisState(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{GasSchedule: &schedule}, nil
+}
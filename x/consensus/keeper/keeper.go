@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -14,6 +15,7 @@ import (
 	storetypes "cosmossdk.io/core/store"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus/exported"
 	"github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
@@ -26,6 +28,12 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// GasScheduleStore holds the gas schedule set by governance, if any.
+	GasScheduleStore collections.Item[types.GasSchedule]
+
+	// storeKeys returns the names of the stores mounted by the app, to which
+	// the store gas configs are restricted. Any store key is accepted if nil.
+	storeKeys func() []string
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -33,10 +41,11 @@ var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
 func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string, em event.Service) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	return Keeper{
-		storeService: storeService,
-		authority:    authority,
-		event:        em,
-		ParamsStore:  collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		storeService:     storeService,
+		authority:        authority,
+		event:            em,
+		ParamsStore:      collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		GasScheduleStore: collections.NewItem(sb, collections.NewPrefix("GasSchedule"), "gas_schedule", codec.CollValue[types.GasSchedule](cdc)),
 	}
 }
 
@@ -44,6 +53,27 @@ func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetStoreKeys sets the function returning the names of the stores mounted by
+// the app. Gas schedules configuring the gas costs of other stores are then
+// rejected. It is a function as the stores are mounted after the keeper is
+// created, and it must be set before the keeper is copied into the module.
+func (k *Keeper) SetStoreKeys(storeKeys func() []string) {
+	k.storeKeys = storeKeys
+}
+
+// GetGasSchedule returns the gas schedule set by governance, or the default
+// one if none was set.
+func (k Keeper) GetGasSchedule(ctx context.Context) (sdk.GasSchedule, error) {
+	schedule, err := k.GasScheduleStore.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.DefaultGasSchedule(), nil
+	} else if err != nil {
+		return sdk.GasSchedule{}, err
+	}
+
+	return schedule.ToSDKGasSchedule(), nil
+}
+
 // Querier
 
 var _ types.QueryServer = Keeper{}
@@ -58,6 +88,16 @@ func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
 
+// GasSchedule queries the active gas schedule
+func (k Keeper) GasSchedule(ctx context.Context, _ *types.QueryGasScheduleRequest) (*types.QueryGasScheduleResponse, error) {
+	schedule, err := k.GetGasSchedule(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasScheduleResponse{GasSchedule: types.NewGasSchedule(schedule)}, nil
+}
+
 // MsgServer
 
 var _ types.MsgServer = Keeper{}
@@ -86,3 +126,51 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k Keeper) UpdateGasSchedule(ctx context.Context, msg *types.MsgUpdateGasSchedule) (*types.MsgUpdateGasScheduleResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	schedule, err := k.setGasSchedule(ctx, msg.GasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		"update_gas_schedule",
+		event.Attribute{Key: "authority", Value: msg.Authority},
+		event.Attribute{Key: "gas_schedule", Value: schedule.String()}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGasScheduleResponse{}, nil
+}
+
+// setGasSchedule validates the gas schedule, checking that its stores are
+// mounted by the app, and stores it in its canonical form, sorted by store key.
+func (k Keeper) setGasSchedule(ctx context.Context, gs types.GasSchedule) (types.GasSchedule, error) {
+	if err := gs.Validate(); err != nil {
+		return types.GasSchedule{}, err
+	}
+
+	if k.storeKeys != nil {
+		mounted := make(map[string]bool)
+		for _, storeKey := range k.storeKeys() {
+			mounted[storeKey] = true
+		}
+		for _, store := range gs.Stores {
+			if !mounted[store.StoreKey] {
+				return types.GasSchedule{}, fmt.Errorf("store %s is not mounted by the app", store.StoreKey)
+			}
+		}
+	}
+
+	schedule := types.NewGasSchedule(gs.ToSDKGasSchedule())
+	if err := k.GasScheduleStore.Set(ctx, schedule); err != nil {
+		return types.GasSchedule{}, err
+	}
+
+	return schedule, nil
+}
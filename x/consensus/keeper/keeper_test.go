@@ -35,6 +35,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	storeService := runtime.NewKVStoreService(key)
 
 	keeper := consensusparamkeeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress("gov").String(), runtime.EventService{})
+	keeper.SetStoreKeys(func() []string { return []string{"bank", "staking"} })
 
 	s.ctx = ctx
 	s.consensusParamsKeeper = &keeper
@@ -197,3 +198,190 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryGasSchedule() {
+	res, err := s.queryClient.GasSchedule(s.ctx, &types.QueryGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultGasSchedule(), res.GasSchedule)
+
+	bankGasConfig := types.DefaultGasSchedule().Kv
+	bankGasConfig.ReadCostFlat, bankGasConfig.WriteCostFlat = 10, 20
+
+	schedule := types.DefaultGasSchedule()
+	schedule.Stores = []types.StoreGasConfig{
+		{StoreKey: "bank", GasConfig: bankGasConfig},
+	}
+	_, err = s.consensusParamsKeeper.UpdateGasSchedule(s.ctx, &types.MsgUpdateGasSchedule{
+		Authority:   s.consensusParamsKeeper.GetAuthority(),
+		GasSchedule: schedule,
+	})
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.GasSchedule(s.ctx, &types.QueryGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, res.GasSchedule)
+}
+
+func (s *KeeperTestSuite) TestUpdateGasSchedule() {
+	bankGasConfig := types.DefaultGasSchedule().Kv
+	bankGasConfig.ReadCostFlat, bankGasConfig.WriteCostFlat = 10, 20
+	stakingGasConfig := types.DefaultGasSchedule().Kv
+	stakingGasConfig.HasCost, stakingGasConfig.IterNextCostFlat = 5, 50
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateGasSchedule
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid schedule",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.DefaultGasSchedule().Kv,
+					Transient: types.DefaultGasSchedule().Transient,
+					Stores: []types.StoreGasConfig{
+						{StoreKey: "staking", GasConfig: stakingGasConfig},
+						{StoreKey: "bank", GasConfig: bankGasConfig},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate store key",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.DefaultGasSchedule().Kv,
+					Transient: types.DefaultGasSchedule().Transient,
+					Stores: []types.StoreGasConfig{
+						{StoreKey: "bank", GasConfig: bankGasConfig},
+						{StoreKey: "bank", GasConfig: stakingGasConfig},
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate store key bank",
+		},
+		{
+			name: "empty store key",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.DefaultGasSchedule().Kv,
+					Transient: types.DefaultGasSchedule().Transient,
+					Stores:    []types.StoreGasConfig{{GasConfig: bankGasConfig}},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "empty store key",
+		},
+		{
+			name: "unmounted store",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.DefaultGasSchedule().Kv,
+					Transient: types.DefaultGasSchedule().Transient,
+					Stores:    []types.StoreGasConfig{{StoreKey: "unknown", GasConfig: bankGasConfig}},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "store unknown is not mounted",
+		},
+		{
+			name: "free kv store operation",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.GasConfig{ReadCostFlat: 10, WriteCostFlat: 20},
+					Transient: types.DefaultGasSchedule().Transient,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid kv gas config: has cost must be at least 1",
+		},
+		{
+			name: "store operation above the maximum cost",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				GasSchedule: types.GasSchedule{
+					Kv:        types.DefaultGasSchedule().Kv,
+					Transient: types.DefaultGasSchedule().Transient,
+					Stores: []types.StoreGasConfig{
+						{StoreKey: "bank", GasConfig: types.GasConfig{HasCost: 1, DeleteCost: 1, ReadCostFlat: 1, WriteCostFlat: 1, WriteCostPerByte: types.MaxGasCost + 1, IterNextCostFlat: 1}},
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid gas config of store bank: write cost per byte must be at most 100000",
+		},
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateGasSchedule{
+				Authority:   "invalid",
+				GasSchedule: types.DefaultGasSchedule(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.consensusParamsKeeper.UpdateGasSchedule(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				schedule, err := s.consensusParamsKeeper.GetGasSchedule(s.ctx)
+				s.Require().NoError(err)
+
+				s.Require().Equal(tc.input.GasSchedule.Kv.ToStoreGasConfig(), schedule.KV)
+				s.Require().Equal(bankGasConfig.ToStoreGasConfig(), schedule.Stores["bank"])
+				s.Require().Equal(stakingGasConfig.ToStoreGasConfig(), schedule.Stores["staking"])
+
+				// the stores are stored sorted by store key
+				res, err := s.consensusParamsKeeper.GasSchedule(s.ctx, &types.QueryGasScheduleRequest{})
+				s.Require().NoError(err)
+				s.Require().Equal("bank", res.GasSchedule.Stores[0].StoreKey)
+				s.Require().Equal("staking", res.GasSchedule.Stores[1].StoreKey)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	// no gas schedule is exported until one is set
+	gs, err := s.consensusParamsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultGenesisState(), gs)
+
+	bankGasConfig := types.DefaultGasSchedule().Kv
+	bankGasConfig.ReadCostFlat, bankGasConfig.WriteCostFlat = 10, 20
+
+	schedule := types.DefaultGasSchedule()
+	schedule.Stores = []types.StoreGasConfig{{StoreKey: "bank", GasConfig: bankGasConfig}}
+	s.Require().NoError(s.consensusParamsKeeper.InitGenesis(s.ctx, &types.GenesisState{GasSchedule: &schedule}))
+
+	// the gas schedule survives an export and import
+	gs, err = s.consensusParamsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(&schedule, gs.GasSchedule)
+
+	s.SetupTest()
+	s.Require().NoError(s.consensusParamsKeeper.InitGenesis(s.ctx, gs))
+	res, err := s.queryClient.GasSchedule(s.ctx, &types.QueryGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, res.GasSchedule)
+
+	// the gas config of an unmounted store is rejected
+	schedule.Stores[0].StoreKey = "unknown"
+	err = s.consensusParamsKeeper.InitGenesis(s.ctx, &types.GenesisState{GasSchedule: &schedule})
+	s.Require().ErrorContains(err, "store unknown is not mounted")
+}
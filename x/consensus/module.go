@@ -3,9 +3,11 @@ package consensus
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc"
 
 	modulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
@@ -13,6 +15,7 @@ import (
 	"cosmossdk.io/core/event"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/store/rootmulti"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -48,15 +51,35 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the consensus
-// module.
+// module. The consensus params are not part of it, as their default genesis is
+// handled by CometBFT.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	// nil is returned since default genesis of consensus params is handled by tendermint
-	return nil
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
-// ValidateGenesis performs genesis state validation
+// ValidateGenesis performs genesis state validation for the consensus module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	return nil
+	data, err := unmarshalGenesis(cdc, bz)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
+// unmarshalGenesis unmarshals the genesis state of the consensus module, which
+// is empty in the genesis files exported before it held the gas schedule.
+func unmarshalGenesis(cdc codec.JSONCodec, bz json.RawMessage) (*types.GenesisState, error) {
+	data := types.DefaultGenesisState()
+	if len(bz) == 0 || string(bz) == "null" {
+		return data, nil
+	}
+
+	if err := cdc.UnmarshalJSON(bz, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes
@@ -107,16 +130,30 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 // Name returns the consensus module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
-// InitGenesis is handled by for init genesis of consensus
-func (am AppModule) InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate {
-	// nil is returned since initgenesis of consensus params is handled by tendermint
-	return nil
+// InitGenesis performs genesis initialization for the consensus module. The
+// consensus params are initialized by baseapp from the genesis of CometBFT.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	genesisState, err := unmarshalGenesis(cdc, bz)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := am.keeper.InitGenesis(ctx, genesisState); err != nil {
+		panic(err)
+	}
+
+	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis is handled by CometBFT export of genesis
-func (am AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	// nil is returned since ExportGenesis of consensus params is handled by CometBFT and baseapp
-	return nil
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// consensus module. The consensus params are exported by CometBFT and baseapp.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return cdc.MustMarshalJSON(gs)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -154,9 +191,23 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	k := keeper.NewKeeper(in.Cdc, in.StoreService, authority.String(), in.EventManager)
+
+	// the stores are mounted once the baseapp options are applied
+	var bApp *baseapp.BaseApp
+	k.SetStoreKeys(func() []string {
+		rs, ok := bApp.CommitMultiStore().(*rootmulti.Store)
+		if !ok {
+			return nil
+		}
+
+		return maps.Keys(rs.StoreKeysByName())
+	})
+
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
+		bApp = app
 		app.SetParamStore(k.ParamsStore)
+		app.SetGasScheduleStore(k)
 	}
 
 	return ModuleOutputs{
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinFlatGasCost is the minimum flat gas cost of a store operation, so that
+	// none of them is free.
+	MinFlatGasCost = 1
+	// MaxGasCost is the maximum gas cost of a store operation, flat or per byte,
+	// so that a gas schedule cannot make every transaction run out of gas.
+	MaxGasCost = 100_000
+)
+
+// NewGasConfig returns the GasConfig of the given store gas config.
+func NewGasConfig(gc storetypes.GasConfig) GasConfig {
+	return GasConfig{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+// ToStoreGasConfig returns the store gas config of the GasConfig.
+func (gc GasConfig) ToStoreGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+// Validate checks that the flat gas costs of the GasConfig are at least
+// MinFlatGasCost, and that none of its gas costs exceeds MaxGasCost.
+func (gc GasConfig) Validate() error {
+	costs := []struct {
+		name string
+		cost uint64
+		min  uint64
+	}{
+		{"has cost", gc.HasCost, MinFlatGasCost},
+		{"delete cost", gc.DeleteCost, MinFlatGasCost},
+		{"read cost flat", gc.ReadCostFlat, MinFlatGasCost},
+		{"read cost per byte", gc.ReadCostPerByte, 0},
+		{"write cost flat", gc.WriteCostFlat, MinFlatGasCost},
+		{"write cost per byte", gc.WriteCostPerByte, 0},
+		{"iter next cost flat", gc.IterNextCostFlat, MinFlatGasCost},
+	}
+	for _, c := range costs {
+		if c.cost < c.min {
+			return fmt.Errorf("%s must be at least %d, got %d", c.name, c.min, c.cost)
+		}
+		if c.cost > MaxGasCost {
+			return fmt.Errorf("%s must be at most %d, got %d", c.name, MaxGasCost, c.cost)
+		}
+	}
+
+	return nil
+}
+
+// NewGasSchedule returns the GasSchedule of the given sdk gas schedule, the
+// store gas configs being sorted by store key.
+func NewGasSchedule(gs sdk.GasSchedule) GasSchedule {
+	schedule := GasSchedule{
+		Kv:        NewGasConfig(gs.KV),
+		Transient: NewGasConfig(gs.Transient),
+	}
+	for storeKey, gc := range gs.Stores {
+		schedule.Stores = append(schedule.Stores, StoreGasConfig{StoreKey: storeKey, GasConfig: NewGasConfig(gc)})
+	}
+	sort.Slice(schedule.Stores, func(i, j int) bool {
+		return schedule.Stores[i].StoreKey < schedule.Stores[j].StoreKey
+	})
+
+	return schedule
+}
+
+// DefaultGasSchedule returns the default GasSchedule.
+func DefaultGasSchedule() GasSchedule {
+	return NewGasSchedule(sdk.DefaultGasSchedule())
+}
+
+// ToSDKGasSchedule returns the sdk gas schedule of the GasSchedule.
+func (gs GasSchedule) ToSDKGasSchedule() sdk.GasSchedule {
+	schedule := sdk.GasSchedule{
+		KV:        gs.Kv.ToStoreGasConfig(),
+		Transient: gs.Transient.ToStoreGasConfig(),
+	}
+	if len(gs.Stores) > 0 {
+		schedule.Stores = make(map[string]storetypes.GasConfig, len(gs.Stores))
+		for _, store := range gs.Stores {
+			schedule.Stores[store.StoreKey] = store.GasConfig.ToStoreGasConfig()
+		}
+	}
+
+	return schedule
+}
+
+// Validate checks that the gas configs of the GasSchedule are valid, and that
+// its store gas configs have distinct non-empty store keys.
+func (gs GasSchedule) Validate() error {
+	if err := gs.Kv.Validate(); err != nil {
+		return fmt.Errorf("invalid kv gas config: %w", err)
+	}
+	if err := gs.Transient.Validate(); err != nil {
+		return fmt.Errorf("invalid transient gas config: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(gs.Stores))
+	for _, store := range gs.Stores {
+		if store.StoreKey == "" {
+			return fmt.Errorf("empty store key in gas schedule")
+		}
+		if _, ok := seen[store.StoreKey]; ok {
+			return fmt.Errorf("duplicate store key %s in gas schedule", store.StoreKey)
+		}
+		seen[store.StoreKey] = struct{}{}
+
+		if err := store.GasConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid gas config of store %s: %w", store.StoreKey, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package types
+
+// DefaultGenesisState returns the default genesis state of the consensus
+// module, without gas schedule.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate checks that the gas schedule of the genesis state, if any, is valid.
+func (gs GenesisState) Validate() error {
+	if gs.GasSchedule == nil {
+		return nil
+	}
+
+	return gs.GasSchedule.Validate()
+}
@@ -7,7 +7,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateGasSchedule{}
+)
 
 func (msg MsgUpdateParams) ToProtoConsensusParams() cmtproto.ConsensusParams {
 	cp := cmtproto.ConsensusParams{
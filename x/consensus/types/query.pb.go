@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -114,33 +115,125 @@ func (m *QueryParamsResponse) GetParams() *types.ConsensusParams {
 	return nil
 }
 
+// QueryGasScheduleRequest defines the request type for querying the gas schedule.
+type QueryGasScheduleRequest struct {
+}
+
+func (m *QueryGasScheduleRequest) Reset()         { *m = QueryGasScheduleRequest{} }
+func (m *QueryGasScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleRequest) ProtoMessage()    {}
+func (*QueryGasScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryGasScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleRequest.Merge(m, src)
+}
+func (m *QueryGasScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleRequest proto.InternalMessageInfo
+
+// QueryGasScheduleResponse defines the response type for querying the gas schedule.
+type QueryGasScheduleResponse struct {
+	// gas_schedule is the active gas schedule, the default one if none was set
+	// by governance.
+	GasSchedule GasSchedule `protobuf:"bytes,1,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+}
+
+func (m *QueryGasScheduleResponse) Reset()         { *m = QueryGasScheduleResponse{} }
+func (m *QueryGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleResponse) ProtoMessage()    {}
+func (*QueryGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleResponse.Merge(m, src)
+}
+func (m *QueryGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryGasScheduleResponse) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGasScheduleRequest)(nil), "cosmos.consensus.v1.QueryGasScheduleRequest")
+	proto.RegisterType((*QueryGasScheduleResponse)(nil), "cosmos.consensus.v1.QueryGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x2d, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x4a, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0x2c,
-	0x08, 0x00, 0x0b, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x05, 0x70, 0x09, 0xa3, 0x88,
-	0x16, 0x17, 0x80, 0x2c, 0x14, 0xb2, 0xe4, 0x62, 0x83, 0x68, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x52, 0xd4, 0x43, 0x18, 0xae, 0x07, 0x36, 0x5c, 0xcf, 0x19, 0xe6, 0x32, 0xa8, 0x56, 0xa8,
-	0x06, 0xa3, 0x2e, 0x46, 0x2e, 0x56, 0xb0, 0x91, 0x42, 0x0d, 0x8c, 0x5c, 0x6c, 0x10, 0x49, 0x21,
-	0x75, 0x3d, 0x2c, 0xfe, 0xd1, 0xc3, 0x74, 0x8f, 0x94, 0x06, 0x61, 0x85, 0x10, 0x27, 0x2a, 0x29,
-	0x37, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x56, 0x48, 0x5a, 0x1f, 0x5b, 0x58, 0x42, 0x1c, 0xe3, 0xe4,
-	0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x08, 0x03, 0x40, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0x92, 0x69, 0x60, 0xef, 0x26, 0xb1, 0x81, 0x43, 0xd1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0xa2, 0x35, 0xa1, 0xba, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6e, 0xda, 0x40,
+	0x18, 0xb7, 0x51, 0xcb, 0x70, 0x74, 0x3a, 0x90, 0x4a, 0xdd, 0x62, 0xc0, 0x0c, 0xa5, 0x52, 0xb9,
+	0x13, 0x74, 0xea, 0x4a, 0x87, 0x26, 0x1b, 0x21, 0x5b, 0x96, 0xe8, 0x30, 0xa7, 0xc3, 0x0a, 0xf6,
+	0x19, 0xdf, 0x19, 0x85, 0x2d, 0xca, 0x13, 0x44, 0x8a, 0xf2, 0x02, 0x79, 0x1a, 0x46, 0xa4, 0x2c,
+	0x99, 0xa2, 0x08, 0xf2, 0x20, 0x91, 0xcf, 0xc7, 0x9f, 0x08, 0xa3, 0x64, 0xba, 0xd3, 0xf7, 0xfd,
+	0xfe, 0xe9, 0xa7, 0x0f, 0x54, 0x5d, 0x2e, 0x7c, 0x2e, 0xb0, 0xcb, 0x03, 0x41, 0x03, 0x11, 0x0b,
+	0x3c, 0x6d, 0xe3, 0x49, 0x4c, 0xa3, 0x19, 0x0a, 0x23, 0x2e, 0x39, 0x2c, 0xa6, 0x00, 0xb4, 0x01,
+	0xa0, 0x69, 0xdb, 0xfa, 0xc1, 0x38, 0x67, 0x63, 0x8a, 0x49, 0xe8, 0x61, 0x12, 0x04, 0x5c, 0x12,
+	0xe9, 0xf1, 0x40, 0xa4, 0x14, 0xab, 0xc4, 0x38, 0xe3, 0xea, 0x8b, 0x93, 0x9f, 0x9e, 0x56, 0x24,
+	0x0d, 0x86, 0x34, 0xf2, 0xbd, 0x40, 0x62, 0x39, 0x0b, 0xa9, 0xc0, 0x21, 0x89, 0x88, 0xbf, 0x26,
+	0x55, 0xb2, 0x82, 0x30, 0xa2, 0xd7, 0x4e, 0x09, 0xc0, 0x93, 0x24, 0x55, 0x4f, 0x71, 0xfa, 0x74,
+	0x12, 0x53, 0x21, 0x9d, 0x1e, 0x28, 0xbe, 0x99, 0x8a, 0x30, 0x61, 0xc3, 0xbf, 0x20, 0x9f, 0x6a,
+	0x97, 0xcd, 0x9a, 0xd9, 0x2c, 0x74, 0xea, 0x68, 0xeb, 0x8d, 0x94, 0x37, 0xfa, 0xb7, 0xb6, 0xd1,
+	0x54, 0x4d, 0x70, 0xbe, 0x81, 0xaf, 0x4a, 0xf1, 0x3f, 0x11, 0xa7, 0xee, 0x88, 0x0e, 0xe3, 0x31,
+	0x5d, 0x9b, 0x51, 0x50, 0xde, 0x5f, 0x69, 0xc7, 0x63, 0xf0, 0x85, 0x11, 0x71, 0x2e, 0xf4, 0x5c,
+	0xfb, 0xd6, 0x50, 0x46, 0x79, 0x68, 0x87, 0xdf, 0xfd, 0x34, 0x7f, 0xaa, 0x1a, 0xfd, 0x02, 0xdb,
+	0x8e, 0x3a, 0xf7, 0x39, 0xf0, 0x59, 0xf9, 0xc0, 0x2b, 0x13, 0xe4, 0xd3, 0x78, 0xf0, 0x67, 0xa6,
+	0xd2, 0x7e, 0x23, 0x56, 0xf3, 0x7d, 0x60, 0x1a, 0xd9, 0x69, 0x5c, 0x3f, 0xbc, 0xdc, 0xe6, 0x2a,
+	0xf0, 0x3b, 0xce, 0x6a, 0x3e, 0xad, 0x03, 0xde, 0x99, 0xa0, 0xb0, 0x93, 0x17, 0xfe, 0x3e, 0x2c,
+	0xbf, 0xdf, 0x98, 0xd5, 0xfa, 0x20, 0x5a, 0x27, 0xfa, 0xa5, 0x12, 0x35, 0x60, 0x1d, 0x1f, 0xb8,
+	0x85, 0x4d, 0xbf, 0xdd, 0xa3, 0xf9, 0xd2, 0x36, 0x17, 0x4b, 0xdb, 0x7c, 0x5e, 0xda, 0xe6, 0xcd,
+	0xca, 0x36, 0x16, 0x2b, 0xdb, 0x78, 0x5c, 0xd9, 0xc6, 0x19, 0x62, 0x9e, 0x1c, 0xc5, 0x03, 0xe4,
+	0x72, 0x7f, 0x2b, 0x93, 0x3c, 0x2d, 0x31, 0xbc, 0xc0, 0x97, 0x3b, 0x9a, 0xea, 0x10, 0x06, 0x79,
+	0x75, 0x5f, 0x7f, 0x5e, 0x07, 0x00, 0x73, 0x60, 0xa3, 0x6d, 0x09, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +250,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasSchedule queries the active gas schedule of the store operations.
+	GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +271,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error) {
+	out := new(QueryGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/GasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasSchedule queries the active gas schedule of the store operations.
+	GasSchedule(context.Context, *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +295,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) GasSchedule(ctx context.Context, req *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +321,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/GasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSchedule(ctx, req.(*QueryGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +347,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasSchedule",
+			Handler:    _Query_GasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -283,6 +414,62 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +503,26 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +665,139 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
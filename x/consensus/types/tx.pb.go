@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -153,42 +154,145 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateGasSchedule is the Msg/UpdateGasSchedule request type.
+type MsgUpdateGasSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// gas_schedule defines the gas schedule to set.
+	//
+	// NOTE: The whole schedule must be supplied.
+	GasSchedule GasSchedule `protobuf:"bytes,2,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+}
+
+func (m *MsgUpdateGasSchedule) Reset()         { *m = MsgUpdateGasSchedule{} }
+func (m *MsgUpdateGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasSchedule) ProtoMessage()    {}
+func (*MsgUpdateGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgUpdateGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasSchedule.Merge(m, src)
+}
+func (m *MsgUpdateGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateGasSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGasSchedule) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
+// MsgUpdateGasScheduleResponse defines the response structure for executing a
+// MsgUpdateGasSchedule message.
+type MsgUpdateGasScheduleResponse struct {
+}
+
+func (m *MsgUpdateGasScheduleResponse) Reset()         { *m = MsgUpdateGasScheduleResponse{} }
+func (m *MsgUpdateGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateGasSchedule)(nil), "cosmos.consensus.v1.MsgUpdateGasSchedule")
+	proto.RegisterType((*MsgUpdateGasScheduleResponse)(nil), "cosmos.consensus.v1.MsgUpdateGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0xcb, 0x12, 0x41,
-	0x1c, 0xc7, 0x5d, 0xff, 0x44, 0x4e, 0x41, 0xb4, 0x05, 0xae, 0x8b, 0x2e, 0x26, 0x11, 0x22, 0x39,
-	0x93, 0x0a, 0x41, 0x11, 0x84, 0x1b, 0x41, 0x1d, 0x84, 0x30, 0xea, 0xd0, 0x25, 0x66, 0x77, 0x87,
-	0x75, 0xd0, 0xdd, 0x59, 0x76, 0xc6, 0x45, 0x6f, 0xd1, 0xb1, 0x53, 0x2f, 0xc5, 0x43, 0x2f, 0xa2,
-	0xa3, 0x74, 0xea, 0xf8, 0xa0, 0x07, 0xe1, 0x79, 0x15, 0x0f, 0xce, 0x8e, 0xee, 0xf3, 0xa8, 0x87,
-	0xe7, 0x22, 0xb8, 0xdf, 0xcf, 0x67, 0xbe, 0xcc, 0x6f, 0x7e, 0xa0, 0xe6, 0x32, 0x1e, 0x30, 0x8e,
-	0x5c, 0x16, 0x72, 0x12, 0xf2, 0x19, 0x47, 0x49, 0x17, 0x89, 0x39, 0x8c, 0x62, 0x26, 0x98, 0xfe,
-	0x28, 0x4d, 0xe1, 0x21, 0x85, 0x49, 0xd7, 0x7c, 0x88, 0x03, 0x1a, 0x32, 0x24, 0x7f, 0x53, 0xce,
-	0xac, 0xa6, 0xdc, 0x77, 0xf9, 0x0f, 0x29, 0x29, 0x8d, 0x2a, 0xaa, 0x20, 0xe0, 0xfe, 0xee, 0xe8,
-	0x80, 0xfb, 0x2a, 0xa8, 0x0b, 0x12, 0x7a, 0x24, 0x0e, 0x68, 0x28, 0x90, 0x58, 0x44, 0x84, 0xa3,
-	0x08, 0xc7, 0x38, 0x50, 0x5e, 0xf3, 0x32, 0x0f, 0x1e, 0x0c, 0xb9, 0xff, 0x25, 0xf2, 0xb0, 0x20,
-	0x9f, 0x64, 0xa2, 0xbf, 0x04, 0x65, 0x3c, 0x13, 0x63, 0x16, 0x53, 0xb1, 0x30, 0xb4, 0x86, 0xd6,
-	0x2a, 0xdb, 0xc6, 0xbf, 0x3f, 0x9d, 0xc7, 0xaa, 0x70, 0xe0, 0x79, 0x31, 0xe1, 0xfc, 0xb3, 0x88,
-	0x69, 0xe8, 0x8f, 0x32, 0x54, 0xef, 0x83, 0x92, 0x33, 0x65, 0xee, 0xc4, 0xc8, 0x37, 0xb4, 0xd6,
-	0xbd, 0x5e, 0x1d, 0x66, 0xd5, 0x50, 0x56, 0x43, 0x7b, 0x17, 0xa7, 0x2d, 0xa3, 0x94, 0xd5, 0xdf,
-	0x80, 0xbb, 0x24, 0xa1, 0x1e, 0x09, 0x5d, 0x62, 0x14, 0xa4, 0xd7, 0x38, 0xf5, 0xde, 0x2b, 0x42,
-	0xa9, 0x07, 0x43, 0x7f, 0x0b, 0xca, 0x09, 0x9e, 0x52, 0x0f, 0x0b, 0x16, 0x1b, 0x45, 0xa9, 0x3f,
-	0x39, 0xd5, 0xbf, 0xee, 0x11, 0xe5, 0x67, 0x8e, 0xfe, 0x02, 0x14, 0xb1, 0xe3, 0x52, 0xa3, 0x24,
-	0xdd, 0xda, 0xa9, 0x3b, 0xb0, 0xdf, 0x7d, 0x54, 0x9a, 0x24, 0x5f, 0xbf, 0xfa, 0xb9, 0x5d, 0xb6,
-	0xb3, 0x5b, 0xff, 0xda, 0x2e, 0xdb, 0xcf, 0xd2, 0xc9, 0x74, 0xb8, 0x37, 0x41, 0xf3, 0x6b, 0x6f,
-	0x7c, 0x34, 0xd8, 0x66, 0x15, 0x54, 0x8e, 0x3e, 0x8d, 0x08, 0x8f, 0x76, 0x78, 0x2f, 0x02, 0x85,
-	0x21, 0xf7, 0x75, 0x07, 0xdc, 0xbf, 0xf1, 0x14, 0x4f, 0xe1, 0x99, 0xd5, 0x80, 0x47, 0x87, 0x98,
-	0xcf, 0x6f, 0x43, 0xed, 0xab, 0xcc, 0xd2, 0x8f, 0xed, 0xb2, 0xad, 0xd9, 0x1f, 0xfe, 0xae, 0x2d,
-	0x6d, 0xb5, 0xb6, 0xb4, 0x8b, 0xb5, 0xa5, 0xfd, 0xde, 0x58, 0xb9, 0xd5, 0xc6, 0xca, 0xfd, 0xdf,
-	0x58, 0xb9, 0x6f, 0xd0, 0xa7, 0x62, 0x3c, 0x73, 0xa0, 0xcb, 0x02, 0x74, 0xd8, 0xdb, 0xb3, 0x17,
-	0x94, 0x23, 0x72, 0xee, 0xc8, 0x55, 0xea, 0x5f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x27, 0xbf,
-	0x79, 0xe5, 0x02, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x6d, 0x23, 0x66, 0x5a, 0x90, 0xae, 0x81, 0x6e, 0x43, 0xb2, 0x8d, 0x41, 0xa4,
+	0x06, 0x3b, 0x63, 0x52, 0x10, 0x2c, 0x82, 0x74, 0x45, 0xd4, 0x43, 0x45, 0x52, 0xf4, 0xe0, 0xa5,
+	0xcc, 0xee, 0x0e, 0x93, 0xa5, 0xd9, 0x9d, 0x75, 0x67, 0x12, 0xda, 0x9b, 0x78, 0xf4, 0xe4, 0xc7,
+	0xf0, 0x98, 0x83, 0x1f, 0xa2, 0xc7, 0xd2, 0x93, 0x27, 0x91, 0xe4, 0x10, 0xf0, 0x53, 0xc8, 0xce,
+	0xce, 0x66, 0x63, 0x76, 0x91, 0xd2, 0x4b, 0xc8, 0xbe, 0xcf, 0xf3, 0x7b, 0xff, 0xed, 0xbb, 0xa0,
+	0xee, 0x30, 0xee, 0x33, 0x8e, 0x1c, 0x16, 0x70, 0x12, 0xf0, 0x21, 0x47, 0xa3, 0x0e, 0x12, 0x67,
+	0x30, 0x8c, 0x98, 0x60, 0xfa, 0xdd, 0x44, 0x85, 0x73, 0x15, 0x8e, 0x3a, 0xb5, 0x4d, 0xec, 0x7b,
+	0x01, 0x43, 0xf2, 0x37, 0xf1, 0xd5, 0xb6, 0x13, 0xdf, 0x89, 0x7c, 0x42, 0x0a, 0x4a, 0xa4, 0x2d,
+	0x55, 0xc0, 0xe7, 0x34, 0x4e, 0xed, 0x73, 0xaa, 0x84, 0x2a, 0x65, 0x94, 0x25, 0x40, 0xfc, 0x4f,
+	0x45, 0x1b, 0x82, 0x04, 0x2e, 0x89, 0x7c, 0x2f, 0x10, 0x48, 0x9c, 0x87, 0x84, 0xa3, 0x10, 0x47,
+	0xd8, 0x4f, 0xb3, 0x35, 0x8a, 0xda, 0xa5, 0x58, 0xc9, 0xad, 0x3f, 0x2b, 0xe0, 0xce, 0x11, 0xa7,
+	0xef, 0x43, 0x17, 0x0b, 0xf2, 0x4e, 0x82, 0xfa, 0x13, 0x50, 0xc1, 0x43, 0xd1, 0x67, 0x91, 0x27,
+	0xce, 0x0d, 0xad, 0xa9, 0xed, 0x56, 0x2c, 0xe3, 0xea, 0xc7, 0x5e, 0x55, 0x75, 0x79, 0xe8, 0xba,
+	0x11, 0xe1, 0xfc, 0x58, 0x44, 0x5e, 0x40, 0x7b, 0x99, 0x55, 0xdf, 0x07, 0x65, 0x7b, 0xc0, 0x9c,
+	0x53, 0x63, 0xa5, 0xa9, 0xed, 0xae, 0x77, 0x1b, 0x30, 0xeb, 0x0c, 0xca, 0xce, 0xa0, 0x15, 0xcb,
+	0x49, 0x95, 0x5e, 0xe2, 0xd5, 0x9f, 0x81, 0xdb, 0x64, 0xe4, 0xb9, 0x24, 0x70, 0x88, 0xb1, 0x2a,
+	0xb9, 0x66, 0x9e, 0x7b, 0xa9, 0x1c, 0x0a, 0x9d, 0x13, 0xfa, 0x73, 0x50, 0x19, 0xe1, 0x81, 0xe7,
+	0x62, 0xc1, 0x22, 0x63, 0x4d, 0xe2, 0xf7, 0xf2, 0xf8, 0x87, 0xd4, 0xa2, 0xf8, 0x8c, 0xd1, 0x1f,
+	0x83, 0x35, 0x6c, 0x3b, 0x9e, 0x51, 0x96, 0x6c, 0x3d, 0xcf, 0x1e, 0x5a, 0x2f, 0xde, 0x28, 0x4c,
+	0x3a, 0x0f, 0x9e, 0x7e, 0x99, 0x8d, 0xdb, 0xd9, 0xd4, 0x5f, 0x67, 0xe3, 0xf6, 0x83, 0x64, 0x33,
+	0x7b, 0xdc, 0x3d, 0x45, 0x67, 0x0b, 0x9b, 0x5e, 0x5a, 0x6c, 0x6b, 0x1b, 0x6c, 0x2d, 0x85, 0x7a,
+	0x84, 0x87, 0xb1, 0xbd, 0x75, 0xa5, 0x81, 0xea, 0x5c, 0x7b, 0x85, 0xf9, 0xb1, 0xd3, 0x27, 0xee,
+	0x70, 0x40, 0x6e, 0xfc, 0x32, 0xde, 0x82, 0x0d, 0x8a, 0xf9, 0x09, 0x57, 0x79, 0xd4, 0x3b, 0x69,
+	0xc2, 0x82, 0xfb, 0x84, 0x0b, 0xf5, 0xac, 0xca, 0xc5, 0xaf, 0x9d, 0xd2, 0xf7, 0xd9, 0xb8, 0xad,
+	0xf5, 0xd6, 0x69, 0x16, 0x3f, 0xe8, 0xe6, 0xc7, 0xde, 0x59, 0x18, 0xbb, 0xa8, 0xf7, 0x96, 0x09,
+	0xea, 0x45, 0xf1, 0x74, 0xe8, 0xee, 0x54, 0x03, 0xab, 0x47, 0x9c, 0xea, 0x36, 0xd8, 0xf8, 0xe7,
+	0x00, 0xef, 0x17, 0x76, 0xb9, 0xb4, 0xba, 0xda, 0xa3, 0xeb, 0xb8, 0xd2, 0x5a, 0xfa, 0x27, 0xb0,
+	0x99, 0x5f, 0xee, 0xc3, 0xff, 0xa7, 0x58, 0xb0, 0xd6, 0x3a, 0xd7, 0xb6, 0xa6, 0x25, 0x6b, 0xe5,
+	0xcf, 0xf1, 0x1a, 0xad, 0xd7, 0x17, 0x13, 0x53, 0xbb, 0x9c, 0x98, 0xda, 0xef, 0x89, 0xa9, 0x7d,
+	0x9b, 0x9a, 0xa5, 0xcb, 0xa9, 0x59, 0xfa, 0x39, 0x35, 0x4b, 0x1f, 0x21, 0xf5, 0x44, 0x7f, 0x68,
+	0x43, 0x87, 0xf9, 0x68, 0xfe, 0x99, 0x16, 0x5e, 0x92, 0xbc, 0x45, 0xfb, 0x96, 0xfc, 0x66, 0xf7,
+	0xff, 0x0e, 0x00, 0x80, 0x2f, 0xd3, 0xb3, 0x83, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -208,6 +312,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule of the store operations. The authority is defined in the keeper.
+	UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -227,6 +334,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error) {
+	out := new(MsgUpdateGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/UpdateGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
@@ -234,6 +350,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule of the store operations. The authority is defined in the keeper.
+	UpdateGasSchedule(context.Context, *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -243,6 +362,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGasSchedule(ctx context.Context, req *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -266,6 +388,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/UpdateGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, req.(*MsgUpdateGasSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -274,6 +414,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasSchedule",
+			Handler:    _Msg_UpdateGasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -380,6 +524,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -429,6 +636,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -711,6 +942,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,7 +13,7 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// misbehavior submitted by CometBFT, i.e. equivocations and light client attacks.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -28,14 +28,18 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for i := 0; i < evidences.Len(); i++ {
 		switch evidences.Get(i).Type() {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case comet.LightClientAttack, comet.DuplicateVote:
+		case comet.DuplicateVote:
 			evidence := types.FromABCIEvidence(evidences.Get(i))
 			err := k.handleEquivocationEvidence(ctx, evidence)
 			if err != nil {
 				return err
 			}
+		case comet.LightClientAttack:
+			evidence := types.LightClientAttackFromABCIEvidence(evidences.Get(i))
+			err := k.handleLightClientAttackEvidence(ctx, evidence)
+			if err != nil {
+				return err
+			}
 		default:
 			k.Logger(sdkCtx).Error(fmt.Sprintf("ignored unknown evidence type: %x", evidences.Get(i).Type()))
 		}
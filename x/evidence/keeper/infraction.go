@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +27,29 @@ import (
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
 func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.Equivocation) error {
+	return k.handleDoubleSignEvidence(ctx, evidence)
+}
+
+// handleLightClientAttackEvidence implements a light client attack evidence
+// handler. It's still ongoing discussion how should we treat and slash attacks
+// with premeditation, so for now the validator taking part in the attack is
+// handled in the same way as an equivocating validator, although the evidence
+// is persisted as a LightClientAttack.
+func (k Keeper) handleLightClientAttackEvidence(ctx context.Context, evidence *types.LightClientAttack) error {
+	return k.handleDoubleSignEvidence(ctx, evidence)
+}
+
+// doubleSignEvidence defines the evidence of a validator infraction, at a given
+// time, which is punished as a double sign.
+type doubleSignEvidence interface {
+	exported.ValidatorEvidence
+
+	GetTime() time.Time
+}
+
+// handleDoubleSignEvidence slashes, jails and tombstones the validator of valid
+// evidence as a double sign infraction, and persists the evidence.
+func (k Keeper) handleDoubleSignEvidence(ctx context.Context, evidence doubleSignEvidence) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
@@ -68,7 +93,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	if cp.Evidence != nil {
 		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
 			logger.Info(
-				"ignored equivocation; evidence too old",
+				fmt.Sprintf("ignored %s; evidence too old", evidence.Route()),
 				"validator", consAddr,
 				"infraction_height", infractionHeight,
 				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
@@ -86,7 +111,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// ignore if the validator is already tombstoned
 	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
 		logger.Info(
-			"ignored equivocation; validator already tombstoned",
+			fmt.Sprintf("ignored %s; validator already tombstoned", evidence.Route()),
 			"validator", consAddr,
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
@@ -95,7 +120,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	}
 
 	logger.Info(
-		"confirmed equivocation",
+		fmt.Sprintf("confirmed %s", evidence.Route()),
 		"validator", consAddr,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
@@ -86,7 +86,7 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
-	if !k.router.HasRoute(evidenceRoute) {
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
 		return nil, errors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
 	}
 
@@ -101,7 +101,7 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
-	if !k.router.HasRoute(evidence.Route()) {
+	if k.router == nil || !k.router.HasRoute(evidence.Route()) {
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
 
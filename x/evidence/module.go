@@ -8,6 +8,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
@@ -206,6 +207,9 @@ type ModuleInputs struct {
 	AddressCodec   address.Codec
 
 	BlockInfoService comet.BlockInfoService
+
+	// EvidenceHandlers are the handlers of the evidence types defined by other modules.
+	EvidenceHandlers []types.HandlerRoute
 }
 
 type ModuleOutputs struct {
@@ -217,6 +221,19 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec, in.BlockInfoService)
+	if len(in.EvidenceHandlers) > 0 {
+		// Default route order is a lexical sort by route.
+		routes := slices.Clone(in.EvidenceHandlers)
+		slices.SortFunc(routes, func(x, y types.HandlerRoute) bool {
+			return x.Route < y.Route
+		})
+
+		router := types.NewRouter()
+		for _, r := range routes {
+			router.AddRoute(r.Route, r.Handler)
+		}
+		k.SetRouter(router)
+	}
 	m := NewAppModule(*k)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
@@ -0,0 +1,127 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RouteInvalidVoteExtension is the Evidence Handler route of InvalidVoteExtension.
+const RouteInvalidVoteExtension = "invalidvoteextension"
+
+// SlashFractionInvalidVoteExtension is the fraction of the stake of a validator
+// slashed for signing an invalid vote extension.
+var SlashFractionInvalidVoteExtension = math.LegacyNewDecWithPrec(1, 2)
+
+var _ exported.ValidatorEvidence = &InvalidVoteExtension{}
+
+// RegisterInterfaces registers InvalidVoteExtension as an implementation of
+// the Evidence interface, so that it can be submitted with MsgSubmitEvidence.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*exported.Evidence)(nil), &InvalidVoteExtension{})
+}
+
+// Route returns the Evidence Handler route for an InvalidVoteExtension type.
+func (e *InvalidVoteExtension) Route() string { return RouteInvalidVoteExtension }
+
+// Hash returns the hash of an InvalidVoteExtension object.
+func (e *InvalidVoteExtension) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on an InvalidVoteExtension object.
+func (e *InvalidVoteExtension) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid vote extension height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid vote extension validator power: %d", e.Power)
+	}
+	if _, err := sdk.ConsAddressFromBech32(e.ConsensusAddress); err != nil {
+		return fmt.Errorf("invalid vote extension validator consensus address: %w", err)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator which
+// signed the invalid vote extension.
+func (e InvalidVoteExtension) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height of the invalid vote extension.
+func (e InvalidVoteExtension) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time of the block of the invalid vote extension.
+func (e InvalidVoteExtension) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at the height of the invalid
+// vote extension.
+func (e InvalidVoteExtension) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower is a no-op for the InvalidVoteExtension type.
+func (e InvalidVoteExtension) GetTotalPower() int64 { return 0 }
+
+// NewInvalidVoteExtensionHandler returns the Evidence Handler of
+// InvalidVoteExtension, which slashes the validator by
+// SlashFractionInvalidVoteExtension and jails it through x/slashing.
+func NewInvalidVoteExtensionHandler(stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper) types.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*InvalidVoteExtension)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		consAddr := evidence.GetConsensusAddress()
+		validator, err := stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+		if validator == nil || validator.IsUnbonded() {
+			return fmt.Errorf("validator %s is not bonded", consAddr)
+		}
+		if slashingKeeper.IsTombstoned(ctx, consAddr) {
+			return fmt.Errorf("validator %s is tombstoned", consAddr)
+		}
+
+		distributionHeight := evidence.GetHeight() - sdk.ValidatorUpdateDelay
+		if err := slashingKeeper.Slash(ctx, consAddr, SlashFractionInvalidVoteExtension, evidence.GetValidatorPower(), distributionHeight); err != nil {
+			return err
+		}
+
+		if validator.IsJailed() {
+			return nil
+		}
+		return slashingKeeper.Jail(ctx, consAddr)
+	}
+}
+
+// ProvideInvalidVoteExtensionHandler provides the Evidence Handler of
+// InvalidVoteExtension to the x/evidence module when using app wiring.
+func ProvideInvalidVoteExtensionHandler(stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper) types.HandlerRoute {
+	return types.HandlerRoute{
+		Route:   RouteInvalidVoteExtension,
+		Handler: NewInvalidVoteExtensionHandler(stakingKeeper, slashingKeeper),
+	}
+}
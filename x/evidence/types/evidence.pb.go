@@ -75,8 +75,60 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// validator taking part in a light client attack, as reported by CometBFT.
+//
+// Since: cosmos-sdk 0.50
+type LightClientAttack struct {
+	// height is the height of the conflicting block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the conflicting block.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// power is the attacking validator power.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// consensus_address is the attacking validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// total_power is the total voting power of the validator set at the height
+	// of the conflicting block.
+	TotalPower int64 `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()         { *m = LightClientAttack{} }
+func (m *LightClientAttack) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttack) ProtoMessage()    {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
 }
 
 func init() {
@@ -84,29 +136,33 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x52, 0x4d, 0x8b, 0xda, 0x40,
+	0x00, 0xcd, 0xf8, 0x05, 0x1d, 0x5b, 0xa8, 0x41, 0xda, 0x54, 0xda, 0x44, 0xa4, 0x14, 0x2b, 0x98,
+	0xc1, 0xf6, 0x66, 0xe9, 0x41, 0x8b, 0xb7, 0x1e, 0x8a, 0xed, 0xa9, 0x17, 0x89, 0xc9, 0x34, 0x0e,
+	0x9a, 0x4c, 0x9a, 0x19, 0x6d, 0xfb, 0x0f, 0xca, 0x9e, 0x84, 0xfd, 0x03, 0x1e, 0x3d, 0x7a, 0xd8,
+	0x1f, 0xe1, 0x51, 0xf6, 0xb4, 0xa7, 0xdd, 0x25, 0x1e, 0xf4, 0x67, 0x2c, 0x99, 0x19, 0x5d, 0x61,
+	0x7f, 0xc1, 0x5e, 0xc2, 0xbc, 0x97, 0xf7, 0xe6, 0xe5, 0x3d, 0x02, 0xdf, 0xb9, 0x94, 0x05, 0x94,
+	0x21, 0x3c, 0x23, 0x1e, 0x0e, 0x5d, 0x8c, 0x66, 0xad, 0x21, 0xe6, 0x4e, 0xeb, 0x48, 0xd8, 0x51,
+	0x4c, 0x39, 0xd5, 0x5f, 0x4a, 0x9d, 0x7d, 0xa4, 0x95, 0xae, 0x52, 0x72, 0x02, 0x12, 0x52, 0x24,
+	0x9e, 0x52, 0x5b, 0x29, 0xfb, 0xd4, 0xa7, 0xe2, 0x88, 0xd2, 0x93, 0x62, 0x2d, 0x9f, 0x52, 0x7f,
+	0x82, 0x91, 0x40, 0xc3, 0xe9, 0x2f, 0xc4, 0x49, 0x80, 0x19, 0x77, 0x82, 0x48, 0x09, 0x5e, 0xc9,
+	0x88, 0x81, 0x74, 0xaa, 0x3c, 0x01, 0x6a, 0x7b, 0x00, 0x9f, 0xf6, 0x7e, 0x4f, 0xc9, 0x8c, 0xba,
+	0x0e, 0x27, 0x34, 0xd4, 0x5f, 0xc0, 0xc2, 0x08, 0x13, 0x7f, 0xc4, 0x0d, 0x50, 0x05, 0xf5, 0x6c,
+	0x5f, 0x21, 0xfd, 0x33, 0xcc, 0xa5, 0xd7, 0x1a, 0x99, 0x2a, 0xa8, 0x17, 0x3f, 0x54, 0x6c, 0x99,
+	0x69, 0x1f, 0x32, 0xed, 0x1f, 0x87, 0xcc, 0xee, 0xb3, 0xf5, 0xb5, 0xa5, 0xcd, 0x6f, 0x2c, 0xb0,
+	0xdc, 0xad, 0x1a, 0xa0, 0x2f, 0x6c, 0x7a, 0x19, 0xe6, 0x23, 0xfa, 0x07, 0xc7, 0x46, 0x56, 0xdc,
+	0x2a, 0x81, 0xde, 0x83, 0x25, 0x97, 0x86, 0x0c, 0x87, 0x6c, 0xca, 0x06, 0x8e, 0xe7, 0xc5, 0x98,
+	0x31, 0x23, 0x57, 0x05, 0xf5, 0x27, 0x5d, 0xe3, 0xf2, 0xa2, 0x59, 0x56, 0x9f, 0xda, 0x91, 0x6f,
+	0xbe, 0xf3, 0x98, 0x84, 0x7e, 0xff, 0xf9, 0xd1, 0xa2, 0xf8, 0xf6, 0xdb, 0xff, 0x0b, 0x4b, 0xdb,
+	0x2f, 0x2c, 0xed, 0x6c, 0xb7, 0x6a, 0xa8, 0x3d, 0x9b, 0xcc, 0x1b, 0xa3, 0xd3, 0x66, 0xb5, 0xf3,
+	0x0c, 0x2c, 0x7d, 0x4d, 0xbb, 0x7c, 0x99, 0x10, 0x1c, 0xf2, 0x0e, 0xe7, 0x8e, 0x3b, 0x7e, 0x8c,
+	0x7d, 0x75, 0x0b, 0x16, 0x39, 0xe5, 0xce, 0x64, 0x20, 0x23, 0xf2, 0x22, 0x02, 0x0a, 0xea, 0x5b,
+	0xca, 0xb4, 0xdf, 0x9f, 0x0e, 0xf2, 0xfa, 0x64, 0x90, 0x07, 0xfd, 0xbb, 0x9f, 0x96, 0x89, 0x09,
+	0xd6, 0x89, 0x09, 0x36, 0x89, 0x09, 0x6e, 0x13, 0x13, 0xcc, 0xb7, 0xa6, 0xb6, 0xd9, 0x9a, 0xda,
+	0xd5, 0xd6, 0xd4, 0x7e, 0xbe, 0x91, 0x5e, 0xe6, 0x8d, 0x6d, 0x42, 0xd1, 0xdf, 0xfb, 0x9f, 0x99,
+	0xff, 0x8b, 0x30, 0x1b, 0x16, 0xc4, 0x1c, 0x1f, 0xef, 0x06, 0x00, 0x11, 0xa9, 0x0e, 0xfd, 0xec,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -157,6 +213,59 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +298,30 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +481,178 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,6 +71,67 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestLightClientAttack_Valid(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	addr := sdk.ConsAddress("foo_________________")
+
+	e := types.LightClientAttack{
+		Height:           100,
+		Time:             n,
+		Power:            1000000,
+		ConsensusAddress: addr.String(),
+		TotalPower:       3000000,
+	}
+
+	require.Equal(t, e.TotalPower, e.GetTotalPower())
+	require.Equal(t, e.Power, e.GetValidatorPower())
+	require.Equal(t, e.Time, e.GetTime())
+	require.Equal(t, e.ConsensusAddress, e.GetConsensusAddress().String())
+	require.Equal(t, e.Height, e.GetHeight())
+	require.Equal(t, types.RouteLightClientAttack, e.Route())
+	require.NotEqual(t, (&types.Equivocation{Height: 100, Time: n, Power: 1000000, ConsensusAddress: addr.String()}).Hash(), e.Hash())
+	require.NoError(t, e.ValidateBasic())
+}
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, addr.String(), 3000000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, addr.String(), 3000000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, addr.String(), 3000000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr.String(), 3000000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, addr.String(), 999999}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, "", 3000000}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestLightClientAttackFromABCIEvidence(t *testing.T) {
+	n := time.Now().UTC()
+	tmEvidence := NewCometMisbehavior(10, 300, n, comet.LightClientAttack,
+		validator{address: []byte("foo_________________"), power: 100})
+
+	evidence := types.LightClientAttackFromABCIEvidence(tmEvidence)
+	require.Equal(t, int64(10), evidence.GetHeight())
+	require.Equal(t, n, evidence.GetTime())
+	require.Equal(t, int64(100), evidence.GetValidatorPower())
+	require.Equal(t, int64(300), evidence.GetTotalPower())
+	require.Equal(t, tmEvidence.Validator().Address(), evidence.GetConsensusAddress().Bytes())
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := NewCometMisbehavior(1, 100, time.Now(), comet.DuplicateVote,
@@ -26,12 +26,23 @@ type (
 		Sealed() bool
 	}
 
+	// HandlerRoute defines the Handler of the Evidence of the given route. Modules
+	// defining their own Evidence provide a HandlerRoute to register its Handler
+	// with the x/evidence module's Router when using app wiring.
+	HandlerRoute struct {
+		Route   string
+		Handler Handler
+	}
+
 	router struct {
 		routes map[string]Handler
 		sealed bool
 	}
 )
 
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
@@ -10,7 +10,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*BasicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*BasicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*BasicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -40,6 +43,16 @@ func (a *BasicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.Msg)
 	return false, nil
 }
 
+// Refund implements RefundableFeeAllowanceI, restoring the refund to the spend
+// limit, if any.
+func (a *BasicAllowance) Refund(_ context.Context, refund sdk.Coins) error {
+	if a.SpendLimit != nil {
+		a.SpendLimit = a.SpendLimit.Add(refund...)
+	}
+
+	return nil
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a BasicAllowance) ValidateBasic() error {
 	if a.SpendLimit != nil {
@@ -29,3 +29,12 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// RefundableFeeAllowanceI is implemented by the fee allowances which can be
+// given back part of the fees they accepted, e.g. when the unused gas of a tx
+// is refunded.
+type RefundableFeeAllowanceI interface {
+	// Refund restores the given amount, previously accepted by Accept, to the
+	// allowance.
+	Refund(ctx context.Context, refund sdk.Coins) error
+}
@@ -22,6 +22,7 @@ const (
 var (
 	_ FeeAllowanceI                 = (*AllowedMsgAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*AllowedMsgAllowance)(nil)
+	_ RefundableFeeAllowanceI       = (*AllowedMsgAllowance)(nil)
 )
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -88,6 +89,25 @@ func (a *AllowedMsgAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []
 	return remove, err
 }
 
+// Refund implements RefundableFeeAllowanceI, restoring the refund to the
+// underlying allowance if it is refundable.
+func (a *AllowedMsgAllowance) Refund(ctx context.Context, refund sdk.Coins) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := allowance.(RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	if err := refundable.Refund(ctx, refund); err != nil {
+		return err
+	}
+
+	return a.SetAllowance(allowance)
+}
+
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
 	msgsMap := make(map[string]bool, len(a.AllowedMessages))
 	for _, msg := range a.AllowedMessages {
@@ -248,6 +248,35 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// RefundGrantedFees restores the refund, part of the fees previously used with
+// UseGrantedFees, to the allowance granted by the granter to the grantee. It is
+// a no-op if the allowance was removed in the meantime, e.g. because it was
+// used up, or if it is not refundable.
+func (k Keeper) RefundGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := grant.(feegrant.RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	if err := refundable.Refund(ctx, refund); err != nil {
+		return err
+	}
+
+	return k.UpdateAllowance(ctx, granter, grantee, grant)
+}
+
 func emitUseGrantEvent(ctx context.Context, granter, grantee string) {
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
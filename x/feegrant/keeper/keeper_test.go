@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type KeeperTestSuite struct {
@@ -294,6 +296,64 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "not found")
 }
 
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	blockTime := suite.ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	// the refund is restored to the spend limit
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], basic)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], smallAtom, []sdk.Msg{})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RefundGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], refund)
+	suite.Require().NoError(err)
+
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Equal(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+		Expiration: &oneYear,
+	}, loaded)
+
+	// the refund is restored to the period without exceeding its spend limit
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: suite.atom},
+		Period:           time.Hour,
+		PeriodSpendLimit: smallAtom,
+		PeriodCanSpend:   smallAtom,
+		PeriodReset:      blockTime.Add(time.Hour),
+	}
+	allowance, err := feegrant.NewAllowedMsgAllowance(periodic, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], allowance)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], refund, []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RefundGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], smallAtom)
+	suite.Require().NoError(err)
+
+	loaded, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	inner, err := loaded.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Equal(smallAtom, inner.(*feegrant.PeriodicAllowance).PeriodCanSpend)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 615)), inner.(*feegrant.PeriodicAllowance).Basic.SpendLimit)
+
+	// the refund of a removed allowance is a no-op
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 495)), []sdk.Msg{})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RefundGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], refund)
+	suite.Require().NoError(err)
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.BlockTime().AddDate(1, 0, 0)
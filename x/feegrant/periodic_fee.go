@@ -10,7 +10,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*PeriodicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*PeriodicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*PeriodicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -50,6 +53,20 @@ func (a *PeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.M
 	return false, nil
 }
 
+// Refund implements RefundableFeeAllowanceI, restoring the refund to both the
+// current period, without exceeding the period spend limit, and the max amount.
+func (a *PeriodicAllowance) Refund(ctx context.Context, refund sdk.Coins) error {
+	canSpend := a.PeriodCanSpend.Add(refund...)
+	for i, c := range canSpend {
+		if limit := a.PeriodSpendLimit.AmountOf(c.Denom); limit.IsPositive() && c.Amount.GT(limit) {
+			canSpend[i].Amount = limit
+		}
+	}
+	a.PeriodCanSpend = canSpend
+
+	return a.Basic.Refund(ctx, refund)
+}
+
 // tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
 // If we hit the reset period, it will top up the PeriodCanSpend amount to
 // min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
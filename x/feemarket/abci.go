@@ -0,0 +1,50 @@
+package feemarket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker adjusts the base fee of the next block from the gas consumed by
+// the current one.
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var gasUsed uint64
+	if gasMeter := sdkCtx.BlockGasMeter(); gasMeter != nil {
+		gasUsed = gasMeter.GasConsumed()
+	}
+
+	baseFee, err := k.UpdateBaseFee(ctx, gasUsed)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+
+	return nil
+}
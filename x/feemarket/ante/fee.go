@@ -0,0 +1,189 @@
+package ante
+
+import (
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FeeMarketKeeper defines the x/feemarket keeper methods used by the ante
+// handler.
+type FeeMarketKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+	GetBaseFee(ctx context.Context) (sdkmath.LegacyDec, error)
+	BurnFees(ctx context.Context, fees sdk.Coins) error
+}
+
+// NewTxFeeChecker returns a TxFeeChecker, to be given to the x/auth
+// DeductFeeDecorator, which requires every transaction to pay at least the
+// current base fee for its gas limit, in both CheckTx and DeliverTx. Unlike
+// validators' minimum-gas-prices, the base fee is part of the consensus state
+// so all nodes agree on it.
+//
+// Fees paid in the fee denoms accepted by the x/auth params are converted to
+// the fee denom of the fee market, when it is the x/auth native fee denom,
+// before being checked against the base fee. The tx priority is the tip per
+// unit of gas, i.e. the gas price paid on top of the base fee.
+//
+// When the fee market is disabled, and for genesis transactions, it falls back
+// to the given TxFeeChecker, or to authante.NewFeeDenomRatesTxFeeChecker if nil.
+func NewTxFeeChecker(k FeeMarketKeeper, ak authante.AccountKeeper, fallback authante.TxFeeChecker) authante.TxFeeChecker {
+	if fallback == nil {
+		fallback = authante.NewFeeDenomRatesTxFeeChecker(ak)
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		if ctx.BlockHeight() == 0 {
+			return fallback(ctx, tx)
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if !params.Enabled {
+			return fallback(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		baseFee, err := k.GetBaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// fee = ceil(baseFee * gasLimit)
+		glDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+		requiredFee := sdk.NewCoin(params.FeeDenom, baseFee.Mul(glDec).Ceil().RoundInt())
+
+		_, paid := feeDenomFees(ctx, ak, params.FeeDenom, feeCoins)
+		if paid.LT(requiredFee.Amount) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFee)
+		}
+
+		return feeCoins, getTipPriority(paid, gas, baseFee), nil
+	}
+}
+
+// getTipPriority returns the tip paid per unit of gas on top of the base fee,
+// truncated to an integer.
+func getTipPriority(paid sdkmath.Int, gas uint64, baseFee sdkmath.LegacyDec) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	gasPrice := sdkmath.LegacyNewDecFromInt(paid).QuoInt(sdkmath.NewIntFromUint64(gas))
+	tip := gasPrice.Sub(baseFee).TruncateInt()
+	if !tip.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return tip.Int64()
+}
+
+// feeDenomFees returns the fees paid in the given fee denom, or in a fee denom
+// the x/auth params convert to it, along with their total value in the fee
+// denom.
+func feeDenomFees(ctx sdk.Context, ak authante.AccountKeeper, feeDenom string, fees sdk.Coins) (sdk.Coins, sdkmath.Int) {
+	// the params are read without consuming gas, like the x/auth fee checker
+	// does, so that the gas consumption does not depend on the execution mode.
+	authParams := ak.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if authParams.NativeFeeDenom != feeDenom {
+		authParams = authtypes.Params{}
+	}
+
+	accepted := sdk.NewCoins()
+	for _, c := range fees {
+		if _, ok := authParams.FeeDenomRate(c.Denom); ok || c.Denom == feeDenom {
+			accepted = accepted.Add(c)
+		}
+	}
+
+	return accepted, authParams.ConvertFees(accepted).AmountOf(feeDenom)
+}
+
+// BurnBaseFeeDecorator burns the BurnRatio portion of the base fee paid by a
+// transaction out of the fee collector. The remainder, as well as the tip, is
+// left to be distributed. It must be placed after the DeductFeeDecorator.
+//
+// Fees paid in the fee denoms accepted by the x/auth params are burned in
+// proportion of their value in the fee denom of the fee market. The burned fees
+// are recorded in the context, see authante.WithBurnedFee, so that the x/auth
+// GasRefundDecorator does not refund them.
+type BurnBaseFeeDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+	accountKeeper   authante.AccountKeeper
+}
+
+func NewBurnBaseFeeDecorator(k FeeMarketKeeper, ak authante.AccountKeeper) BurnBaseFeeDecorator {
+	return BurnBaseFeeDecorator{
+		feeMarketKeeper: k,
+		accountKeeper:   ak,
+	}
+}
+
+func (bfd BurnBaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params, err := bfd.feeMarketKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !params.Enabled || params.BurnRatio.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFee, err := bfd.feeMarketKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	glDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+	burn := baseFee.Mul(glDec).Mul(params.BurnRatio).TruncateInt()
+
+	// the fee checker is skipped when simulating, so never burn more than what
+	// was actually paid
+	fees, paid := feeDenomFees(ctx, bfd.accountKeeper, params.FeeDenom, feeTx.GetFee())
+	if burn.IsPositive() && paid.IsPositive() {
+		burned := fees
+		if burn.LT(paid) {
+			burned = sdk.NewCoins()
+			for _, c := range fees {
+				burned = burned.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(burn).Quo(paid)))
+			}
+		}
+
+		if err := bfd.feeMarketKeeper.BurnFees(ctx, burned); err != nil {
+			return ctx, err
+		}
+
+		// the burned fees cannot be refunded for the unused gas
+		ctx = authante.WithBurnedFee(ctx, burned)
+	}
+
+	return next(ctx, tx, simulate)
+}
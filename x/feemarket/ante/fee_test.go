@@ -0,0 +1,344 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket/ante"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type mockFeeMarketKeeper struct {
+	params  types.Params
+	baseFee sdkmath.LegacyDec
+	burned  sdk.Coins
+}
+
+func (m *mockFeeMarketKeeper) GetParams(context.Context) (types.Params, error) {
+	return m.params, nil
+}
+
+func (m *mockFeeMarketKeeper) GetBaseFee(context.Context) (sdkmath.LegacyDec, error) {
+	return m.baseFee, nil
+}
+
+func (m *mockFeeMarketKeeper) BurnFees(_ context.Context, fees sdk.Coins) error {
+	m.burned = m.burned.Add(fees...)
+	return nil
+}
+
+func newMockFeeMarketKeeper(enabled bool) *mockFeeMarketKeeper {
+	params := types.DefaultParams()
+	params.Enabled = enabled
+	params.BurnRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	return &mockFeeMarketKeeper{params: params, baseFee: sdkmath.LegacyNewDecWithPrec(25, 1)}
+}
+
+// mockAccountKeeper accepts fees in photon at 2 stake per photon.
+type mockAccountKeeper struct {
+	authante.AccountKeeper
+}
+
+func (mockAccountKeeper) GetParams(context.Context) authtypes.Params {
+	params := authtypes.DefaultParams()
+	params.NativeFeeDenom = sdk.DefaultBondDenom
+	params.FeeDenomRates = []authtypes.FeeDenomRate{{Denom: "photon", Rate: sdkmath.LegacyNewDec(2)}}
+	return params
+}
+
+func newTx(t *testing.T, gas uint64, fee sdk.Coins) sdk.Tx {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetGasLimit(gas)
+	txBuilder.SetFeeAmount(fee)
+	return txBuilder.GetTx()
+}
+
+func newContext(t *testing.T) sdk.Context {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	return testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(1)
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	ctx := newContext(t)
+
+	testCases := []struct {
+		name        string
+		enabled     bool
+		ctx         sdk.Context
+		fee         sdk.Coins
+		expPriority int64
+		expErr      error
+	}{
+		{
+			name:        "fee equal to the base fee",
+			enabled:     true,
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)),
+			expPriority: 0,
+		},
+		{
+			name:        "tip on top of the base fee",
+			enabled:     true,
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+			expPriority: 7,
+		},
+		{
+			name:    "fee below the base fee in deliver tx",
+			enabled: true,
+			ctx:     ctx.WithIsCheckTx(false),
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 249)),
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "fee in another denom",
+			enabled: true,
+			ctx:     ctx,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee in a whitelisted denom",
+			enabled:     true,
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("photon", 500)),
+			expPriority: 7,
+		},
+		{
+			name:        "fee partly in a whitelisted denom",
+			enabled:     true,
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("photon", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			expPriority: 0,
+		},
+		{
+			name:    "fee in a whitelisted denom below the base fee",
+			enabled: true,
+			ctx:     ctx.WithIsCheckTx(false),
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("photon", 124)),
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "genesis transactions fall back to min gas prices",
+			enabled:     true,
+			ctx:         ctx.WithBlockHeight(0),
+			fee:         sdk.NewCoins(),
+			expPriority: 0,
+		},
+		{
+			name:        "disabled fee market falls back to min gas prices",
+			enabled:     false,
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+			expPriority: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			checker := ante.NewTxFeeChecker(newMockFeeMarketKeeper(tc.enabled), mockAccountKeeper{}, nil)
+
+			fee, priority, err := checker(tc.ctx, newTx(t, 100, tc.fee))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
+
+func TestBurnBaseFeeDecorator(t *testing.T) {
+	ctx := newContext(t)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name      string
+		enabled   bool
+		ctx       sdk.Context
+		fee       sdk.Coins
+		simulate  bool
+		expBurned sdk.Coins
+	}{
+		{
+			name:      "burns the burn ratio of the base fee",
+			enabled:   true,
+			ctx:       ctx,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+			expBurned: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 125)),
+		},
+		{
+			name:      "never burns more than the fee paid when simulating",
+			enabled:   true,
+			ctx:       ctx,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			simulate:  true,
+			expBurned: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		{
+			name:      "burns the fee paid in a whitelisted denom",
+			enabled:   true,
+			ctx:       ctx,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("photon", 500)),
+			expBurned: sdk.NewCoins(sdk.NewInt64Coin("photon", 62)),
+		},
+		{
+			name:      "burns the fee denoms in proportion of their value",
+			enabled:   true,
+			ctx:       ctx,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("photon", 250), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), sdk.NewInt64Coin("atom", 1000)),
+			expBurned: sdk.NewCoins(sdk.NewInt64Coin("photon", 31), sdk.NewInt64Coin(sdk.DefaultBondDenom, 62)),
+		},
+		{
+			name:    "disabled fee market",
+			enabled: false,
+			ctx:     ctx,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+		{
+			name:    "genesis transactions",
+			enabled: true,
+			ctx:     ctx.WithBlockHeight(0),
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			k := newMockFeeMarketKeeper(tc.enabled)
+			decorator := ante.NewBurnBaseFeeDecorator(k, mockAccountKeeper{})
+
+			_, err := decorator.AnteHandle(tc.ctx, newTx(t, 100, tc.fee), tc.simulate, next)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBurned, k.burned)
+		})
+	}
+}
+
+// refundAccountKeeper refunds half of the fees paid for the unused gas.
+type refundAccountKeeper struct {
+	mockAccountKeeper
+}
+
+func (refundAccountKeeper) GetParams(ctx context.Context) authtypes.Params {
+	params := mockAccountKeeper{}.GetParams(ctx)
+	params.GasRefundRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	return params
+}
+
+func (refundAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (refundAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+type mockBankKeeper struct {
+	authtypes.BankKeeper
+	balances map[string]sdk.Coins
+}
+
+func (bk mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, isNeg := bk.balances[from.String()].SafeSub(amt...)
+	if isNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return bk.send(from, authtypes.NewModuleAddress(module), amt)
+}
+
+func (bk mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(module), to, amt)
+}
+
+func (bk mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+// burningFeeMarketKeeper burns the fees out of the fee collector balance.
+type burningFeeMarketKeeper struct {
+	*mockFeeMarketKeeper
+	bankKeeper mockBankKeeper
+}
+
+func (k burningFeeMarketKeeper) BurnFees(ctx context.Context, fees sdk.Coins) error {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	balance, isNeg := k.bankKeeper.balances[feeCollector.String()].SafeSub(fees...)
+	if isNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	k.bankKeeper.balances[feeCollector.String()] = balance
+	return k.mockFeeMarketKeeper.BurnFees(ctx, fees)
+}
+
+func TestBurnBaseFeeAndGasRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	tx := newTx(t, 100000, fee)
+	payer := sdk.AccAddress(tx.(sdk.FeeTx).FeePayer())
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	ak := refundAccountKeeper{}
+	bk := mockBankKeeper{balances: map[string]sdk.Coins{payer.String(): fee}}
+	k := burningFeeMarketKeeper{mockFeeMarketKeeper: newMockFeeMarketKeeper(true), bankKeeper: bk}
+
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewDeductFeeDecorator(ak, bk, nil, ante.NewTxFeeChecker(k, ak, nil)),
+		ante.NewBurnBaseFeeDecorator(k, ak),
+	)
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		AccountKeeper: ak,
+		BankKeeper:    bk,
+	})
+	require.NoError(t, err)
+
+	ctx := newContext(t).WithGasMeter(storetypes.NewGasMeter(100000))
+	ctx, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// half of the base fee of 2.5 per unit of gas is burned
+	burned := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 125000))
+	require.Equal(t, burned, k.burned)
+	deducted, ok := authante.DeductedFeeFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, burned, deducted.Burned)
+
+	ctx.GasMeter().ConsumeGas(40000-ctx.GasMeter().GasConsumed(), "msgs")
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// half of the fees left after the burn for the 60% of unused gas is refunded
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 262500))
+	require.Equal(t, refund, bk.balances[payer.String()])
+	require.Equal(t, fee.Sub(burned...).Sub(refund...), bk.balances[feeCollector.String()])
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new feemarket genesis
+func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types.GenesisState) {
+	if err := keeper.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.BaseFee.Set(ctx, data.BaseFee); err != nil {
+		panic(err)
+	}
+
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	baseFee, err := keeper.BaseFee.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, baseFee)
+}
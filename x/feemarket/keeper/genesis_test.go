@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestImportExportGenesis() {
+	params := types.NewParams(true, "feetoken", math.LegacyNewDecWithPrec(1, 2), 1_000_000, math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1))
+	genesisState := types.NewGenesisState(params, math.LegacyNewDecWithPrec(3, 2))
+
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName).Return(sdk.ModuleAccountI(nil))
+	s.feeMarketKeeper.InitGenesis(s.ctx, s.accountKeeper, genesisState)
+
+	baseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.BaseFee, baseFee)
+
+	s.Require().Equal(genesisState, s.feeMarketKeeper.ExportGenesis(s.ctx))
+}
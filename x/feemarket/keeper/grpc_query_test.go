@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestGRPCParams() {
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	kparams, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(kparams, params.Params)
+}
+
+func (s *IntegrationTestSuite) TestGRPCBaseFee() {
+	s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, math.LegacyNewDecWithPrec(25, 2)))
+
+	res, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(25, 2)), res.BaseFee)
+}
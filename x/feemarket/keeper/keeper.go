@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeService     storetypes.KVStoreService
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	BaseFee collections.Item[math.LegacyDec]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:          collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// GetBaseFee returns the current base fee, per unit of gas.
+func (k Keeper) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	return k.BaseFee.Get(ctx)
+}
+
+// BurnFees burns the given fees out of the fee collector, which already
+// received them from the fee payer.
+func (k Keeper) BurnFees(ctx context.Context, fees sdk.Coins) error {
+	if fees.Empty() {
+		// skip as no coins need to be burned
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+}
+
+// UpdateBaseFee computes the base fee of the next block from the gas consumed
+// by the current one and stores it.
+func (k Keeper) UpdateBaseFee(ctx context.Context, gasUsed uint64) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	next := params.NextBaseFee(baseFee, gasUsed)
+	if err := k.BaseFee.Set(ctx, next); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return next, nil
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	feeMarketKeeper keeper.Keeper
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	s.feeMarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		s.accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(types.GovModuleName).String(),
+	)
+
+	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
+		s.feeMarketKeeper.Logger(testCtx.Ctx))
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, params.MinBaseFee))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.feeMarketKeeper)
+}
+
+func (s *IntegrationTestSuite) TestBurnFees() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, fees).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, fees).Return(nil)
+	s.Require().NoError(s.feeMarketKeeper.BurnFees(s.ctx, fees))
+
+	// no bank calls are expected for empty fees
+	s.Require().NoError(s.feeMarketKeeper.BurnFees(s.ctx, sdk.NewCoins()))
+}
+
+func (s *IntegrationTestSuite) TestUpdateBaseFee() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	start := math.LegacyNewDec(100)
+	s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, start))
+
+	// a full block raises the base fee by the max change rate
+	baseFee, err := s.feeMarketKeeper.UpdateBaseFee(s.ctx, 2*params.TargetBlockGas)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("112.5"), baseFee)
+
+	stored, err := s.feeMarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(baseFee, stored)
+
+	// a block on target keeps it
+	baseFee, err = s.feeMarketKeeper.UpdateBaseFee(s.ctx, params.TargetBlockGas)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("112.5"), baseFee)
+
+	// an empty block lowers it by the max change rate
+	baseFee, err = s.feeMarketKeeper.UpdateBaseFee(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("98.4375"), baseFee)
+}
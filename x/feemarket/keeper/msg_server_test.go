@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *IntegrationTestSuite) TestUpdateParams() {
+	validParams := types.DefaultParams()
+	validParams.Enabled = true
+
+	raisedMinParams := validParams
+	raisedMinParams.MinBaseFee = sdkmath.LegacyNewDec(5)
+
+	invalidParams := validParams
+	invalidParams.MaxChangeRate = sdkmath.LegacyNewDec(2)
+
+	testCases := []struct {
+		name            string
+		request         *types.MsgUpdateParams
+		expectErr       bool
+		expectedBaseFee sdkmath.LegacyDec
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    invalidParams,
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    validParams,
+			},
+			expectErr:       false,
+			expectedBaseFee: validParams.MinBaseFee,
+		},
+		{
+			name: "raising the min base fee raises the base fee",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    raisedMinParams,
+			},
+			expectErr:       false,
+			expectedBaseFee: raisedMinParams.MinBaseFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Params, params)
+
+			baseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedBaseFee, baseFee)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	MinBaseFee     = "min_base_fee"
+	TargetBlockGas = "target_block_gas"
+	MaxChangeRate  = "max_change_rate"
+	BurnRatio      = "burn_ratio"
+)
+
+// GenMinBaseFee randomized MinBaseFee
+func GenMinBaseFee(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(99)+1), 3)
+}
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(30_000_000) + 1)
+}
+
+// GenMaxChangeRate randomized MaxChangeRate
+func GenMaxChangeRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(99)+1), 2)
+}
+
+// GenBurnRatio randomized BurnRatio
+func GenBurnRatio(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The fee
+// market is left disabled as simulated transactions pay random fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minBaseFee math.LegacyDec
+	simState.AppParams.GetOrGenerate(MinBaseFee, &minBaseFee, simState.Rand, func(r *rand.Rand) { minBaseFee = GenMinBaseFee(r) })
+
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(TargetBlockGas, &targetBlockGas, simState.Rand, func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) })
+
+	var maxChangeRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(MaxChangeRate, &maxChangeRate, simState.Rand, func(r *rand.Rand) { maxChangeRate = GenMaxChangeRate(r) })
+
+	var burnRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(BurnRatio, &burnRatio, simState.Rand, func(r *rand.Rand) { burnRatio = GenBurnRatio(r) })
+
+	params := types.NewParams(false, simState.BondDenom, minBaseFee, targetBlockGas, maxChangeRate, burnRatio)
+
+	feemarketGenesis := types.NewGenesisState(params, minBaseFee)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
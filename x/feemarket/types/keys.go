@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key to use for the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// BaseFeeKey is the key to use for the current base fee.
+	BaseFeeKey = collections.NewPrefix(1)
+)
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	GovModuleName = "gov"
+)
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	stdmath "math"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, targetBlockGas uint64, maxChangeRate, burnRatio math.LegacyDec) Params {
+	return Params{
+		Enabled:        enabled,
+		FeeDenom:       feeDenom,
+		MinBaseFee:     minBaseFee,
+		TargetBlockGas: targetBlockGas,
+		MaxChangeRate:  maxChangeRate,
+		BurnRatio:      burnRatio,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The fee market
+// is disabled by default so that chains opt in through genesis or governance.
+func DefaultParams() Params {
+	return Params{
+		Enabled:        false,
+		FeeDenom:       sdk.DefaultBondDenom,
+		MinBaseFee:     math.LegacyNewDecWithPrec(1, 3),
+		TargetBlockGas: 15_000_000,
+		MaxChangeRate:  math.LegacyNewDecWithPrec(125, 3),
+		BurnRatio:      math.LegacyOneDec(),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+
+	return validateBurnRatio(p.BurnRatio)
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee of the
+// current one and the gas it consumed. Following EIP-1559, the base fee moves
+// by up to MaxChangeRate proportionally to the distance between the gas used
+// and TargetBlockGas, and never drops below MinBaseFee.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	// cap the gas used at twice the target so that the change is bounded by
+	// MaxChangeRate in both directions
+	if maxGas := 2 * p.TargetBlockGas; gasUsed > maxGas {
+		gasUsed = maxGas
+	}
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+	used := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed))
+
+	delta := baseFee.Mul(p.MaxChangeRate).Mul(used.Sub(target)).Quo(target)
+	next := baseFee.Add(delta)
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return next
+}
+
+func validateFeeDenom(v string) error {
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("min base fee cannot be nil: %s", v)
+	}
+	// a zero base fee could never grow again as changes are relative
+	if !v.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+	// the gas used is capped at twice the target block gas
+	if v > stdmath.MaxUint64/2 {
+		return fmt.Errorf("target block gas too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxChangeRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max change rate cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max change rate must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBurnRatio(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("burn ratio cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("burn ratio cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("burn ratio too large: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(p *types.Params)
+		expErr   string
+	}{
+		{"default", func(*types.Params) {}, ""},
+		{"blank denom", func(p *types.Params) { p.FeeDenom = "" }, "fee denom cannot be blank"},
+		{"zero min base fee", func(p *types.Params) { p.MinBaseFee = math.LegacyZeroDec() }, "min base fee must be positive"},
+		{"zero target block gas", func(p *types.Params) { p.TargetBlockGas = 0 }, "target block gas must be positive"},
+		{"max target block gas", func(p *types.Params) { p.TargetBlockGas = stdmath.MaxUint64 / 2 }, ""},
+		{"target block gas too large", func(p *types.Params) { p.TargetBlockGas = stdmath.MaxUint64/2 + 1 }, "target block gas too large"},
+		{"zero max change rate", func(p *types.Params) { p.MaxChangeRate = math.LegacyZeroDec() }, "max change rate must be positive"},
+		{"max change rate too large", func(p *types.Params) { p.MaxChangeRate = math.LegacyNewDec(2) }, "max change rate too large"},
+		{"negative burn ratio", func(p *types.Params) { p.BurnRatio = math.LegacyNewDec(-1) }, "burn ratio cannot be negative"},
+		{"burn ratio too large", func(p *types.Params) { p.BurnRatio = math.LegacyNewDec(2) }, "burn ratio too large"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.TargetBlockGas = 1000
+	baseFee := math.LegacyNewDec(8)
+
+	testCases := []struct {
+		name    string
+		gasUsed uint64
+		exp     math.LegacyDec
+	}{
+		{"on target", 1000, math.LegacyNewDec(8)},
+		{"full block", 2000, math.LegacyNewDec(9)},
+		{"above max block is capped", 5000, math.LegacyNewDec(9)},
+		{"half full", 1500, math.LegacyMustNewDecFromStr("8.5")},
+		{"empty block", 0, math.LegacyNewDec(7)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, params.NextBaseFee(baseFee, tc.gasUsed))
+		})
+	}
+
+	// the base fee never drops below the minimum
+	require.Equal(t, params.MinBaseFee, params.NextBaseFee(params.MinBaseFee, 0))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	gs := types.DefaultGenesisState()
+	gs.BaseFee = gs.Params.MinBaseFee.QuoInt64(2)
+	require.ErrorContains(t, types.ValidateGenesis(*gs), "cannot be lower than the min base fee")
+}
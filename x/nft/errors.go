@@ -12,4 +12,7 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrInvalidPolicy  = errors.Register(ModuleName, 9, "invalid class policy")
+	ErrNoClassPolicy  = errors.Register(ModuleName, 10, "nft class has no policy")
+	ErrMaxSupply      = errors.Register(ModuleName, 11, "nft class max supply reached")
 )
@@ -2,14 +2,25 @@ package nft
 
 import (
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState, ac address.Codec) error {
+	classes := make(map[string]struct{}, len(data.Classes))
 	for _, class := range data.Classes {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		classes[class.Id] = struct{}{}
+	}
+	for _, policy := range data.ClassPolicies {
+		if err := policy.ValidateBasic(ac); err != nil {
+			return err
+		}
+		if _, ok := classes[policy.ClassId]; !ok {
+			return errors.Wrap(ErrClassNotExists, policy.ClassId)
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -28,6 +28,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, policy := range data.ClassPolicies {
+		if err := k.SetClassPolicy(ctx, *policy); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -60,7 +65,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:       classes,
+		Entries:       entries,
+		ClassPolicies: k.GetClassPolicies(ctx),
 	}
 }
@@ -195,3 +195,21 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassPolicy return the minting policy of a class created through Msg/CreateClass
+func (k Keeper) ClassPolicy(goCtx context.Context, r *nft.QueryClassPolicyRequest) (*nft.QueryClassPolicyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, has := k.GetClassPolicy(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrNoClassPolicy.Wrapf("not found class policy: %s", r.ClassId)
+	}
+	return &nft.QueryClassPolicyResponse{Policy: &policy}, nil
+}
@@ -54,3 +54,175 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	}
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	policy := nft.ClassPolicy{
+		ClassId:    msg.Id,
+		Creator:    msg.Creator,
+		MintPolicy: msg.MintPolicy,
+		Allowlist:  msg.Allowlist,
+		MaxSupply:  msg.MaxSupply,
+	}
+	if err := policy.ValidateBasic(k.ac); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class := nft.Class{
+		Id:          msg.Id,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId:    msg.Id,
+		Creator:    msg.Creator,
+		MintPolicy: msg.MintPolicy.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// MintNFT implements MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	minter, err := k.ac.StringToBytes(msg.Minter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiver := minter
+	if len(msg.Receiver) > 0 {
+		receiver, err = k.ac.StringToBytes(msg.Receiver)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, has := k.GetClassPolicy(ctx, msg.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrNoClassPolicy, msg.ClassId)
+	}
+
+	minterStr, err := k.ac.BytesToString(minter)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanMint(minterStr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nft of class %s", msg.Minter, msg.ClassId)
+	}
+
+	if policy.MaxSupply > 0 && k.GetTotalSupply(ctx, msg.ClassId) >= policy.MaxSupply {
+		return nil, errorsmod.Wrapf(nft.ErrMaxSupply, "class %s is capped at %d nfts", msg.ClassId, policy.MaxSupply)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implements BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	creator, err := k.ac.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", msg.Creator)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, has := k.GetClassPolicy(ctx, msg.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrNoClassPolicy, msg.ClassId)
+	}
+
+	classCreator, err := k.ac.StringToBytes(policy.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(classCreator, creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of class %s", msg.Creator, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
@@ -130,3 +130,246 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestCreateClass() {
+	testCases := []struct {
+		name   string
+		req    *nft.MsgCreateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty class id",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				MintPolicy: nft.MintPolicy_MINT_POLICY_CREATOR_ONLY,
+			},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "invalid creator",
+			req: &nft.MsgCreateClass{
+				Creator:    "invalid",
+				Id:         testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_CREATOR_ONLY,
+			},
+			expErr: true,
+			errMsg: "invalid creator address",
+		},
+		{
+			name: "unspecified mint policy",
+			req: &nft.MsgCreateClass{
+				Creator: s.addrs[0].String(),
+				Id:      testClassID,
+			},
+			expErr: true,
+			errMsg: "unknown mint policy",
+		},
+		{
+			name: "empty allowlist",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				Id:         testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+			},
+			expErr: true,
+			errMsg: "allowlist cannot be empty",
+		},
+		{
+			name: "allowlist with creator-only policy",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				Id:         testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_CREATOR_ONLY,
+				Allowlist:  []string{s.addrs[1].String()},
+			},
+			expErr: true,
+			errMsg: "allowlist is only supported",
+		},
+		{
+			name: "duplicate allowlist address",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				Id:         testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+				Allowlist:  []string{s.addrs[1].String(), s.addrs[1].String()},
+			},
+			expErr: true,
+			errMsg: "duplicate allowlist address",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				Id:         testClassID,
+				Name:       testClassName,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+				Allowlist:  []string{s.addrs[1].String()},
+				MaxSupply:  1,
+			},
+			expErr: false,
+		},
+		{
+			name: "class already exists",
+			req: &nft.MsgCreateClass{
+				Creator:    s.addrs[0].String(),
+				Id:         testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.CreateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	policy, has := s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[0].String(), policy.Creator)
+	s.Require().Equal(nft.MintPolicy_MINT_POLICY_ALLOWLIST, policy.MintPolicy)
+	s.Require().Equal(uint64(1), policy.MaxSupply)
+
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]*nft.ClassPolicy{&policy}, genesis.ClassPolicies)
+}
+
+func (s *TestSuite) TestMintBurnUpdateNFT() {
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator:    s.addrs[0].String(),
+		Id:         testClassID,
+		MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+		Allowlist:  []string{s.addrs[1].String()},
+		MaxSupply:  1,
+	})
+	s.Require().NoError(err)
+
+	// classes created without Msg/CreateClass have no policy
+	err = s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "no-policy"})
+	s.Require().NoError(err)
+
+	mintCases := []struct {
+		name   string
+		req    *nft.MsgMintNFT
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty nft id",
+			req: &nft.MsgMintNFT{
+				Minter:  s.addrs[0].String(),
+				ClassId: testClassID,
+			},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name: "class without policy",
+			req: &nft.MsgMintNFT{
+				Minter:  s.addrs[0].String(),
+				ClassId: "no-policy",
+				Id:      testID,
+			},
+			expErr: true,
+			errMsg: "nft class has no policy",
+		},
+		{
+			name: "minter not in allowlist",
+			req: &nft.MsgMintNFT{
+				Minter:  s.addrs[2].String(),
+				ClassId: testClassID,
+				Id:      testID,
+			},
+			expErr: true,
+			errMsg: "is not allowed to mint",
+		},
+		{
+			name: "valid transaction by allowlisted minter",
+			req: &nft.MsgMintNFT{
+				Minter:   s.addrs[1].String(),
+				ClassId:  testClassID,
+				Id:       testID,
+				Uri:      testURI,
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: false,
+		},
+		{
+			name: "max supply reached",
+			req: &nft.MsgMintNFT{
+				Minter:  s.addrs[0].String(),
+				ClassId: testClassID,
+				Id:      "kitty2",
+			},
+			expErr: true,
+			errMsg: "nft class max supply reached",
+		},
+	}
+
+	for _, tc := range mintCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.MintNFT(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// only the class creator may update the nft data
+	_, err = s.nftKeeper.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Creator: s.addrs[2].String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the creator of class %s", s.addrs[2].String(), testClassID))
+
+	_, err = s.nftKeeper.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Creator: s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+	})
+	s.Require().NoError(err)
+	token, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal("new uri", token.Uri)
+
+	// only the owner may burn the nft
+	_, err = s.nftKeeper.BurnNFT(s.ctx, &nft.MsgBurnNFT{
+		Owner:   s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the owner of nft %s", s.addrs[0].String(), testID))
+
+	_, err = s.nftKeeper.BurnNFT(s.ctx, &nft.MsgBurnNFT{
+		Owner:   s.addrs[2].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+
+	// burning frees up supply for new mints
+	_, err = s.nftKeeper.MintNFT(s.ctx, &nft.MsgMintNFT{
+		Minter:  s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      "kitty2",
+	})
+	s.Require().NoError(err)
+}
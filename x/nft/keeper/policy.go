@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetClassPolicy defines a method for setting the minting policy of an existing nft class
+func (k Keeper) SetClassPolicy(ctx context.Context, policy nft.ClassPolicy) error {
+	if !k.HasClass(ctx, policy.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, policy.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassPolicy failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classPolicyStoreKey(policy.ClassId), bz)
+}
+
+// GetClassPolicy defines a method for returning the minting policy of the specified class
+func (k Keeper) GetClassPolicy(ctx context.Context, classID string) (nft.ClassPolicy, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var policy nft.ClassPolicy
+
+	bz, err := store.Get(classPolicyStoreKey(classID))
+	if err != nil {
+		return policy, false
+	}
+
+	if len(bz) == 0 {
+		return policy, false
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetClassPolicies defines a method for returning all class policies
+func (k Keeper) GetClassPolicies(ctx context.Context) (policies []*nft.ClassPolicy) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassPolicyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy nft.ClassPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, &policy)
+	}
+	return
+}
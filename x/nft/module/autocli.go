@@ -79,10 +79,67 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "ClassPolicy",
+					Use:       "class-policy [class-id]",
+					Short:     "Query the minting policy of an NFT class",
+					Example:   fmt.Sprintf(`%s query %s class-policy <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: nftv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateClass",
+					Use:       "create-class [creator] [class-id] [mint-policy]",
+					Short:     "Create a new NFT class with a minting policy.",
+					Long:      "Create a new NFT class with a minting policy. The mint policy is one of MINT_POLICY_CREATOR_ONLY, MINT_POLICY_OPEN or MINT_POLICY_ALLOWLIST.",
+					Example:   fmt.Sprintf(`%s tx %s create-class <creator> <class-id> MINT_POLICY_ALLOWLIST --allowlist=<address> --max-supply=100`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "creator"},
+						{ProtoField: "id"},
+						{ProtoField: "mint_policy"},
+					},
+				},
+				{
+					RpcMethod: "MintNFT",
+					Use:       "mint [minter] [class-id] [nft-id]",
+					Short:     "Mint an NFT of a class created with create-class.",
+					Example:   fmt.Sprintf(`%s tx %s mint <minter> <class-id> <nft-id> --uri=<uri> --receiver=<receiver>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "minter"},
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "BurnNFT",
+					Use:       "burn [owner] [class-id] [nft-id]",
+					Short:     "Burn an NFT owned by the sender.",
+					Example:   fmt.Sprintf(`%s tx %s burn <owner> <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateNFT",
+					Use:       "update [creator] [class-id] [nft-id]",
+					Short:     "Update the data of an NFT, only allowed for the class creator.",
+					Example:   fmt.Sprintf(`%s tx %s update <creator> <class-id> <nft-id> --uri=<uri> --uri-hash=<uri-hash>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "creator"},
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+			},
+			EnhanceCustomCommand: true, // We still have the manual send command in nft that we want to keep
 		},
 	}
 }
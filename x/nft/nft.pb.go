@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines who is allowed to mint nfts of a class through Msg/MintNFT.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_UNSPECIFIED defines a no-op policy, it is not accepted by Msg/CreateClass.
+	MintPolicy_MINT_POLICY_UNSPECIFIED MintPolicy = 0
+	// MINT_POLICY_CREATOR_ONLY only allows the class creator to mint.
+	MintPolicy_MINT_POLICY_CREATOR_ONLY MintPolicy = 1
+	// MINT_POLICY_OPEN allows any account to mint.
+	MintPolicy_MINT_POLICY_OPEN MintPolicy = 2
+	// MINT_POLICY_ALLOWLIST allows the class creator and the accounts in the class allowlist to mint.
+	MintPolicy_MINT_POLICY_ALLOWLIST MintPolicy = 3
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_UNSPECIFIED",
+	1: "MINT_POLICY_CREATOR_ONLY",
+	2: "MINT_POLICY_OPEN",
+	3: "MINT_POLICY_ALLOWLIST",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_UNSPECIFIED":  0,
+	"MINT_POLICY_CREATOR_ONLY": 1,
+	"MINT_POLICY_OPEN":         2,
+	"MINT_POLICY_ALLOWLIST":    3,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,35 +242,131 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// ClassPolicy defines the minting rules of a class created through Msg/CreateClass.
+type ClassPolicy struct {
+	// class_id associated with the policy
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// creator is the address of the account which created the class
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// mint_policy defines who is allowed to mint nfts of the class
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// allowlist defines the accounts allowed to mint when mint_policy is MINT_POLICY_ALLOWLIST
+	Allowlist []string `protobuf:"bytes,4,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// max_supply is the maximum number of nfts of the class that may exist at the same time, 0 means unlimited
+	MaxSupply uint64 `protobuf:"varint,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (m *ClassPolicy) Reset()         { *m = ClassPolicy{} }
+func (m *ClassPolicy) String() string { return proto.CompactTextString(m) }
+func (*ClassPolicy) ProtoMessage()    {}
+func (*ClassPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassPolicy.Merge(m, src)
+}
+func (m *ClassPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassPolicy proto.InternalMessageInfo
+
+func (m *ClassPolicy) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MintPolicy_MINT_POLICY_UNSPECIFIED
+}
+
+func (m *ClassPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *ClassPolicy) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassPolicy)(nil), "cosmos.nft.v1beta1.ClassPolicy")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xd1, 0x8e, 0xd2, 0x40,
+	0x14, 0x65, 0x68, 0x17, 0xe4, 0x92, 0x6c, 0x9a, 0x09, 0xea, 0xb0, 0xae, 0x0d, 0xd9, 0x27, 0x62,
+	0xb4, 0xcd, 0x62, 0xe2, 0xab, 0x61, 0x91, 0x8d, 0x4d, 0x58, 0x20, 0x05, 0x63, 0xd6, 0x97, 0x66,
+	0xa0, 0x5d, 0x98, 0xd8, 0x76, 0x48, 0x67, 0x50, 0xf8, 0x02, 0x5f, 0xfd, 0x18, 0x1f, 0xfd, 0x00,
+	0x1f, 0x37, 0x3e, 0xf9, 0x68, 0xe0, 0x17, 0xfc, 0x00, 0xd3, 0x69, 0x97, 0x25, 0x71, 0xb3, 0xbe,
+	0xdd, 0x7b, 0xce, 0xe9, 0xcc, 0x39, 0xbd, 0x73, 0xe1, 0x78, 0xca, 0x45, 0xc4, 0x85, 0x1d, 0x5f,
+	0x49, 0xfb, 0xd3, 0xe9, 0x24, 0x90, 0xf4, 0x34, 0xad, 0xad, 0x45, 0xc2, 0x25, 0xc7, 0x38, 0x63,
+	0xad, 0x14, 0xc9, 0xd9, 0xa3, 0xfa, 0x8c, 0xf3, 0x59, 0x18, 0xd8, 0x4a, 0x31, 0x59, 0x5e, 0xd9,
+	0x34, 0x5e, 0x67, 0xf2, 0xa3, 0x7a, 0x26, 0xf7, 0x54, 0x67, 0xe7, 0xdf, 0xaa, 0xe6, 0xe4, 0x3b,
+	0x82, 0x83, 0x4e, 0x48, 0x85, 0xc0, 0x87, 0x50, 0x64, 0x3e, 0x41, 0x0d, 0xd4, 0xac, 0xb8, 0x45,
+	0xe6, 0x63, 0x0c, 0x7a, 0x4c, 0xa3, 0x80, 0x14, 0x15, 0xa2, 0x6a, 0xfc, 0x08, 0x4a, 0x62, 0x1d,
+	0x4d, 0x78, 0x48, 0x34, 0x85, 0xe6, 0x1d, 0x6e, 0x40, 0xd5, 0x0f, 0xc4, 0x34, 0x61, 0x0b, 0xc9,
+	0x78, 0x4c, 0x74, 0x45, 0xee, 0x43, 0xd8, 0x00, 0x6d, 0x99, 0x30, 0x72, 0xa0, 0x98, 0xb4, 0xc4,
+	0x75, 0x78, 0xb0, 0x4c, 0x98, 0x37, 0xa7, 0x62, 0x4e, 0x4a, 0x0a, 0x2e, 0x2f, 0x13, 0xf6, 0x96,
+	0x8a, 0x39, 0x6e, 0x82, 0xee, 0x53, 0x49, 0x49, 0xb9, 0x81, 0x9a, 0xd5, 0x56, 0xcd, 0xca, 0x92,
+	0x59, 0x37, 0xc9, 0xac, 0x76, 0xbc, 0x76, 0x95, 0xe2, 0xe4, 0x0b, 0x02, 0xad, 0x7f, 0x3e, 0x4e,
+	0x0f, 0x9b, 0xa6, 0x29, 0xbc, 0x5d, 0x84, 0xb2, 0xea, 0x1d, 0x3f, 0xcf, 0x55, 0xdc, 0xe5, 0xca,
+	0x9d, 0x68, 0x77, 0x3b, 0xd1, 0xef, 0x76, 0x02, 0xff, 0x75, 0xf2, 0x07, 0x41, 0x55, 0xfd, 0xc8,
+	0x21, 0x0f, 0xd9, 0x74, 0x7d, 0x9f, 0xa3, 0x16, 0x94, 0xa7, 0x49, 0x40, 0x25, 0x4f, 0x32, 0x5b,
+	0x67, 0xe4, 0xe7, 0xb7, 0x17, 0xb5, 0x7c, 0x2c, 0x6d, 0xdf, 0x4f, 0x02, 0x21, 0x46, 0x32, 0x61,
+	0xf1, 0xcc, 0xbd, 0x11, 0xe2, 0xd7, 0x50, 0x8d, 0x58, 0x2c, 0xbd, 0x85, 0x3a, 0x5d, 0xb9, 0x3f,
+	0x6c, 0x99, 0xd6, 0xbf, 0xef, 0xc0, 0xba, 0x60, 0xb1, 0xcc, 0x3c, 0xb8, 0x10, 0xed, 0x6a, 0xfc,
+	0x0a, 0x2a, 0x34, 0x0c, 0xf9, 0xe7, 0x90, 0x09, 0x49, 0xf4, 0x86, 0x76, 0xef, 0xb5, 0xb7, 0x52,
+	0xfc, 0x14, 0x20, 0xa2, 0x2b, 0x4f, 0x2c, 0x17, 0x8b, 0x70, 0xad, 0xe6, 0xa7, 0xbb, 0x95, 0x88,
+	0xae, 0x46, 0x0a, 0x78, 0xb6, 0x02, 0xb8, 0xbd, 0x10, 0x3f, 0x81, 0xc7, 0x17, 0x4e, 0x7f, 0xec,
+	0x0d, 0x07, 0x3d, 0xa7, 0x73, 0xe9, 0xbd, 0xeb, 0x8f, 0x86, 0xdd, 0x8e, 0x73, 0xee, 0x74, 0xdf,
+	0x18, 0x05, 0x7c, 0x0c, 0x64, 0x9f, 0xec, 0xb8, 0xdd, 0xf6, 0x78, 0xe0, 0x7a, 0x83, 0x7e, 0xef,
+	0xd2, 0x40, 0xb8, 0x06, 0xc6, 0x3e, 0x3b, 0x18, 0x76, 0xfb, 0x46, 0x11, 0xd7, 0xe1, 0xe1, 0x3e,
+	0xda, 0xee, 0xf5, 0x06, 0xef, 0x7b, 0xce, 0x68, 0x6c, 0x68, 0x67, 0xcf, 0x7f, 0x6c, 0x4c, 0x74,
+	0xbd, 0x31, 0xd1, 0xef, 0x8d, 0x89, 0xbe, 0x6e, 0xcd, 0xc2, 0xf5, 0xd6, 0x2c, 0xfc, 0xda, 0x9a,
+	0x85, 0x0f, 0xf9, 0x76, 0x08, 0xff, 0xa3, 0xc5, 0xb8, 0xbd, 0x4a, 0xf7, 0x66, 0x52, 0x52, 0x23,
+	0x7b, 0xf9, 0x77, 0x00, 0x8e, 0x9f, 0x01, 0xd5, 0x58, 0x03, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +509,62 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +642,35 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1169,190 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,65 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs stateless validation of the class policy.
+func (p ClassPolicy) ValidateBasic(ac address.Codec) error {
+	if len(p.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if _, err := ac.StringToBytes(p.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", p.Creator)
+	}
+
+	switch p.MintPolicy {
+	case MintPolicy_MINT_POLICY_CREATOR_ONLY, MintPolicy_MINT_POLICY_OPEN:
+		if len(p.Allowlist) > 0 {
+			return errors.Wrapf(ErrInvalidPolicy, "allowlist is only supported by %s", MintPolicy_MINT_POLICY_ALLOWLIST)
+		}
+	case MintPolicy_MINT_POLICY_ALLOWLIST:
+		if len(p.Allowlist) == 0 {
+			return errors.Wrap(ErrInvalidPolicy, "allowlist cannot be empty")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidPolicy, "unknown mint policy %s", p.MintPolicy)
+	}
+
+	seen := make(map[string]struct{}, len(p.Allowlist))
+	for _, addr := range p.Allowlist {
+		if _, err := ac.StringToBytes(addr); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlist address (%s)", addr)
+		}
+		if _, ok := seen[addr]; ok {
+			return errors.Wrapf(ErrInvalidPolicy, "duplicate allowlist address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// CanMint returns true if the given address is allowed to mint by the policy.
+func (p ClassPolicy) CanMint(minter string) bool {
+	switch p.MintPolicy {
+	case MintPolicy_MINT_POLICY_OPEN:
+		return true
+	case MintPolicy_MINT_POLICY_CREATOR_ONLY:
+		return minter == p.Creator
+	case MintPolicy_MINT_POLICY_ALLOWLIST:
+		if minter == p.Creator {
+			return true
+		}
+		for _, addr := range p.Allowlist {
+			if addr == minter {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -39,6 +39,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassPolicyKey):
+			var policyA, policyB nft.ClassPolicy
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
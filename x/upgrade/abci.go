@@ -16,6 +16,7 @@ import (
 
 // BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
+// If the plan failed in the upgraded binary and has a contingency plan, it will record the failure and clear the plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
 //
@@ -40,7 +41,8 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 		// 1. If there is no scheduled upgrade.
 		// 2. If the plan is not ready.
 		// 3. If the plan is ready and skip upgrade height is set for current height.
-		if !found || !plan.ShouldExecute(blockHeight) || (plan.ShouldExecute(blockHeight) && k.IsSkipHeight(blockHeight)) {
+		// 4. If the plan is ready and failed in the upgraded binary, according to its contingency plan.
+		if !found || !plan.ShouldExecute(blockHeight) || (plan.ShouldExecute(blockHeight) && (k.IsSkipHeight(blockHeight) || k.IsFailedUpgrade(plan))) {
 			lastAppliedPlan, _, err := k.GetLastCompletedUpgrade(ctx)
 			if err != nil {
 				return err
@@ -76,6 +78,15 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 			return k.ClearUpgradePlan(ctx)
 		}
 
+		// If the upgrade handler failed in the upgraded binary and we have switched back to the
+		// previous binary, we record the failure and clear the upgrade plan, as its contingency
+		// plan says
+		if k.IsFailedUpgrade(plan) {
+			logger.Error(fmt.Sprintf("UPGRADE \"%s\" FAILED at %d, skipped by its contingency plan: %s", plan.Name, plan.Height, plan.Contingency.Info))
+
+			return k.SkipFailedUpgrade(ctx, plan)
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
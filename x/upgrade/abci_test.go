@@ -387,6 +387,85 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	require.Nil(err)
 }
 
+func TestContingencyPlan(t *testing.T) {
+	// could not use setupTest() here, because we have to use the same key
+	// and home directory for the upgraded and previous keepers.
+	encCfg := moduletestutil.MakeTestEncodingConfig(upgrade.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now(), Height: 10})
+	homeDir := t.TempDir()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	k := keeper.NewKeeper(map[int64]bool{}, storeService, encCfg.Codec, homeDir, nil, authority)
+	plan := types.Plan{Name: "contingency", Height: 11, Contingency: &types.ContingencyPlan{Info: "v1.0.0"}}
+	require.NoError(t, k.ScheduleUpgrade(ctx, plan))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11})
+
+	t.Log("Verify that a previous binary without failure info still requires the upgrade")
+	previous := keeper.NewKeeper(map[int64]bool{}, storeService, encCfg.Codec, homeDir, nil, authority)
+	require.False(t, previous.IsFailedUpgrade(plan))
+	cacheCtx, _ := ctx.CacheContext()
+	err := upgrade.NewAppModule(previous, addresscodec.NewBech32Codec("cosmos")).BeginBlock(cacheCtx)
+	require.ErrorContains(t, err, "UPGRADE \"contingency\" NEEDED at height: 11")
+
+	t.Log("Verify that a failing upgrade handler halts the upgraded binary and records the failure")
+	k.SetUpgradeHandler(plan.Name, func(context.Context, types.Plan, module.VersionMap) (module.VersionMap, error) {
+		panic("migration failed")
+	})
+	cacheCtx, _ = ctx.CacheContext()
+	err = upgrade.NewAppModule(k, addresscodec.NewBech32Codec("cosmos")).BeginBlock(cacheCtx)
+	require.ErrorIs(t, err, types.ErrUpgradeFailed)
+	require.ErrorContains(t, err, "migration failed")
+
+	failure, err := k.ReadUpgradeFailureFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, plan, failure.Plan)
+	require.Contains(t, failure.Error, "migration failed")
+
+	t.Log("Verify that the upgraded binary does not skip the failed upgrade")
+	k = keeper.NewKeeper(map[int64]bool{}, storeService, encCfg.Codec, homeDir, nil, authority)
+	k.SetUpgradeHandler(plan.Name, func(context.Context, types.Plan, module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	require.False(t, k.IsFailedUpgrade(plan))
+
+	t.Log("Verify that the previous binary skips the failed upgrade and records the failure")
+	previous = keeper.NewKeeper(map[int64]bool{}, storeService, encCfg.Codec, homeDir, nil, authority)
+	require.True(t, previous.IsFailedUpgrade(plan))
+	require.NoError(t, upgrade.NewAppModule(previous, addresscodec.NewBech32Codec("cosmos")).BeginBlock(ctx))
+
+	_, err = previous.GetUpgradePlan(ctx)
+	require.ErrorIs(t, err, types.ErrNoUpgradePlanFound)
+	height, err := previous.GetDoneHeight(ctx, plan.Name)
+	require.NoError(t, err)
+	require.Zero(t, height)
+	height, err = previous.GetFailedHeight(ctx, plan.Name)
+	require.NoError(t, err)
+	require.Equal(t, plan.Height, height)
+
+	height, err = previous.GetFailedHeight(ctx, "other")
+	require.NoError(t, err)
+	require.Zero(t, height)
+}
+
+func TestUpgradeWithoutContingencyPlan(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11}))
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11})
+
+	s.keeper.SetUpgradeHandler("test", func(context.Context, types.Plan, module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	err := s.module.BeginBlock(newCtx)
+	require.EqualError(t, err, "migration failed")
+
+	failure, err := s.keeper.ReadUpgradeFailureFromDisk()
+	require.NoError(t, err)
+	require.Empty(t, failure.Plan.Name)
+}
+
 // TODO: add testcase to for `no upgrade handler is present for last applied upgrade`.
 func TestBinaryVersion(t *testing.T) {
 	var skipHeight int64 = 15
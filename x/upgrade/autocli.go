@@ -40,6 +40,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "PlanBinaries",
+					Use:       "plan-binaries [optional platform]",
+					Short:     "Query the binaries of the upgrade plan (if one exists)",
+					Long:      "Gets the binaries of the currently scheduled upgrade plan. Following the command with an os/arch platform will return only the binary of that platform, or the binary of any platform.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "platform", Optional: true},
+					},
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/pflag"
 
 	"cosmossdk.io/x/upgrade/types"
@@ -17,5 +20,42 @@ func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
 		return types.Plan{}, err
 	}
 
-	return types.Plan{Name: name, Height: height, Info: info}, nil
+	binaryFlags, err := fs.GetStringArray(FlagBinary)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	binaries := make([]types.Binary, 0, len(binaryFlags))
+	for _, binaryFlag := range binaryFlags {
+		binary, err := parseBinary(binaryFlag)
+		if err != nil {
+			return types.Plan{}, err
+		}
+		binaries = append(binaries, binary)
+	}
+	if len(binaries) == 0 {
+		binaries = nil
+	}
+
+	var contingency *types.ContingencyPlan
+	if fs.Changed(FlagContingency) {
+		contingencyInfo, err := fs.GetString(FlagContingency)
+		if err != nil {
+			return types.Plan{}, err
+		}
+		contingency = &types.ContingencyPlan{Info: contingencyInfo}
+	}
+
+	return types.Plan{Name: name, Height: height, Info: info, Binaries: binaries, Contingency: contingency}, nil
+}
+
+// parseBinary parses a binary in the "platform=url,checksum" format.
+func parseBinary(s string) (types.Binary, error) {
+	platform, rest, ok := strings.Cut(s, "=")
+	i := strings.LastIndex(rest, ",")
+	if !ok || i < 0 {
+		return types.Binary{}, fmt.Errorf("invalid binary %q, expected platform=url,checksum", s)
+	}
+
+	return types.Binary{Platform: platform, Url: rest[:i], Checksum: rest[i+1:]}, nil
 }
@@ -33,4 +33,26 @@ func TestParsePlan(t *testing.T) {
 	require.Equal(t, p.Name, proposal.Plan.Name)
 	require.Equal(t, p.Height, proposal.Plan.Height)
 	require.Equal(t, p.Info, proposal.Plan.Info)
+	require.Nil(t, p.Binaries)
+	require.Nil(t, p.Contingency)
+
+	require.NoError(t, fs.Set(FlagContingency, "v1.2.3"))
+	p, err = parsePlan(fs, proposal.Plan.Name)
+	require.NoError(t, err)
+	require.Equal(t, &types.ContingencyPlan{Info: "v1.2.3"}, p.Contingency)
+
+	checksum := "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	require.NoError(t, fs.Set(FlagBinary, "linux/amd64=https://example.com/simd.zip?a=1,b=2,"+checksum))
+	require.NoError(t, fs.Set(FlagBinary, "any=https://example.com/simd.zip,"+checksum))
+
+	p, err = parsePlan(fs, proposal.Plan.Name)
+	require.NoError(t, err)
+	require.Equal(t, []types.Binary{
+		{Platform: "linux/amd64", Url: "https://example.com/simd.zip?a=1,b=2", Checksum: checksum},
+		{Platform: "any", Url: "https://example.com/simd.zip", Checksum: checksum},
+	}, p.Binaries)
+
+	require.NoError(t, fs.Set(FlagBinary, "invalid"))
+	_, err = parsePlan(fs, proposal.Plan.Name)
+	require.Error(t, err)
 }
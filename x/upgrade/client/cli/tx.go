@@ -22,6 +22,8 @@ import (
 const (
 	FlagUpgradeHeight      = "upgrade-height"
 	FlagUpgradeInfo        = "upgrade-info"
+	FlagBinary             = "binary"
+	FlagContingency        = "contingency"
 	FlagNoValidate         = "no-validate"
 	FlagNoChecksumRequired = "no-checksum-required"
 	FlagDaemonName         = "daemon-name"
@@ -46,12 +48,13 @@ func GetTxCmd(ac addresscodec.Codec) *cobra.Command {
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
 func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "software-upgrade [name] (--upgrade-height [height]) (--upgrade-info [info]) [flags]",
+		Use:   "software-upgrade [name] (--upgrade-height [height]) (--upgrade-info [info]) (--binary [platform=url,checksum]...) [flags]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor",
+			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor\n" +
+			"Binaries may also be provided for each platform with the --binary flag, e.g. --binary linux/amd64=https://example.com/simd.zip,sha256:<hex>",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -85,13 +88,26 @@ func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 					return err
 				}
 
-				var planInfo *plan.Info
-				if planInfo, err = plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(!noChecksum)); err != nil {
-					return err
+				if len(p.Binaries) == 0 || p.Info != "" {
+					var planInfo *plan.Info
+					if planInfo, err = plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(!noChecksum)); err != nil {
+						return err
+					}
+
+					if err = planInfo.ValidateFull(daemonName); err != nil {
+						return err
+					}
 				}
 
-				if err = planInfo.ValidateFull(daemonName); err != nil {
-					return err
+				if len(p.Binaries) > 0 {
+					var binariesInfo *plan.Info
+					if binariesInfo, err = plan.NewInfoFromBinaries(p.Binaries); err != nil {
+						return err
+					}
+
+					if err = binariesInfo.ValidateFull(daemonName); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -119,6 +135,8 @@ func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
 	cmd.Flags().String(FlagUpgradeInfo, "", "Info for the upgrade plan such as new version download urls, etc.")
+	cmd.Flags().StringArray(FlagBinary, nil, "Binary of the upgrade for a platform, as platform=url,checksum (can be repeated)")
+	cmd.Flags().String(FlagContingency, "", "Attach a contingency plan, with info about the previous software, to switch back to it if the upgrade handler fails")
 	cmd.Flags().Bool(FlagNoValidate, false, "Skip validation of the upgrade info (dangerous!)")
 	cmd.Flags().Bool(FlagNoChecksumRequired, false, "Skip requirement of checksums for binaries in the upgrade info")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
@@ -87,3 +87,27 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// PlanBinaries implements the Query/PlanBinaries gRPC method
+func (k Keeper) PlanBinaries(c context.Context, req *types.QueryPlanBinariesRequest) (*types.QueryPlanBinariesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QueryPlanBinariesResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	binaries := plan.Binaries
+	if req.Platform != "" {
+		binaries = nil
+		if binary, ok := plan.BinaryForPlatform(req.Platform); ok {
+			binaries = []types.Binary{binary}
+		}
+	}
+
+	return &types.QueryPlanBinariesResponse{Name: plan.Name, Height: plan.Height, Binaries: binaries}, nil
+}
@@ -102,6 +102,58 @@ func (suite *UpgradeTestSuite) TestQueryCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestQueryPlanBinaries() {
+	linux := types.Binary{Platform: "linux/amd64", Url: "https://example.com/simd-linux.zip", Checksum: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+	anyPlatform := types.Binary{Platform: "any", Url: "https://example.com/simd.zip", Checksum: "md5:d41d8cd98f00b204e9800998ecf8427e"}
+	plan := types.Plan{Name: "test-plan", Height: 5, Binaries: []types.Binary{linux, anyPlatform}}
+
+	testCases := []struct {
+		msg         string
+		schedule    bool
+		req         *types.QueryPlanBinariesRequest
+		expResponse *types.QueryPlanBinariesResponse
+	}{
+		{
+			"without current upgrade plan",
+			false,
+			&types.QueryPlanBinariesRequest{},
+			&types.QueryPlanBinariesResponse{},
+		},
+		{
+			"all binaries",
+			true,
+			&types.QueryPlanBinariesRequest{},
+			&types.QueryPlanBinariesResponse{Name: plan.Name, Height: plan.Height, Binaries: plan.Binaries},
+		},
+		{
+			"binary of a platform",
+			true,
+			&types.QueryPlanBinariesRequest{Platform: "linux/amd64"},
+			&types.QueryPlanBinariesResponse{Name: plan.Name, Height: plan.Height, Binaries: []types.Binary{linux}},
+		},
+		{
+			"binary of any platform",
+			true,
+			&types.QueryPlanBinariesRequest{Platform: "darwin/arm64"},
+			&types.QueryPlanBinariesResponse{Name: plan.Name, Height: plan.Height, Binaries: []types.Binary{anyPlatform}},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			if tc.schedule {
+				suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+			}
+
+			res, err := suite.queryClient.PlanBinaries(context.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResponse, res)
+		})
+	}
+}
+
 func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	var (
 		req       *types.QueryAppliedPlanRequest
@@ -41,6 +41,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	upgradeFailure     types.UpgradeFailure            // the failed upgrade read from disk at startup, if any
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -64,6 +65,10 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeService corestore.KVStore
 		telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(upgradePlan.Height, 10))})
 	}
 
+	if upgradeFailure, err := k.ReadUpgradeFailureFromDisk(); err == nil {
+		k.upgradeFailure = upgradeFailure
+	}
+
 	return k
 }
 
@@ -462,7 +467,7 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
-	updatedVM, err := handler(ctx, plan, vm)
+	updatedVM, err := k.runUpgradeHandler(ctx, handler, plan, vm)
 	if err != nil {
 		return err
 	}
@@ -503,6 +508,73 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 	return k.setDone(ctx, plan.Name)
 }
 
+// runUpgradeHandler runs the upgrade handler. If the plan has a contingency
+// plan, a failure of the handler, including a panic, is written to
+// UpgradeFailureInfoFilename so that nodes can switch back to the previous
+// binary, and an ErrUpgradeFailed error is returned to halt the node at the
+// upgrade height.
+func (k Keeper) runUpgradeHandler(ctx context.Context, handler types.UpgradeHandler, plan types.Plan, vm module.VersionMap) (updatedVM module.VersionMap, err error) {
+	if plan.Contingency == nil {
+		return handler(ctx, plan, vm)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade handler panicked: %v", r)
+		}
+
+		if err != nil {
+			height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+			if dumpErr := k.DumpUpgradeFailureToDisk(height, plan, err); dumpErr != nil {
+				err = errors.Join(err, fmt.Errorf("unable to write upgrade failure info to filesystem: %w", dumpErr))
+			}
+			err = errorsmod.Wrapf(types.ErrUpgradeFailed, "%s: %s", plan.Name, err)
+		}
+	}()
+
+	return handler(ctx, plan, vm)
+}
+
+// IsFailedUpgrade returns true if the plan has a contingency plan and failed,
+// according to the upgrade failure info read from disk at startup, in a
+// binary which has a handler for it. This binary, which does not, is then the
+// previous binary and must skip the upgrade.
+func (k Keeper) IsFailedUpgrade(plan types.Plan) bool {
+	return plan.Contingency != nil &&
+		!k.HasHandler(plan.Name) &&
+		k.upgradeFailure.Plan.Name == plan.Name &&
+		k.upgradeFailure.Plan.Height == plan.Height
+}
+
+// SkipFailedUpgrade records the height of the plan which failed, according to
+// the upgrade failure info read from disk, and clears the plan, so that the
+// chain continues with the previous binary. Only the plan name and height are
+// written to state, the rest of the failure info being local to the node.
+func (k Keeper) SkipFailedUpgrade(ctx context.Context, plan types.Plan) error {
+	if !k.IsFailedUpgrade(plan) {
+		return fmt.Errorf("upgrade %s did not fail", plan.Name)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.FailedKey(plan.Name), sdk.Uint64ToBigEndian(uint64(plan.Height))); err != nil {
+		return err
+	}
+
+	return k.ClearUpgradePlan(ctx)
+}
+
+// GetFailedHeight returns the height of the named upgrade, as recorded when
+// the upgrade was skipped by its contingency plan, or 0 if it did not fail.
+func (k Keeper) GetFailedHeight(ctx context.Context, name string) (int64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.FailedKey(name))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
@@ -516,9 +588,11 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
 	}
 
 	upgradeInfo := types.Plan{
-		Name:   p.Name,
-		Height: height,
-		Info:   p.Info,
+		Name:        p.Name,
+		Height:      height,
+		Info:        p.Info,
+		Binaries:    p.Binaries,
+		Contingency: p.Contingency,
 	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
@@ -572,6 +646,70 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (types.Plan, error) {
 	return upgradeInfo, nil
 }
 
+// DumpUpgradeFailureToDisk writes the failure of the upgrade handler of the
+// plan to UpgradeFailureInfoFilename.
+func (k Keeper) DumpUpgradeFailureToDisk(height int64, p types.Plan, failure error) error {
+	upgradeFailureFilePath, err := k.GetUpgradeFailureInfoPath()
+	if err != nil {
+		return err
+	}
+
+	upgradeFailure := types.UpgradeFailure{
+		Plan: types.Plan{
+			Name:        p.Name,
+			Height:      height,
+			Info:        p.Info,
+			Binaries:    p.Binaries,
+			Contingency: p.Contingency,
+		},
+		Error: failure.Error(),
+	}
+	info, err := json.Marshal(upgradeFailure)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(upgradeFailureFilePath, info, 0o600)
+}
+
+// GetUpgradeFailureInfoPath returns the upgrade failure info file path
+func (k Keeper) GetUpgradeFailureInfoPath() (string, error) {
+	upgradeInfoFileDir := path.Join(k.getHomeDir(), "data")
+	if err := os.MkdirAll(upgradeInfoFileDir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("could not create directory %q: %w", upgradeInfoFileDir, err)
+	}
+
+	return filepath.Join(upgradeInfoFileDir, types.UpgradeFailureInfoFilename), nil
+}
+
+// ReadUpgradeFailureFromDisk returns the failure of the upgrade which is
+// written to disk by the upgraded binary when its upgrade handler fails. An
+// empty failure is returned if there is no such file.
+func (k Keeper) ReadUpgradeFailureFromDisk() (types.UpgradeFailure, error) {
+	var upgradeFailure types.UpgradeFailure
+
+	upgradeFailurePath, err := k.GetUpgradeFailureInfoPath()
+	if err != nil {
+		return upgradeFailure, err
+	}
+
+	data, err := os.ReadFile(upgradeFailurePath)
+	if err != nil {
+		// if file does not exist, assume there are no failed upgrades
+		if os.IsNotExist(err) {
+			return upgradeFailure, nil
+		}
+
+		return upgradeFailure, err
+	}
+
+	if err := json.Unmarshal(data, &upgradeFailure); err != nil {
+		return upgradeFailure, err
+	}
+
+	return upgradeFailure, nil
+}
+
 // SetDowngradeVerified updates downgradeVerified.
 func (k *Keeper) SetDowngradeVerified(v bool) {
 	k.downgradeVerified = v
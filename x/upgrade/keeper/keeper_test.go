@@ -79,6 +79,9 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	expected := types.Plan{
 		Name:   "test_upgrade",
 		Height: 100,
+		Binaries: []types.Binary{
+			{Platform: "any", Url: "https://example.com/simd.zip", Checksum: "md5:d41d8cd98f00b204e9800998ecf8427e"},
+		},
 	}
 
 	// create an upgrade info file
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/x/upgrade/internal/conv"
+	"cosmossdk.io/x/upgrade/types"
 )
 
 // Info is the special structure that the Plan.Info string can be (as json).
@@ -76,6 +77,28 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 	return &planInfo, nil
 }
 
+// NewInfoFromBinaries returns the Info of the structured binaries of a Plan.
+// Each URL of the returned Info includes the checksum of its binary, which is
+// enforced.
+func NewInfoFromBinaries(binaries []types.Binary) (*Info, error) {
+	if len(binaries) == 0 {
+		return nil, errors.New("no binaries found")
+	}
+
+	planInfo := Info{
+		parseConfig: ParseConfig{EnforceChecksum: true},
+		Binaries:    make(BinaryDownloadURLMap, len(binaries)),
+	}
+	for _, binary := range binaries {
+		if err := binary.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid binary for platform %s: %w", binary.Platform, err)
+		}
+		planInfo.Binaries[binary.Platform] = binary.DownloadURL()
+	}
+
+	return &planInfo, nil
+}
+
 // ValidateFull does all possible validation of this Info.
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
@@ -1,11 +1,15 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/x/upgrade/types"
 )
 
 type InfoTestSuite struct {
@@ -188,6 +192,31 @@ func (s *InfoTestSuite) TestInfoValidateFull() {
 	}
 }
 
+func (s *InfoTestSuite) TestNewInfoFromBinaries() {
+	contents := "#!/usr/bin\necho 'linux/amd64'\n"
+	linuxPath := s.saveTestFile(NewTestFile("linux_amd64", contents))
+	linuxURL := "file://" + linuxPath
+	sum := sha256.Sum256([]byte(contents))
+	checksum := "sha256:" + hex.EncodeToString(sum[:])
+	wrongChecksum := "sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
+
+	planInfo, err := NewInfoFromBinaries([]types.Binary{{Platform: "linux/amd64", Url: linuxURL, Checksum: checksum}})
+	s.Require().NoError(err)
+	s.Require().Equal(BinaryDownloadURLMap{"linux/amd64": linuxURL + "?checksum=sha256%3A" + hex.EncodeToString(sum[:])}, planInfo.Binaries)
+	s.Require().True(planInfo.parseConfig.EnforceChecksum)
+	s.Require().NoError(planInfo.ValidateFull("daemon"))
+
+	planInfo, err = NewInfoFromBinaries([]types.Binary{{Platform: "linux/amd64", Url: linuxURL, Checksum: wrongChecksum}})
+	s.Require().NoError(err)
+	s.Require().ErrorContains(planInfo.ValidateFull("daemon"), "Checksums did not match")
+
+	_, err = NewInfoFromBinaries(nil)
+	s.Require().ErrorContains(err, "no binaries found")
+
+	_, err = NewInfoFromBinaries([]types.Binary{{Platform: "linux/amd64", Url: linuxURL}})
+	s.Require().ErrorContains(err, "invalid checksum")
+}
+
 func (s *InfoTestSuite) TestBinaryDownloadURLMapValidateBasic() {
 	addDummyChecksum := func(url string) string {
 		return url + "?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
@@ -16,4 +16,6 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrUpgradeFailed error if the upgrade handler of a plan with a contingency plan fails
+	ErrUpgradeFailed = errors.Register(ModuleName, 7, "upgrade failed")
 )
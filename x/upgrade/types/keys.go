@@ -26,6 +26,9 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// FailedByte is a prefix to look up failed upgrades by name
+	FailedByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +45,11 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// FailedKey is the key under which the height of the named failed upgrade is saved
+func FailedKey(name string) []byte {
+	return append([]byte{FailedByte}, []byte(name)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	neturl "net/url"
+	"regexp"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -11,6 +15,9 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// UpgradeFailureInfoFilename file to store the failure of an upgrade with a contingency plan
+const UpgradeFailureInfoFilename = "upgrade-failure-info.json"
+
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() {
@@ -26,9 +33,40 @@ func (p Plan) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
 
+	platforms := make(map[string]bool, len(p.Binaries))
+	for _, binary := range p.Binaries {
+		if platforms[binary.Platform] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate binary for platform %q", binary.Platform)
+		}
+		platforms[binary.Platform] = true
+
+		if err := binary.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "binary for platform %q: %s", binary.Platform, err)
+		}
+	}
+
 	return nil
 }
 
+// BinaryForPlatform returns the binary of the given "os/arch" platform, or the
+// binary of the "any" platform if there is none.
+func (p Plan) BinaryForPlatform(platform string) (Binary, bool) {
+	var anyBinary *Binary
+	for i, binary := range p.Binaries {
+		switch binary.Platform {
+		case platform:
+			return binary, true
+		case BinaryPlatformAny:
+			anyBinary = &p.Binaries[i]
+		}
+	}
+
+	if anyBinary == nil {
+		return Binary{}, false
+	}
+	return *anyBinary, true
+}
+
 // ShouldExecute returns true if the Plan is ready to execute given the current block height
 func (p Plan) ShouldExecute(blockHeight int64) bool {
 	return p.Height > 0 && p.Height <= blockHeight
@@ -38,3 +76,66 @@ func (p Plan) ShouldExecute(blockHeight int64) bool {
 func (p Plan) DueAt() string {
 	return fmt.Sprintf("height: %d", p.Height)
 }
+
+// BinaryPlatformAny is the platform of a binary which runs on any platform.
+const BinaryPlatformAny = "any"
+
+var (
+	platformRx = regexp.MustCompile(`^[a-zA-Z0-9]+/[a-zA-Z0-9]+$`)
+
+	// checksumHexLen maps the supported checksum types to the length of their hex encoded value.
+	checksumHexLen = map[string]int{
+		"md5":    32,
+		"sha1":   40,
+		"sha256": 64,
+		"sha512": 128,
+	}
+)
+
+// ValidateBasic does basic validation of a Binary. It checks that the platform
+// is "any" or has the "os/arch" format, that the url is an absolute URL without
+// checksum query parameter, and that the checksum is well formed.
+func (b Binary) ValidateBasic() error {
+	if b.Platform != BinaryPlatformAny && !platformRx.MatchString(b.Platform) {
+		return fmt.Errorf("invalid os/arch format %q", b.Platform)
+	}
+
+	u, err := neturl.Parse(b.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", b.Url, err)
+	}
+	if u.Scheme == "" || (u.Host == "" && u.Path == "") {
+		return fmt.Errorf("url %q must be absolute", b.Url)
+	}
+	if u.Query().Has("checksum") {
+		return fmt.Errorf("url %q must not have a checksum query parameter, use the checksum field", b.Url)
+	}
+
+	checksumType, value, ok := strings.Cut(b.Checksum, ":")
+	if !ok {
+		return fmt.Errorf("invalid checksum %q, expected type:value", b.Checksum)
+	}
+	hexLen, ok := checksumHexLen[checksumType]
+	if !ok {
+		return fmt.Errorf("unsupported checksum type %q", checksumType)
+	}
+	if _, err := hex.DecodeString(value); err != nil || len(value) != hexLen {
+		return fmt.Errorf("invalid %s checksum value %q", checksumType, value)
+	}
+
+	return nil
+}
+
+// DownloadURL returns the url of the binary with its checksum as query
+// parameter, as expected by the go-getter library to verify the download.
+func (b Binary) DownloadURL() string {
+	u, err := neturl.Parse(b.Url)
+	if err != nil {
+		return b.Url
+	}
+
+	query := u.Query()
+	query.Set("checksum", b.Checksum)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -12,6 +12,11 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+const (
+	md5Hex    = "d41d8cd98f00b204e9800998ecf8427e"
+	sha256Hex = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
 func mustParseTime(s string) time.Time {
 	t, err := time.Parse(time.RFC3339, s)
 	if err != nil {
@@ -90,6 +95,69 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"valid binaries": {
+			p: types.Plan{
+				Name:   "binaries",
+				Height: 123450000,
+				Binaries: []types.Binary{
+					{Platform: "linux/amd64", Url: "https://example.com/simd-linux.zip", Checksum: "sha256:" + sha256Hex},
+					{Platform: "any", Url: "https://example.com/simd.zip?archive=zip", Checksum: "md5:" + md5Hex},
+				},
+			},
+			valid: true,
+		},
+		"duplicate binary platform": {
+			p: types.Plan{
+				Name:   "binaries",
+				Height: 123450000,
+				Binaries: []types.Binary{
+					{Platform: "linux/amd64", Url: "https://example.com/simd-1.zip", Checksum: "sha256:" + sha256Hex},
+					{Platform: "linux/amd64", Url: "https://example.com/simd-2.zip", Checksum: "sha256:" + sha256Hex},
+				},
+			},
+		},
+		"binary with invalid platform": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "linux", Url: "https://example.com/simd.zip", Checksum: "sha256:" + sha256Hex}},
+			},
+		},
+		"binary with relative url": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "any", Url: "simd.zip", Checksum: "sha256:" + sha256Hex}},
+			},
+		},
+		"binary with checksum in url": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "any", Url: "https://example.com/simd.zip?checksum=sha256:" + sha256Hex, Checksum: "sha256:" + sha256Hex}},
+			},
+		},
+		"binary without checksum": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "any", Url: "https://example.com/simd.zip"}},
+			},
+		},
+		"binary with unsupported checksum type": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "any", Url: "https://example.com/simd.zip", Checksum: "crc32:0a1b2c3d"}},
+			},
+		},
+		"binary with checksum of the wrong length": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.Binary{{Platform: "any", Url: "https://example.com/simd.zip", Checksum: "sha256:" + md5Hex}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -105,6 +173,28 @@ func TestPlanValid(t *testing.T) {
 	}
 }
 
+func TestPlanBinaryForPlatform(t *testing.T) {
+	linux := types.Binary{Platform: "linux/amd64", Url: "https://example.com/simd-linux.zip", Checksum: "sha256:" + sha256Hex}
+	anyPlatform := types.Binary{Platform: "any", Url: "https://example.com/simd.zip", Checksum: "sha256:" + sha256Hex}
+	p := types.Plan{Binaries: []types.Binary{anyPlatform, linux}}
+
+	binary, ok := p.BinaryForPlatform("linux/amd64")
+	require.True(t, ok)
+	require.Equal(t, linux, binary)
+
+	binary, ok = p.BinaryForPlatform("darwin/arm64")
+	require.True(t, ok)
+	require.Equal(t, anyPlatform, binary)
+
+	_, ok = types.Plan{Binaries: []types.Binary{linux}}.BinaryForPlatform("darwin/arm64")
+	require.False(t, ok)
+}
+
+func TestBinaryDownloadURL(t *testing.T) {
+	binary := types.Binary{Platform: "any", Url: "https://example.com/simd.zip?archive=zip", Checksum: "sha256:" + sha256Hex}
+	require.Equal(t, "https://example.com/simd.zip?archive=zip&checksum=sha256%3A"+sha256Hex, binary.DownloadURL())
+}
+
 func TestShouldExecute(t *testing.T) {
 	cases := map[string]struct {
 		p         types.Plan
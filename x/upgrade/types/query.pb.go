@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -492,6 +493,123 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryPlanBinariesRequest is the request type for the Query/PlanBinaries RPC
+// method.
+//
+// Since: cosmos-sdk 0.50
+type QueryPlanBinariesRequest struct {
+	// platform, when set, restricts the response to the binary of the given
+	// "os/arch" platform, falling back to the "any" platform binary.
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+}
+
+func (m *QueryPlanBinariesRequest) Reset()         { *m = QueryPlanBinariesRequest{} }
+func (m *QueryPlanBinariesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanBinariesRequest) ProtoMessage()    {}
+func (*QueryPlanBinariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryPlanBinariesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanBinariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanBinariesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanBinariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanBinariesRequest.Merge(m, src)
+}
+func (m *QueryPlanBinariesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanBinariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanBinariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanBinariesRequest proto.InternalMessageInfo
+
+func (m *QueryPlanBinariesRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+// QueryPlanBinariesResponse is the response type for the Query/PlanBinaries RPC
+// method.
+//
+// Since: cosmos-sdk 0.50
+type QueryPlanBinariesResponse struct {
+	// name is the name of the current upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the height of the current upgrade plan.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// binaries are the binaries of the current upgrade plan.
+	Binaries []Binary `protobuf:"bytes,3,rep,name=binaries,proto3" json:"binaries"`
+}
+
+func (m *QueryPlanBinariesResponse) Reset()         { *m = QueryPlanBinariesResponse{} }
+func (m *QueryPlanBinariesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanBinariesResponse) ProtoMessage()    {}
+func (*QueryPlanBinariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryPlanBinariesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanBinariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanBinariesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanBinariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanBinariesResponse.Merge(m, src)
+}
+func (m *QueryPlanBinariesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanBinariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanBinariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanBinariesResponse proto.InternalMessageInfo
+
+func (m *QueryPlanBinariesResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryPlanBinariesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryPlanBinariesResponse) GetBinaries() []Binary {
+	if m != nil {
+		return m.Binaries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +621,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryPlanBinariesRequest)(nil), "cosmos.upgrade.v1beta1.QueryPlanBinariesRequest")
+	proto.RegisterType((*QueryPlanBinariesResponse)(nil), "cosmos.upgrade.v1beta1.QueryPlanBinariesResponse")
 }
 
 func init() {
@@ -510,47 +630,54 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4d,
+	0x14, 0xef, 0x94, 0x7e, 0x7c, 0xe5, 0x95, 0xf0, 0x7d, 0x99, 0x68, 0x59, 0x56, 0x52, 0x70, 0x41,
+	0x85, 0x68, 0x3b, 0x50, 0x22, 0x31, 0x18, 0x0d, 0xc2, 0x45, 0x8c, 0x12, 0xad, 0xd1, 0x83, 0x97,
+	0x66, 0x61, 0xc7, 0xb2, 0xb1, 0xdd, 0x59, 0x76, 0x66, 0x89, 0x0d, 0xe1, 0xe2, 0x49, 0x6f, 0x26,
+	0xc6, 0xab, 0x89, 0x07, 0x2f, 0xfc, 0x25, 0x1c, 0x49, 0xbc, 0x78, 0x30, 0xc6, 0x80, 0x7f, 0x88,
+	0xd9, 0xd9, 0x69, 0xb3, 0xa5, 0xbb, 0x6b, 0xf1, 0xd6, 0x9d, 0xf9, 0xfd, 0xde, 0xfb, 0xbd, 0x79,
+	0xef, 0xf7, 0x0a, 0xc6, 0x36, 0xe3, 0x2d, 0xc6, 0x89, 0xef, 0x36, 0x3c, 0xd3, 0xa2, 0x64, 0x6f,
+	0x71, 0x8b, 0x0a, 0x73, 0x91, 0xec, 0xfa, 0xd4, 0x6b, 0x57, 0x5c, 0x8f, 0x09, 0x86, 0x8b, 0x21,
+	0xa6, 0xa2, 0x30, 0x15, 0x85, 0xd1, 0x2f, 0x34, 0x58, 0x83, 0x49, 0x08, 0x09, 0x7e, 0x85, 0x68,
+	0x7d, 0xb2, 0xc1, 0x58, 0xa3, 0x49, 0x89, 0xe9, 0xda, 0xc4, 0x74, 0x1c, 0x26, 0x4c, 0x61, 0x33,
+	0x87, 0xab, 0xdb, 0xd9, 0x84, 0x7c, 0x9d, 0xd8, 0x12, 0x65, 0x4c, 0xc0, 0xf8, 0x93, 0x40, 0xc0,
+	0xba, 0xef, 0x79, 0xd4, 0x11, 0x8f, 0x9b, 0xa6, 0x53, 0xa3, 0xbb, 0x3e, 0xe5, 0xc2, 0x78, 0x08,
+	0x5a, 0xff, 0x15, 0x77, 0x99, 0xc3, 0x29, 0x5e, 0x80, 0x9c, 0xdb, 0x34, 0x1d, 0x0d, 0x4d, 0xa3,
+	0xb9, 0x42, 0x75, 0xb2, 0x12, 0xaf, 0xbb, 0x22, 0x39, 0x12, 0x69, 0x94, 0x55, 0xa2, 0x7b, 0xae,
+	0xdb, 0xb4, 0xa9, 0x15, 0x49, 0x84, 0x31, 0xe4, 0x1c, 0xb3, 0x45, 0x65, 0xb0, 0x91, 0x9a, 0xfc,
+	0x6d, 0x54, 0x41, 0xeb, 0x87, 0xab, 0xe4, 0x45, 0x18, 0xde, 0xa1, 0x76, 0x63, 0x47, 0x48, 0xc6,
+	0x50, 0x4d, 0x7d, 0x19, 0x1b, 0x60, 0x48, 0xce, 0xb3, 0x50, 0x85, 0xb5, 0x1e, 0xa0, 0x1d, 0xee,
+	0xf3, 0xa7, 0xc2, 0x14, 0xb4, 0x93, 0x6d, 0x0a, 0x0a, 0x4d, 0x93, 0x8b, 0x7a, 0x4f, 0x08, 0x08,
+	0x8e, 0xee, 0xcb, 0x93, 0x95, 0xac, 0x86, 0x0c, 0x1b, 0x66, 0x52, 0x43, 0x29, 0x25, 0xb7, 0x40,
+	0x53, 0x25, 0x5b, 0xf5, 0xed, 0x0e, 0xa4, 0xce, 0x03, 0x8c, 0x96, 0x9d, 0x46, 0x73, 0xa3, 0xb5,
+	0xa2, 0x1f, 0x1b, 0x21, 0x48, 0xf2, 0x20, 0x97, 0x47, 0xff, 0x67, 0x8d, 0x3b, 0xa0, 0xcb, 0x54,
+	0x8f, 0x98, 0xe5, 0x37, 0xe9, 0x73, 0xea, 0xf1, 0xa0, 0x89, 0x11, 0xb5, 0x2d, 0x79, 0x51, 0x8f,
+	0x3c, 0x11, 0x84, 0x47, 0x9b, 0xc1, 0x43, 0xb5, 0xe0, 0x52, 0x2c, 0x5d, 0x29, 0xdc, 0x84, 0xff,
+	0x14, 0x7f, 0x4f, 0x5d, 0x69, 0x68, 0x7a, 0x68, 0xae, 0x50, 0xbd, 0x92, 0xd4, 0xb3, 0x9e, 0x40,
+	0xb5, 0xb1, 0x56, 0x4f, 0x5c, 0x63, 0x1c, 0x2e, 0x86, 0x7d, 0xf1, 0xc5, 0x0e, 0xf3, 0x6c, 0xd1,
+	0xee, 0x4c, 0x4b, 0x15, 0x8a, 0x67, 0x2f, 0x94, 0x04, 0x0d, 0xfe, 0x35, 0x2d, 0xcb, 0xa3, 0x9c,
+	0x2b, 0xf9, 0x9d, 0x4f, 0x63, 0x59, 0x35, 0x39, 0xe8, 0xee, 0x9a, 0xed, 0x98, 0x9e, 0x4d, 0xbb,
+	0x85, 0xeb, 0x90, 0x77, 0x9b, 0xa6, 0x78, 0xc9, 0xbc, 0x96, 0xa2, 0x75, 0xbf, 0x8d, 0x77, 0x08,
+	0x26, 0x62, 0x88, 0x2a, 0x5f, 0xcc, 0x38, 0x45, 0x46, 0x26, 0x1b, 0x1d, 0x19, 0xbc, 0x0a, 0xf9,
+	0x2d, 0xc5, 0xd7, 0x86, 0xe4, 0xbb, 0x94, 0x92, 0xde, 0x45, 0xe6, 0x69, 0xaf, 0xe5, 0x8e, 0x7e,
+	0x4c, 0x65, 0x6a, 0x5d, 0x56, 0xf5, 0x73, 0x1e, 0xfe, 0x91, 0x5a, 0xf0, 0x27, 0x04, 0x85, 0x88,
+	0x57, 0x30, 0x49, 0x8a, 0x94, 0x60, 0x38, 0x7d, 0x61, 0x70, 0x42, 0x58, 0xaa, 0x71, 0xe3, 0xcd,
+	0xd7, 0x5f, 0x1f, 0xb2, 0x57, 0xf1, 0x2c, 0x49, 0x30, 0xfb, 0x76, 0x48, 0xaa, 0x07, 0x16, 0xc4,
+	0x5f, 0x10, 0x14, 0x22, 0x7e, 0xfa, 0x83, 0xc0, 0x7e, 0xa3, 0xea, 0x0b, 0x83, 0x13, 0x94, 0xc0,
+	0x25, 0x29, 0xb0, 0x8c, 0xaf, 0x27, 0x09, 0x34, 0x43, 0x92, 0x14, 0x48, 0xf6, 0x83, 0x5e, 0x1d,
+	0xe0, 0xef, 0x08, 0x8a, 0xf1, 0xc6, 0xc3, 0x2b, 0xa9, 0x0a, 0x52, 0x8d, 0xaf, 0xdf, 0xfe, 0x2b,
+	0xae, 0x2a, 0x64, 0x43, 0x16, 0xb2, 0x8a, 0xef, 0x92, 0xf4, 0xb5, 0xda, 0xb7, 0x07, 0xc8, 0x7e,
+	0x64, 0xdb, 0x1c, 0xbc, 0xcd, 0x22, 0x7c, 0x88, 0x60, 0xac, 0xd7, 0xad, 0xb8, 0x9a, 0x2a, 0x2d,
+	0x76, 0x33, 0xe8, 0x4b, 0xe7, 0xe2, 0xa8, 0x32, 0x88, 0x2c, 0x63, 0x1e, 0x5f, 0x4b, 0x2a, 0xe3,
+	0xcc, 0xb2, 0xc0, 0x1f, 0x11, 0x8c, 0x74, 0x2d, 0x8d, 0xcb, 0xe9, 0x03, 0x70, 0x66, 0x27, 0xe8,
+	0x95, 0x41, 0xe1, 0x4a, 0xdd, 0xbc, 0x54, 0x37, 0x83, 0x2f, 0x27, 0x4e, 0x4b, 0x57, 0xc9, 0x21,
+	0x82, 0xd1, 0xa8, 0xfb, 0x71, 0xfa, 0x6c, 0xc6, 0x6c, 0x18, 0x7d, 0xf1, 0x1c, 0x0c, 0x25, 0xf0,
+	0xa6, 0x14, 0x48, 0x70, 0x79, 0x10, 0xbf, 0x91, 0xce, 0x8e, 0x58, 0x5b, 0x3e, 0x3a, 0x29, 0xa1,
+	0xe3, 0x93, 0x12, 0xfa, 0x79, 0x52, 0x42, 0xef, 0x4f, 0x4b, 0x99, 0xe3, 0xd3, 0x52, 0xe6, 0xdb,
+	0x69, 0x29, 0xf3, 0x62, 0x32, 0x8c, 0xc3, 0xad, 0x57, 0x15, 0x9b, 0x91, 0xd7, 0xdd, 0x78, 0xa2,
+	0xed, 0x52, 0xbe, 0x35, 0x2c, 0xff, 0xa3, 0x97, 0x7e, 0x0f, 0x00, 0xb4, 0x90, 0x5f, 0xe8, 0x3b,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +711,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the binaries of the current upgrade plan.
+	//
+	// Since: cosmos-sdk 0.50
+	PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error)
 }
 
 type queryClient struct {
@@ -640,6 +771,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error) {
+	out := new(QueryPlanBinariesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/PlanBinaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -661,6 +801,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the binaries of the current upgrade plan.
+	//
+	// Since: cosmos-sdk 0.50
+	PlanBinaries(context.Context, *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -682,6 +826,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) PlanBinaries(ctx context.Context, req *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanBinaries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -777,6 +924,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PlanBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanBinaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/PlanBinaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanBinaries(ctx, req.(*QueryPlanBinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -801,6 +966,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "PlanBinaries",
+			Handler:    _Query_PlanBinaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1100,6 +1269,85 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPlanBinariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanBinariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanBinariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanBinariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanBinariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanBinariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1233,6 +1481,41 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPlanBinariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanBinariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1977,6 +2260,223 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPlanBinariesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanBinariesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanBinariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanBinariesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanBinariesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanBinariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, Binary{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53,6 +53,21 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *types.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// binaries are the binaries of the upgraded software for each platform,
+	// which tools such as cosmovisor use to download the upgraded software.
+	// Unlike the binaries which may be encoded in info, they are validated
+	// on-chain and must each have a checksum.
+	//
+	// Since: cosmos-sdk 0.50
+	Binaries []Binary `protobuf:"bytes,6,rep,name=binaries,proto3" json:"binaries"`
+	// contingency is the plan to follow if the upgrade handler fails. Without
+	// it, a failing upgrade handler panics and operators must coordinate the
+	// recovery off-chain. With it, the failure is recorded, nodes halt at the
+	// upgrade height without committing it, and switch back to the previous
+	// binary which then skips the upgrade.
+	//
+	// Since: cosmos-sdk 0.50
+	Contingency *ContingencyPlan `protobuf:"bytes,7,opt,name=contingency,proto3" json:"contingency,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -88,6 +103,140 @@ func (m *Plan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plan proto.InternalMessageInfo
 
+// ContingencyPlan is the plan to follow if an upgrade fails.
+//
+// Since: cosmos-sdk 0.50
+type ContingencyPlan struct {
+	// info is any application specific information about the previous
+	// software, which nodes switch back to if the upgrade fails.
+	Info string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *ContingencyPlan) Reset()         { *m = ContingencyPlan{} }
+func (m *ContingencyPlan) String() string { return proto.CompactTextString(m) }
+func (*ContingencyPlan) ProtoMessage()    {}
+func (*ContingencyPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+}
+func (m *ContingencyPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContingencyPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContingencyPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContingencyPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContingencyPlan.Merge(m, src)
+}
+func (m *ContingencyPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContingencyPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContingencyPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContingencyPlan proto.InternalMessageInfo
+
+// UpgradeFailure records an upgrade whose handler failed.
+//
+// Since: cosmos-sdk 0.50
+type UpgradeFailure struct {
+	// plan is the upgrade plan which failed, with the height it failed at.
+	Plan Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+	// error is the error returned, or the panic raised, by the upgrade handler.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *UpgradeFailure) Reset()         { *m = UpgradeFailure{} }
+func (m *UpgradeFailure) String() string { return proto.CompactTextString(m) }
+func (*UpgradeFailure) ProtoMessage()    {}
+func (*UpgradeFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+}
+func (m *UpgradeFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeFailure.Merge(m, src)
+}
+func (m *UpgradeFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeFailure proto.InternalMessageInfo
+
+// Binary is the upgraded software binary of a platform.
+//
+// Since: cosmos-sdk 0.50
+type Binary struct {
+	// platform is the "os/arch" the binary runs on, e.g. "linux/amd64", or "any"
+	// for a binary which runs on any platform.
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url is the URL the binary, or an archive containing it, can be downloaded
+	// from. It must not contain a checksum query parameter.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// checksum is the checksum of the downloaded file, in the "type:value"
+	// format, e.g. "sha256:abcd...", where type is one of md5, sha1, sha256 or
+	// sha512 and value is hex encoded.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *Binary) Reset()         { *m = Binary{} }
+func (m *Binary) String() string { return proto.CompactTextString(m) }
+func (*Binary) ProtoMessage()    {}
+func (*Binary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+}
+func (m *Binary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Binary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Binary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Binary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Binary.Merge(m, src)
+}
+func (m *Binary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Binary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Binary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Binary proto.InternalMessageInfo
+
 // SoftwareUpgradeProposal is a gov Content type for initiating a software
 // upgrade.
 // Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
@@ -107,7 +256,7 @@ func (m *SoftwareUpgradeProposal) Reset()         { *m = SoftwareUpgradeProposal
 func (m *SoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*SoftwareUpgradeProposal) ProtoMessage()    {}
 func (*SoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
 }
 func (m *SoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +302,7 @@ func (m *CancelSoftwareUpgradeProposal) Reset()         { *m = CancelSoftwareUpg
 func (m *CancelSoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelSoftwareUpgradeProposal) ProtoMessage()    {}
 func (*CancelSoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
 }
 func (m *CancelSoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +345,7 @@ func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
 func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
 func (*ModuleVersion) ProtoMessage()    {}
 func (*ModuleVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{6}
 }
 func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +376,9 @@ var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
+	proto.RegisterType((*ContingencyPlan)(nil), "cosmos.upgrade.v1beta1.ContingencyPlan")
+	proto.RegisterType((*UpgradeFailure)(nil), "cosmos.upgrade.v1beta1.UpgradeFailure")
+	proto.RegisterType((*Binary)(nil), "cosmos.upgrade.v1beta1.Binary")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
@@ -237,40 +389,48 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x36, 0x6e, 0xda, 0x6c, 0x04, 0x05, 0x53, 0x8a, 0x1b, 0x15, 0x27, 0x8a, 0x90, 0x88,
+	0x8a, 0x6a, 0xab, 0xe5, 0x16, 0x0e, 0x88, 0x44, 0x20, 0x21, 0x40, 0x2a, 0x2e, 0x20, 0xc4, 0xa5,
+	0xda, 0x38, 0x1b, 0x77, 0x55, 0x7b, 0xd7, 0x5a, 0x6f, 0x0a, 0x39, 0x72, 0xe5, 0xd4, 0x9f, 0xc0,
+	0x11, 0x71, 0xea, 0x81, 0x1f, 0x51, 0x71, 0xea, 0x11, 0x09, 0x89, 0x8f, 0xf6, 0x50, 0x7e, 0x06,
+	0xda, 0x0f, 0xa7, 0xa1, 0x34, 0x08, 0x21, 0x2e, 0xd1, 0xcc, 0xf8, 0xbd, 0x79, 0x6f, 0x66, 0x62,
+	0xc3, 0x6b, 0x21, 0xcb, 0x12, 0x96, 0xf9, 0x83, 0x34, 0xe2, 0xa8, 0x87, 0xfd, 0x9d, 0xd5, 0x2e,
+	0x16, 0x68, 0x35, 0xcf, 0xbd, 0x94, 0x33, 0xc1, 0xec, 0x05, 0x8d, 0xf2, 0xf2, 0xaa, 0x41, 0x55,
+	0x17, 0x23, 0xc6, 0xa2, 0x18, 0xfb, 0x0a, 0xd5, 0x1d, 0xf4, 0x7d, 0x44, 0x87, 0x9a, 0x52, 0x9d,
+	0x8f, 0x58, 0xc4, 0x54, 0xe8, 0xcb, 0xc8, 0x54, 0x6b, 0xa7, 0x09, 0x82, 0x24, 0x38, 0x13, 0x28,
+	0x49, 0x0d, 0x60, 0x51, 0x2b, 0x6d, 0x6a, 0xa6, 0x91, 0xd5, 0x8f, 0x2e, 0xa2, 0x84, 0x50, 0xe6,
+	0xab, 0x5f, 0x5d, 0x6a, 0xbc, 0x2e, 0x42, 0x6b, 0x3d, 0x46, 0xd4, 0xb6, 0xa1, 0x45, 0x51, 0x82,
+	0x1d, 0x50, 0x07, 0xcd, 0x72, 0xa0, 0x62, 0xfb, 0x36, 0xb4, 0x64, 0x77, 0x67, 0xaa, 0x0e, 0x9a,
+	0x95, 0xb5, 0xaa, 0xa7, 0xa5, 0xbd, 0x5c, 0xda, 0x7b, 0x92, 0x4b, 0xb7, 0xe7, 0xf6, 0xbf, 0xd4,
+	0x0a, 0xbb, 0x5f, 0x6b, 0xe0, 0xdd, 0xf1, 0xde, 0x32, 0x70, 0x40, 0xa0, 0x88, 0xf6, 0x02, 0x2c,
+	0x6d, 0x61, 0x12, 0x6d, 0x09, 0xa7, 0x58, 0x07, 0xcd, 0x62, 0x60, 0x32, 0x29, 0x46, 0x68, 0x9f,
+	0x39, 0x96, 0x16, 0x93, 0xb1, 0xfd, 0x10, 0x5e, 0x36, 0xcb, 0xe9, 0x6d, 0x86, 0x31, 0xc1, 0x54,
+	0x6c, 0x66, 0x02, 0x09, 0xec, 0x4c, 0x2b, 0xf5, 0xf9, 0xdf, 0xd4, 0xef, 0xd0, 0x61, 0x7b, 0xca,
+	0x01, 0xc1, 0xa5, 0x9c, 0xd6, 0x51, 0xac, 0x0d, 0x49, 0xb2, 0xef, 0xc2, 0xd9, 0x2e, 0xa1, 0x88,
+	0x13, 0x9c, 0x39, 0xa5, 0x7a, 0xb1, 0x59, 0x59, 0x73, 0xbd, 0xb3, 0x4f, 0xe0, 0xb5, 0x25, 0x6e,
+	0xd8, 0x2e, 0xcb, 0x11, 0x94, 0xfd, 0x60, 0x44, 0xb5, 0xef, 0xc3, 0x4a, 0xc8, 0xa8, 0x20, 0x34,
+	0xc2, 0x34, 0x1c, 0x3a, 0x33, 0xca, 0xca, 0xf5, 0x49, 0x9d, 0x3a, 0x27, 0x50, 0xb9, 0xd3, 0x60,
+	0x9c, 0xdb, 0x72, 0x7e, 0xbc, 0xad, 0x81, 0x37, 0xc7, 0x7b, 0xcb, 0x73, 0x9a, 0xbd, 0x92, 0xf5,
+	0xb6, 0x7d, 0x09, 0x6b, 0xdc, 0x80, 0x73, 0xa7, 0x98, 0xa3, 0x05, 0x81, 0x93, 0x05, 0xb5, 0x2c,
+	0xd9, 0xa0, 0x41, 0xe0, 0xf9, 0xa7, 0x5a, 0xf6, 0x1e, 0x22, 0xf1, 0x80, 0x63, 0xfb, 0x16, 0xb4,
+	0xd2, 0x18, 0x51, 0x85, 0xad, 0xac, 0x2d, 0x4d, 0x32, 0x27, 0xfb, 0x8e, 0x0f, 0xa9, 0x48, 0xf6,
+	0x3c, 0x9c, 0xc6, 0x9c, 0x33, 0xae, 0x6e, 0x5c, 0x0e, 0x74, 0x62, 0xa4, 0x9e, 0xc3, 0x92, 0xde,
+	0x8d, 0x5d, 0x85, 0xb3, 0x69, 0x8c, 0x44, 0x9f, 0xf1, 0xc4, 0x58, 0x1a, 0xe5, 0xf6, 0x05, 0x58,
+	0x1c, 0xf0, 0xd8, 0xf0, 0x65, 0x28, 0xd1, 0xe1, 0x16, 0x0e, 0xb7, 0xb3, 0x41, 0xa2, 0xee, 0x5e,
+	0x0e, 0x46, 0xb9, 0xe9, 0xfc, 0x19, 0xc0, 0x2b, 0x1b, 0xac, 0x2f, 0x5e, 0x22, 0x8e, 0xcd, 0x34,
+	0xeb, 0x9c, 0xa5, 0x2c, 0x43, 0xb1, 0x74, 0x24, 0x88, 0x88, 0xf3, 0x7f, 0xa2, 0x4e, 0xec, 0x3a,
+	0xac, 0xf4, 0x70, 0x16, 0x72, 0x92, 0x0a, 0xc2, 0xa8, 0x51, 0x1b, 0x2f, 0x8d, 0xd6, 0x50, 0xfc,
+	0x87, 0x35, 0xb4, 0x1e, 0x48, 0x5b, 0x1f, 0x3f, 0xac, 0x54, 0x0d, 0x2b, 0x62, 0x3b, 0xbf, 0x5c,
+	0x15, 0x53, 0x21, 0x4f, 0xd7, 0x18, 0x3b, 0xdd, 0x04, 0xff, 0x0e, 0x68, 0xbc, 0x07, 0xf0, 0x6a,
+	0x07, 0xd1, 0x10, 0xc7, 0xff, 0x79, 0xc6, 0xd6, 0xe3, 0xbf, 0xb3, 0xd9, 0x1c, 0xb3, 0xf9, 0x47,
+	0x23, 0x0e, 0x68, 0x74, 0xe0, 0xb9, 0x47, 0xac, 0x37, 0x88, 0xf1, 0x33, 0xcc, 0x33, 0xc2, 0xce,
+	0xfe, 0x10, 0x38, 0x70, 0x66, 0x47, 0x3f, 0x56, 0xae, 0xac, 0x20, 0x4f, 0xf5, 0x3d, 0xdb, 0xad,
+	0xfd, 0xef, 0x6e, 0x61, 0xff, 0xd0, 0x05, 0x07, 0x87, 0x2e, 0xf8, 0x76, 0xe8, 0x82, 0xdd, 0x23,
+	0xb7, 0x70, 0x70, 0xe4, 0x16, 0x3e, 0x1d, 0xb9, 0x85, 0x17, 0x4b, 0xda, 0x4e, 0xd6, 0xdb, 0xf6,
+	0x08, 0xf3, 0x5f, 0x8d, 0xbe, 0x94, 0x62, 0x98, 0xe2, 0xac, 0x5b, 0x52, 0x2f, 0xf4, 0xcd, 0x9f,
+	0x03, 0x00, 0x14, 0x97, 0xf8, 0x85, 0x48, 0x05, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -307,6 +467,98 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if len(this.Binaries) != len(that1.Binaries) {
+		return false
+	}
+	for i := range this.Binaries {
+		if !this.Binaries[i].Equal(&that1.Binaries[i]) {
+			return false
+		}
+	}
+	if !this.Contingency.Equal(that1.Contingency) {
+		return false
+	}
+	return true
+}
+func (this *ContingencyPlan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContingencyPlan)
+	if !ok {
+		that2, ok := that.(ContingencyPlan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Info != that1.Info {
+		return false
+	}
+	return true
+}
+func (this *UpgradeFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradeFailure)
+	if !ok {
+		that2, ok := that.(UpgradeFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Plan.Equal(&that1.Plan) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *Binary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Binary)
+	if !ok {
+		that2, ok := that.(Binary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Platform != that1.Platform {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -413,6 +665,32 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Contingency != nil {
+		{
+			size, err := m.Contingency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -437,12 +715,12 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintUpgrade(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintUpgrade(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Name) > 0 {
@@ -455,6 +733,120 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContingencyPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContingencyPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContingencyPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Binary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Binary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Binary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -608,22 +1000,81 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	if m.Contingency != nil {
+		l = m.Contingency.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
-func (m *SoftwareUpgradeProposal) Size() (n int) {
+func (m *ContingencyPlan) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Info)
 	if l > 0 {
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovUpgrade(uint64(l))
+	return n
+}
+
+func (m *UpgradeFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *Binary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *SoftwareUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
 	}
 	l = m.Plan.Size()
 	n += 1 + l + sovUpgrade(uint64(l))
@@ -850,6 +1301,419 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, Binary{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contingency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contingency == nil {
+				m.Contingency = &ContingencyPlan{}
+			}
+			if err := m.Contingency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContingencyPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContingencyPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContingencyPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Binary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Binary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Binary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])